@@ -0,0 +1,19 @@
+# Copyright (c) HashiCorp, Inc.
+
+resource "terraform_data" "smoke_test" {
+  input = contextforge_tool.example.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.contextforge_invoke_tool.smoke_test]
+    }
+  }
+}
+
+action "contextforge_invoke_tool" "smoke_test" {
+  config {
+    tool_id   = contextforge_tool.example.id
+    arguments = jsonencode({ message = "ping" })
+  }
+}
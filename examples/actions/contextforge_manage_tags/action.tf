@@ -0,0 +1,21 @@
+# Copyright (c) HashiCorp, Inc.
+
+resource "terraform_data" "release" {
+  input = var.release_version
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.contextforge_manage_tags.release]
+    }
+  }
+}
+
+action "contextforge_manage_tags" "release" {
+  config {
+    object_type = "tool"
+    object_id   = contextforge_tool.example.id
+    add         = ["released"]
+    remove      = ["pending-release"]
+  }
+}
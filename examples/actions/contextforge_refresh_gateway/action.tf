@@ -0,0 +1,18 @@
+# Copyright (c) HashiCorp, Inc.
+
+resource "terraform_data" "backend_release" {
+  input = var.backend_version
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.contextforge_refresh_gateway.backend]
+    }
+  }
+}
+
+action "contextforge_refresh_gateway" "backend" {
+  config {
+    gateway_id = contextforge_gateway.example.id
+  }
+}
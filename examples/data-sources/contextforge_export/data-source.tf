@@ -0,0 +1,11 @@
+# Copyright (c) HashiCorp, Inc.
+
+data "contextforge_export" "example" {}
+
+locals {
+  export = jsondecode(data.contextforge_export.example.document)
+}
+
+output "server_ids" {
+  value = [for s in local.export.contextforge_server : s.id]
+}
@@ -0,0 +1,7 @@
+# Copyright (c) HashiCorp, Inc.
+
+data "contextforge_federation" "example" {}
+
+output "federated_peers" {
+  value = data.contextforge_federation.example.peer_count
+}
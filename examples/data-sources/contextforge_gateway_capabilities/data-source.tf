@@ -0,0 +1,9 @@
+# Copyright (c) HashiCorp, Inc.
+
+data "contextforge_gateway_capabilities" "example" {
+  id = "gateway-id"
+}
+
+output "gateway_supports_tools" {
+  value = data.contextforge_gateway_capabilities.example.supports_tools
+}
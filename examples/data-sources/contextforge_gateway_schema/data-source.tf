@@ -0,0 +1,11 @@
+# Copyright (c) HashiCorp, Inc.
+
+data "contextforge_gateway_schema" "example" {}
+
+output "gateway_api_version" {
+  value = data.contextforge_gateway_schema.example.version
+}
+
+output "gateway_api_paths" {
+  value = keys(jsondecode(data.contextforge_gateway_schema.example.schema_json).paths)
+}
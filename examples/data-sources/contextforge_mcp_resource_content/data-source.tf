@@ -0,0 +1,9 @@
+# Copyright (c) HashiCorp, Inc.
+
+data "contextforge_mcp_resource_content" "example" {
+  id = "resource-id"
+}
+
+output "resource_text" {
+  value = data.contextforge_mcp_resource_content.example.content
+}
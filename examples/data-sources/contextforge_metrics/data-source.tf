@@ -0,0 +1,7 @@
+# Copyright (c) HashiCorp, Inc.
+
+data "contextforge_metrics" "example" {}
+
+output "requests_last_hour" {
+  value = data.contextforge_metrics.example.requests_last_hour
+}
@@ -0,0 +1,12 @@
+# Copyright (c) HashiCorp, Inc.
+
+data "contextforge_prompt_render" "example" {
+  id = "prompt-id"
+  arguments = {
+    name = "Ada"
+  }
+}
+
+output "rendered_messages" {
+  value = jsondecode(data.contextforge_prompt_render.example.messages)
+}
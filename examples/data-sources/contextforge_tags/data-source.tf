@@ -0,0 +1,7 @@
+# Copyright (c) HashiCorp, Inc.
+
+data "contextforge_tags" "example" {}
+
+output "rarely_used_tags" {
+  value = [for tag, count in data.contextforge_tags.example.counts : tag if count == 1]
+}
@@ -0,0 +1,5 @@
+# Copyright (c) HashiCorp, Inc.
+
+data "contextforge_user" "example" {
+  id = "user-id"
+}
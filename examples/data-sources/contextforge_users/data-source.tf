@@ -0,0 +1,3 @@
+# Copyright (c) HashiCorp, Inc.
+
+data "contextforge_users" "all" {}
@@ -0,0 +1,3 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform import contextforge_passthrough_config.example passthrough_config
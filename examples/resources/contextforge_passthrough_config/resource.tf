@@ -0,0 +1,8 @@
+# Copyright (c) HashiCorp, Inc.
+
+resource "contextforge_passthrough_config" "example" {
+  allowed_headers = [
+    "X-Tenant-ID",
+    "X-Trace-ID",
+  ]
+}
@@ -1,3 +1,6 @@
 # Copyright (c) HashiCorp, Inc.
 
 terraform import contextforge_root.example "file:///workspace/project"
+
+# The name can be given after a comma, for roots the gateway reports without one.
+terraform import contextforge_root.example "file:///workspace/project,project"
@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+
+# Importing adopts every root currently on the gateway.
+terraform import contextforge_roots.example roots
@@ -0,0 +1,13 @@
+# Copyright (c) HashiCorp, Inc.
+
+resource "contextforge_roots" "example" {
+  roots = [
+    {
+      uri  = "file:///workspace/project"
+      name = "project-root"
+    },
+    {
+      uri = "file:///workspace/shared"
+    },
+  ]
+}
@@ -0,0 +1,3 @@
+# Copyright (c) HashiCorp, Inc.
+
+terraform import contextforge_user.example user-id
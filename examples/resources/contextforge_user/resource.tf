@@ -0,0 +1,7 @@
+# Copyright (c) HashiCorp, Inc.
+
+resource "contextforge_user" "example" {
+  email    = "jane@example.com"
+  role     = "member"
+  team_ids = ["team-platform"]
+}
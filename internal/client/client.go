@@ -6,30 +6,304 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Polling intervals used by the Wait* methods.
+var (
+	pollInitialInterval = 500 * time.Millisecond
+	pollMaxInterval     = 10 * time.Second
+)
+
+// Retry settings for transient request failures.
+var (
+	retryInitialInterval = 500 * time.Millisecond
+	retryMaxInterval     = 5 * time.Second
 )
 
+// RetryPolicy decides whether a request the gateway answered with statusCode
+// is re-sent, and how long to wait before doing so. attempt is the number of
+// retries already sent for the request, starting at 0.
+type RetryPolicy func(statusCode int, attempt int) (retry bool, wait time.Duration)
+
+// DefaultRetryStatusCodes are the statuses DefaultRetryPolicy retries.
+var DefaultRetryStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// DefaultRetryPolicy retries the statuses in DefaultRetryStatusCodes with
+// exponential backoff.
+func DefaultRetryPolicy(statusCode int, attempt int) (bool, time.Duration) {
+	return RetryOnStatus(DefaultRetryStatusCodes...)(statusCode, attempt)
+}
+
+// RetryOnStatus returns a RetryPolicy that retries responses with any of
+// statusCodes, e.g. a 409 from a backend that reports conflicts while it
+// settles, with the same exponential backoff as DefaultRetryPolicy.
+func RetryOnStatus(statusCodes ...int) RetryPolicy {
+	codes := slices.Clone(statusCodes)
+	return func(statusCode int, attempt int) (bool, time.Duration) {
+		if !slices.Contains(codes, statusCode) {
+			return false, 0
+		}
+		return true, retryBackoff(attempt)
+	}
+}
+
+// retryBackoff returns how long to wait before retry number attempt+1,
+// doubling from retryInitialInterval up to retryMaxInterval.
+func retryBackoff(attempt int) time.Duration {
+	wait := retryInitialInterval
+	for range attempt {
+		if wait >= retryMaxInterval {
+			break
+		}
+		wait *= 2
+	}
+	return min(wait, retryMaxInterval)
+}
+
+// Sleeper waits out the delays between the attempts of a request and between
+// polls. Tests substitute one that records the delays and returns at once, so
+// backoff can be asserted without waiting for it.
+type Sleeper interface {
+	// Sleep blocks for d or until ctx is done, returning ctx.Err() in the
+	// latter case.
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+// systemSleeper waits on the system clock, like time.Sleep but returning
+// early when ctx is done.
+type systemSleeper struct{}
+
+func (systemSleeper) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// DefaultMaxResponseBytes is the largest response body the client reads unless
+// MaxResponseBytes overrides it.
+const DefaultMaxResponseBytes int64 = 32 << 20
+
+// DefaultAuthScheme is the Authorization scheme used unless AuthScheme
+// overrides it.
+const DefaultAuthScheme = "Bearer"
+
+// DefaultAPIKeyHeader is the header an API key is sent in unless APIKeyHeader
+// overrides it.
+const DefaultAPIKeyHeader = "X-API-Key"
+
+// DefaultTraceHeader is the header a request's trace ID is sent in unless
+// TraceHeader overrides it.
+const DefaultTraceHeader = "X-Request-ID"
+
+// DefaultHealthPath is the path of the gateway health endpoint unless a
+// deployment overrides it.
+const DefaultHealthPath = "/health"
+
 // Client is the HTTP client for the ContextForge MCP Gateway API.
 type Client struct {
 	BaseURL     string
 	BearerToken string
 	HTTPClient  *http.Client
+
+	// AuthScheme is the scheme the token is sent with in the Authorization
+	// header, e.g. "Bearer" or "Token".
+	AuthScheme string
+
+	// APIKey, when set, is sent in the APIKeyHeader header on every request.
+	// It is an alternative to BearerToken for gateways that accept API keys.
+	APIKey       string
+	APIKeyHeader string
+
+	// MaxRetries is the number of times a request that failed with a transient
+	// error is re-sent. Only idempotent methods are retried, plus creates when
+	// IdempotencyKeys is set. Zero, the default, disables retries.
+	MaxRetries int
+
+	// RetryPolicy classifies the responses of requests that may be retried,
+	// deciding which statuses are transient for this gateway and how long to
+	// wait between attempts. MaxRetries still caps the number of retries.
+	// Requests that fail without a response, e.g. because the connection was
+	// refused, are retried with exponential backoff regardless. Nil means
+	// DefaultRetryPolicy.
+	RetryPolicy RetryPolicy
+
+	// Sleeper waits between retries and between the polls of
+	// WaitForServerActive and WaitForJob. Nil means the system clock.
+	Sleeper Sleeper
+
+	// IdempotencyKeys sends an Idempotency-Key header on create requests so
+	// the gateway can deduplicate retried creates. Other POST requests, such
+	// as tool invocations, never carry one.
+	IdempotencyKeys bool
+
+	// TraceHeader is the header every request carries a trace ID in, so
+	// gateway logs can be correlated with the provider's. Each request gets a
+	// new random ID, shared by its retries, unless TraceID is set. Empty
+	// disables the header.
+	TraceHeader string
+
+	// TraceID, when set, is sent as the trace ID of every request instead of
+	// a generated one, e.g. an ID propagated from the pipeline running
+	// Terraform.
+	TraceID string
+
+	// FallbackURLs are further base URLs of the same gateway, e.g. a standby
+	// behind its own URL. When the base URL in use cannot be connected to, a
+	// request moves on to the next one in order, starting from BaseURL, and
+	// the client keeps using the last one that answered.
+	FallbackURLs []string
+
+	// activeBase is the index, into BaseURL followed by FallbackURLs, of the
+	// base URL that last answered.
+	activeBase atomic.Int64
+
+	// HealthPath is the path GetHealth requests, relative to BaseURL.
+	HealthPath string
+
+	// MaxResponseBytes caps the size of a response body. Larger responses fail
+	// with ErrResponseTooLarge instead of being read into memory.
+	MaxResponseBytes int64
+
+	// DefaultVisibility is sent when creating a server, tool, resource, or
+	// prompt whose request leaves Visibility empty. Empty means the gateway
+	// applies its own default.
+	DefaultVisibility string
+
+	// DefaultHeaders are added to every request, e.g. headers required by a
+	// WAF in front of the gateway. Headers the client sets itself, such as
+	// Authorization and Content-Type, are never overridden.
+	DefaultHeaders map[string]string
+
+	// CacheTTL, when positive, keeps successful GET responses in memory for
+	// that long so repeated reads of the same path, e.g. a list data source
+	// and several single-object lookups, are served without a round trip.
+	// Any other request clears the cache.
+	CacheTTL time.Duration
+
+	cache responseCache
+
+	// etags remembers the ETag and decoded object of single-object reads so
+	// they can be revalidated with If-None-Match.
+	etags etagCache
+
+	// patchUnsupported is set once the gateway rejects a PATCH request, so
+	// later partial updates go straight to the PUT fallback.
+	patchUnsupported atomic.Bool
 }
 
 // NewClient creates a new ContextForge API client.
 func NewClient(baseURL, bearerToken string) *Client {
 	return &Client{
-		BaseURL:     strings.TrimRight(baseURL, "/"),
-		BearerToken: bearerToken,
-		HTTPClient:  &http.Client{},
+		BaseURL:          NormalizeBaseURL(baseURL),
+		BearerToken:      bearerToken,
+		HTTPClient:       &http.Client{},
+		AuthScheme:       DefaultAuthScheme,
+		TraceHeader:      DefaultTraceHeader,
+		RetryPolicy:      DefaultRetryPolicy,
+		Sleeper:          systemSleeper{},
+		HealthPath:       DefaultHealthPath,
+		MaxResponseBytes: DefaultMaxResponseBytes,
 	}
 }
 
+// DisableCompression stops the client from requesting gzip-encoded responses.
+// By default the transport advertises gzip and transparently decompresses
+// response bodies; disabling it is mainly useful when inspecting raw traffic.
+func (c *Client) DisableCompression() {
+	c.transport().DisableCompression = true
+}
+
+// SetMinTLSVersion makes the client refuse TLS connections below version, one
+// of the tls.VersionTLS* constants.
+func (c *Client) SetMinTLSVersion(version uint16) {
+	c.tlsConfig().MinVersion = version
+}
+
+// SetInsecureSkipVerify disables verification of the gateway's certificate
+// chain and host name. It should only be used against test gateways.
+func (c *Client) SetInsecureSkipVerify(skip bool) {
+	c.tlsConfig().InsecureSkipVerify = skip
+}
+
+// SetRootCAs makes the client trust only the certificates in pemCerts, a
+// PEM-encoded bundle, when verifying the gateway's certificate.
+func (c *Client) SetRootCAs(pemCerts []byte) error {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pemCerts) {
+		return errors.New("no PEM-encoded certificates found")
+	}
+	c.tlsConfig().RootCAs = pool
+	return nil
+}
+
+// tlsConfig returns the TLS configuration of the client's own transport,
+// creating it if necessary.
+func (c *Client) tlsConfig() *tls.Config {
+	transport := c.transport()
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	return transport.TLSClientConfig
+}
+
+// transport returns the client's own *http.Transport, replacing a nil or
+// shared transport with a clone of http.DefaultTransport so that settings
+// applied to it do not leak into other clients.
+func (c *Client) transport() *http.Transport {
+	if t, ok := c.HTTPClient.Transport.(*http.Transport); ok && t != http.DefaultTransport {
+		return t
+	}
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	c.HTTPClient.Transport = t
+	return t
+}
+
+// repeatedSlashes matches runs of path separators.
+var repeatedSlashes = regexp.MustCompile(`/{2,}`)
+
+// NormalizeBaseURL collapses repeated slashes in the path of baseURL and strips
+// any trailing slashes, so that joining API paths onto it never produces empty
+// path segments. Values that do not parse as URLs only have trailing slashes
+// stripped.
+func NormalizeBaseURL(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Opaque != "" {
+		return strings.TrimRight(baseURL, "/")
+	}
+	u.Path = strings.TrimRight(repeatedSlashes.ReplaceAllString(u.Path, "/"), "/")
+	u.RawPath = ""
+	return u.String()
+}
+
 // doRequest executes an HTTP request with authentication and returns the response body.
 func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) ([]byte, int, error) {
 	return c.doRequestWithQuery(ctx, method, path, nil, body)
@@ -37,135 +311,1087 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 
 // doRequestWithQuery executes an HTTP request with optional query parameters.
 func (c *Client) doRequestWithQuery(ctx context.Context, method, reqPath string, query map[string]string, body interface{}) ([]byte, int, error) {
-	reqURL, err := url.JoinPath(c.BaseURL, reqPath)
+	respBody, statusCode, _, err := c.do(ctx, method, reqPath, query, body)
+	return respBody, statusCode, err
+}
+
+// doWrite executes a create or update request. Some gateway versions answer
+// these with 204 No Content or another success status without a body; the
+// object is then fetched from the Location header or, for updates, from the
+// request path, so callers can always decode it. A create without a body or
+// Location header returns ErrEmptyResponse.
+func (c *Client) doWrite(ctx context.Context, method, reqPath string, body interface{}) ([]byte, int, error) {
+	respBody, statusCode, header, err := c.do(ctx, method, reqPath, nil, body)
+	return c.completeWrite(ctx, method, reqPath, respBody, statusCode, header, err)
+}
+
+// completeWrite finishes a write sent with do, fetching the object when the
+// response carries none, as described for doWrite.
+func (c *Client) completeWrite(ctx context.Context, method, reqPath string, respBody []byte, statusCode int, header http.Header, err error) ([]byte, int, error) {
+	if err != nil || statusCode < 200 || statusCode > 299 || len(bytes.TrimSpace(respBody)) > 0 {
+		return respBody, statusCode, err
+	}
+
+	objectPath := reqPath
+	if location := header.Get("Location"); location != "" {
+		objectPath, err = c.locationPath(location)
+		if err != nil {
+			return nil, statusCode, err
+		}
+	} else if method == http.MethodPost {
+		return nil, statusCode, fmt.Errorf("%w: %s %s returned status %d", ErrEmptyResponse, method, reqPath, statusCode)
+	}
+
+	tflog.Debug(ctx, "fetching object after empty write response", map[string]interface{}{
+		"method":      method,
+		"path":        reqPath,
+		"status_code": statusCode,
+		"object_path": objectPath,
+	})
+	return c.doRequest(ctx, http.MethodGet, objectPath, nil)
+}
+
+// createKey marks a context whose POST request creates an object, so it
+// carries an Idempotency-Key when IdempotencyKeys is set.
+type createKey struct{}
+
+// doCreate executes a create request like doWrite, sending an Idempotency-Key
+// when IdempotencyKeys is set so the create can be retried safely.
+func (c *Client) doCreate(ctx context.Context, reqPath string, body interface{}) ([]byte, int, error) {
+	return c.doWrite(context.WithValue(ctx, createKey{}, true), http.MethodPost, reqPath, body)
+}
+
+// getObject reads a single object with GET reqPath and decodes it as a T, or
+// returns nil when the gateway reports 404. kind names the object in errors.
+//
+// When an earlier read of reqPath returned an ETag, the request carries it in
+// If-None-Match and a 304 Not Modified answer reuses the object decoded then
+// instead of downloading and decoding it again. Such objects share maps and
+// slices with earlier results, so callers must treat them as read-only.
+func getObject[T any](ctx context.Context, c *Client, reqPath, kind string) (*T, error) {
+	etag, prior, hasPrior := c.etags.get(reqPath)
+	if hasPrior {
+		ctx = context.WithValue(ctx, ifNoneMatchKey{}, etag)
+	}
+
+	body, statusCode, header, err := c.do(ctx, http.MethodGet, reqPath, nil, nil)
 	if err != nil {
-		return nil, 0, fmt.Errorf("building request URL: %w", err)
+		return nil, err
+	}
+	if statusCode == http.StatusNotModified && hasPrior {
+		if obj, ok := prior.(T); ok {
+			tflog.Debug(ctx, "gateway object not modified", map[string]interface{}{"path": reqPath})
+			return &obj, nil
+		}
+	}
+	if statusCode == http.StatusNotFound {
+		c.etags.delete(reqPath)
+		return nil, nil
+	}
+	if statusCode != http.StatusOK {
+		return nil, unexpectedStatusError(statusCode, body)
+	}
+
+	var obj T
+	if err := json.Unmarshal(body, &obj); err != nil {
+		return nil, fmt.Errorf("decoding %s response: %w", kind, err)
+	}
+	if etag := header.Get("ETag"); etag != "" {
+		c.etags.put(reqPath, etag, obj)
+	} else {
+		c.etags.delete(reqPath)
+	}
+	return &obj, nil
+}
+
+// etagCache holds the ETag and decoded object of the latest read of each
+// object path. The zero value is an empty cache ready for use.
+type etagCache struct {
+	mu      sync.Mutex
+	entries map[string]etagEntry
+}
+
+type etagEntry struct {
+	etag  string
+	value interface{}
+}
+
+// get returns the ETag and object stored for key.
+func (ec *etagCache) get(key string) (string, interface{}, bool) {
+	ec.mu.Lock()
+	defer ec.mu.Unlock()
+
+	entry, ok := ec.entries[key]
+	return entry.etag, entry.value, ok
+}
+
+// put stores the ETag and decoded object read from key.
+func (ec *etagCache) put(key, etag string, value interface{}) {
+	ec.mu.Lock()
+	defer ec.mu.Unlock()
+
+	if ec.entries == nil {
+		ec.entries = make(map[string]etagEntry)
+	}
+	ec.entries[key] = etagEntry{etag: etag, value: value}
+}
+
+// delete drops the entry for key.
+func (ec *etagCache) delete(key string) {
+	ec.mu.Lock()
+	defer ec.mu.Unlock()
+
+	delete(ec.entries, key)
+}
+
+// ifNoneMatchKey carries the ETag a GET request sends in If-None-Match.
+type ifNoneMatchKey struct{}
+
+// traceIDKey carries the trace ID a request sends in TraceHeader.
+type traceIDKey struct{}
+
+// withTraceID returns ctx carrying the trace ID for one logical request, so
+// every attempt of it sends the same value, and adds the ID to the fields
+// logged for the request. ctx is returned unchanged when TraceHeader is empty.
+func (c *Client) withTraceID(ctx context.Context) (context.Context, error) {
+	if c.TraceHeader == "" {
+		return ctx, nil
 	}
+	traceID := c.TraceID
+	if traceID == "" {
+		var err error
+		traceID, err = newUUID()
+		if err != nil {
+			return ctx, fmt.Errorf("generating trace ID: %w", err)
+		}
+	}
+	ctx = context.WithValue(ctx, traceIDKey{}, traceID)
+	return tflog.SetField(ctx, "trace_id", traceID), nil
+}
 
-	if len(query) > 0 {
-		parsedURL, err := url.Parse(reqURL)
+// ErrEmptyResponse is returned when the gateway acknowledges a create without
+// returning the object or saying where to find it.
+var ErrEmptyResponse = errors.New("gateway returned no object and no Location header")
+
+// locationPath converts a Location header into an escaped path relative to
+// the gateway's base URL. Relative locations are resolved against the base URL
+// in use; absolute locations may point at any of the configured base URLs.
+// Locations outside them are rejected rather than sending credentials to
+// another host.
+func (c *Client) locationPath(location string) (string, error) {
+	bases := c.baseURLs()
+	active := c.activeBaseIndex()
+	for i := range bases {
+		base, err := url.Parse(bases[(active+i)%len(bases)] + "/")
 		if err != nil {
-			return nil, 0, fmt.Errorf("parsing request URL: %w", err)
+			return "", fmt.Errorf("parsing base URL: %w", err)
 		}
-		q := parsedURL.Query()
-		for k, v := range query {
-			q.Set(k, v)
+		loc, err := base.Parse(location)
+		if err != nil {
+			return "", fmt.Errorf("parsing Location header %q: %w", location, err)
+		}
+		if loc.Scheme == base.Scheme && loc.Host == base.Host && strings.HasPrefix(loc.EscapedPath(), base.EscapedPath()) {
+			return "/" + strings.TrimPrefix(loc.EscapedPath(), base.EscapedPath()), nil
 		}
-		parsedURL.RawQuery = q.Encode()
-		reqURL = parsedURL.String()
 	}
+	return "", fmt.Errorf("location %q is outside the gateway endpoint %s", location, c.BaseURL)
+}
 
-	var reqBody io.Reader
+// baseURLs returns BaseURL followed by FallbackURLs.
+func (c *Client) baseURLs() []string {
+	return append([]string{c.BaseURL}, c.FallbackURLs...)
+}
+
+// activeBaseIndex returns the index into baseURLs of the base URL that last
+// answered, falling back to BaseURL if FallbackURLs has since shrunk.
+func (c *Client) activeBaseIndex() int {
+	active := int(c.activeBase.Load())
+	if active > len(c.FallbackURLs) {
+		return 0
+	}
+	return active
+}
+
+// requestURL joins reqPath and the query parameters onto base.
+func requestURL(base, reqPath string, query map[string]string) (string, error) {
+	reqURL, err := url.JoinPath(base, reqPath)
+	if err != nil {
+		return "", fmt.Errorf("building request URL: %w", err)
+	}
+	if len(query) == 0 {
+		return reqURL, nil
+	}
+
+	parsedURL, err := url.Parse(reqURL)
+	if err != nil {
+		return "", fmt.Errorf("parsing request URL: %w", err)
+	}
+	q := parsedURL.Query()
+	for k, v := range query {
+		q.Set(k, v)
+	}
+	parsedURL.RawQuery = q.Encode()
+	return parsedURL.String(), nil
+}
+
+// sendWithFailover performs one attempt of a request. It starts at the base
+// URL that last answered and, when that one cannot be connected to, tries the
+// others in order. A request that was never delivered is safe to send again
+// whatever its method. The attempt fails with the last connection error when
+// no base URL can be reached, which do may then retry.
+func (c *Client) sendWithFailover(ctx context.Context, method, reqPath string, query map[string]string, body *requestBody, idempotencyKey string) ([]byte, int, http.Header, error) {
+	bases := c.baseURLs()
+	active := c.activeBaseIndex()
+	var err error
+	for i := range bases {
+		index := (active + i) % len(bases)
+		var reqURL string
+		reqURL, err = requestURL(bases[index], reqPath, query)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+
+		var respBody []byte
+		var statusCode int
+		var header http.Header
+		respBody, statusCode, header, err = c.send(ctx, method, reqURL, body, idempotencyKey)
+		if err == nil {
+			if index != active {
+				c.activeBase.Store(int64(index))
+				tflog.Warn(ctx, "switched to another gateway endpoint", map[string]interface{}{
+					"from": bases[active],
+					"to":   bases[index],
+				})
+			}
+			return respBody, statusCode, header, nil
+		}
+		if ctx.Err() != nil || !isConnectError(err) {
+			return respBody, statusCode, header, err
+		}
+		tflog.Debug(ctx, "gateway endpoint unreachable", map[string]interface{}{
+			"endpoint": bases[index],
+			"error":    err.Error(),
+		})
+	}
+	return nil, 0, nil, err
+}
+
+// isConnectError reports whether err means no connection to the gateway could
+// be established, e.g. a refused connection or a failed DNS lookup, so the
+// request was never delivered.
+func isConnectError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// do executes an HTTP request and also returns the response headers. The
+// request is bound to ctx, so canceling it aborts an in-flight request and the
+// returned error wraps the context error. Transient failures are retried up to
+// MaxRetries times when the request is safe to repeat.
+func (c *Client) do(ctx context.Context, method, reqPath string, query map[string]string, body interface{}) ([]byte, int, http.Header, error) {
+	// Cached responses are keyed by their URL on BaseURL, whichever base URL
+	// served them.
+	reqURL, err := requestURL(c.BaseURL, reqPath, query)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	// Writes invalidate cached reads once they finish, whether or not they
+	// succeeded, since a failed request may still have changed the gateway.
+	if method != http.MethodGet && ctx.Value(noSideEffectsKey{}) == nil {
+		defer c.cache.invalidate()
+	}
+	cacheable := method == http.MethodGet && c.CacheTTL > 0
+	var generation uint64
+	if cacheable {
+		cached, gen, ok := c.cache.get(reqURL)
+		if ok && ctx.Value(bypassCacheKey{}) == nil {
+			tflog.Debug(ctx, "gateway response served from cache", map[string]interface{}{"path": reqPath})
+			return cached, http.StatusOK, nil, nil
+		}
+		generation = gen
+	}
+
+	var reqBody *requestBody
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		reqBody, err = newRequestBody(body)
 		if err != nil {
-			return nil, 0, fmt.Errorf("marshaling request body: %w", err)
+			return nil, 0, nil, fmt.Errorf("marshaling request body: %w", err)
+		}
+	}
+
+	// The key is generated once per logical request so every retry of it
+	// carries the same value.
+	idempotencyKey := ""
+	if method == http.MethodPost && c.IdempotencyKeys && ctx.Value(createKey{}) != nil {
+		idempotencyKey, err = newUUID()
+		if err != nil {
+			return nil, 0, nil, fmt.Errorf("generating idempotency key: %w", err)
+		}
+	}
+	retryable := isIdempotentMethod(method) || idempotencyKey != ""
+
+	ctx, err = c.withTraceID(ctx)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	start := time.Now()
+	for attempt := 0; ; attempt++ {
+		respBody, statusCode, header, err := c.sendWithFailover(ctx, method, reqPath, query, reqBody, idempotencyKey)
+		retry, wait := false, time.Duration(0)
+		if retryable && attempt < c.MaxRetries {
+			retry, wait = c.shouldRetry(ctx, statusCode, attempt, err)
+		}
+		if !retry {
+			if err == nil {
+				err = nonJSONResponseError(statusCode, header.Get("Content-Type"), respBody)
+			}
+			logRequestSummary(ctx, method, reqPath, attempt+1, time.Since(start), statusCode, err)
+			if cacheable && err == nil && statusCode == http.StatusOK {
+				c.cache.put(reqURL, respBody, generation, time.Now().Add(c.CacheTTL))
+			}
+			return respBody, statusCode, header, err
+		}
+
+		totalRetries.Add(1)
+		if err := c.sleep(ctx, wait); err != nil {
+			err = fmt.Errorf("executing request: %w", err)
+			logRequestSummary(ctx, method, reqPath, attempt+1, time.Since(start), 0, err)
+			return nil, 0, nil, err
 		}
-		reqBody = bytes.NewBuffer(jsonBody)
 	}
+}
+
+// sleep waits for d with the client's Sleeper.
+func (c *Client) sleep(ctx context.Context, d time.Duration) error {
+	if c.Sleeper == nil {
+		return systemSleeper{}.Sleep(ctx, d)
+	}
+	return c.Sleeper.Sleep(ctx, d)
+}
+
+// responseCache holds GET response bodies keyed by request URL. The zero value
+// is an empty cache ready for use.
+type responseCache struct {
+	mu         sync.Mutex
+	generation uint64
+	entries    map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	body    []byte
+	expires time.Time
+}
 
-	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+// get returns the cached body for key if it has not expired, together with the
+// current generation to pass to put when the body has to be fetched.
+func (rc *responseCache) get(key string) ([]byte, uint64, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entry, ok := rc.entries[key]
+	if ok && time.Now().Before(entry.expires) {
+		return entry.body, rc.generation, true
+	}
+	if ok {
+		delete(rc.entries, key)
+	}
+	return nil, rc.generation, false
+}
+
+// put stores body for key unless the cache was invalidated since generation
+// was read, so a read that raced with a write cannot re-cache stale data.
+func (rc *responseCache) put(key string, body []byte, generation uint64, expires time.Time) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if generation != rc.generation {
+		return
+	}
+	if rc.entries == nil {
+		rc.entries = make(map[string]cacheEntry)
+	}
+	rc.entries[key] = cacheEntry{body: body, expires: expires}
+}
+
+// invalidate drops every cached response.
+func (rc *responseCache) invalidate() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.generation++
+	rc.entries = nil
+}
+
+// bypassCacheKey marks a context whose GET requests must reach the gateway,
+// e.g. while polling for a status change. Their responses are still cached.
+type bypassCacheKey struct{}
+
+// noSideEffectsKey marks a context whose POST request leaves the gateway
+// unchanged, e.g. a validation, so it keeps the cached reads.
+type noSideEffectsKey struct{}
+
+// totalRetries counts the retries sent by all clients in the process.
+var totalRetries atomic.Int64
+
+// logRequestSummary records at debug level how many attempts a request took
+// and how long it ran in total, so retry behavior can be tuned from logs.
+func logRequestSummary(ctx context.Context, method, reqPath string, attempts int, elapsed time.Duration, statusCode int, err error) {
+	fields := map[string]interface{}{
+		"method":     method,
+		"path":       reqPath,
+		"attempts":   attempts,
+		"retries":    attempts - 1,
+		"elapsed_ms": elapsed.Milliseconds(),
+	}
+	if statusCode != 0 {
+		fields["status_code"] = statusCode
+	}
 	if err != nil {
-		return nil, 0, fmt.Errorf("creating request: %w", err)
+		fields["error"] = err.Error()
 	}
+	tflog.Debug(ctx, "gateway request finished", fields)
+}
+
+// streamBodyThreshold is the encoded size above which a request body is
+// streamed to the gateway instead of being held in memory for the whole
+// request, e.g. a tool with a very large input_schema.
+const streamBodyThreshold = 1 << 20
+
+// requestBody is the JSON body of a request. Bodies up to streamBodyThreshold
+// are encoded once and replayed from memory on every retry; larger bodies are
+// encoded again for each attempt and streamed through a pipe, so only the
+// value they are encoded from stays in memory between attempts.
+type requestBody struct {
+	encoded []byte
+	value   interface{}
+}
 
+// errBodyOverThreshold stops the encoding of a body that has to be streamed.
+var errBodyOverThreshold = errors.New("request body exceeds the streaming threshold")
+
+// thresholdWriter keeps what is written to it up to streamBodyThreshold bytes
+// and fails once that is exceeded, dropping everything written so far.
+type thresholdWriter struct {
+	buf []byte
+}
+
+func (w *thresholdWriter) Write(p []byte) (int, error) {
+	if len(w.buf)+len(p) > streamBodyThreshold {
+		w.buf = nil
+		return 0, errBodyOverThreshold
+	}
+	w.buf = append(w.buf, p...)
+	return len(p), nil
+}
+
+// newRequestBody encodes value, keeping the result when it is small enough to
+// replay from memory.
+func newRequestBody(value interface{}) (*requestBody, error) {
+	var w thresholdWriter
+	err := json.NewEncoder(&w).Encode(value)
+	if errors.Is(err, errBodyOverThreshold) {
+		return &requestBody{value: value}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &requestBody{encoded: bytes.TrimSuffix(w.buf, []byte("\n"))}, nil
+}
+
+// reader returns a fresh reader over the body for one attempt. A streamed
+// body is encoded by a goroutine that stops when the transport closes the
+// reader, including when the request fails before the body is sent.
+func (b *requestBody) reader() io.ReadCloser {
+	if b.value == nil {
+		return io.NopCloser(bytes.NewReader(b.encoded))
+	}
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(json.NewEncoder(pw).Encode(b.value))
+	}()
+	return pr
+}
+
+// send performs a single HTTP attempt.
+func (c *Client) send(ctx context.Context, method, reqURL string, body *requestBody, idempotencyKey string) ([]byte, int, http.Header, error) {
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, nil)
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("creating request: %w", err)
+	}
+	if body != nil {
+		req.Body = body.reader()
+		req.GetBody = func() (io.ReadCloser, error) { return body.reader(), nil }
+		if body.value == nil {
+			req.ContentLength = int64(len(body.encoded))
+		} else {
+			// The length is unknown, so the body is sent chunked.
+			req.ContentLength = -1
+		}
+	}
+
+	for name, value := range c.DefaultHeaders {
+		if IsReservedHeader(name) {
+			continue
+		}
+		req.Header.Set(name, value)
+	}
+	if traceID, ok := ctx.Value(traceIDKey{}).(string); ok && c.TraceHeader != "" {
+		req.Header.Set(c.TraceHeader, traceID)
+	}
+	if c.APIKey != "" {
+		header := c.APIKeyHeader
+		if header == "" {
+			header = DefaultAPIKeyHeader
+		}
+		req.Header.Set(header, c.APIKey)
+	}
 	if c.BearerToken != "" {
-		req.Header.Set("Authorization", "Bearer "+c.BearerToken)
+		scheme := c.AuthScheme
+		if scheme == "" {
+			scheme = DefaultAuthScheme
+		}
+		req.Header.Set("Authorization", scheme+" "+c.BearerToken)
 	}
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	if etag, ok := ctx.Value(ifNoneMatchKey{}).(string); ok && method == http.MethodGet {
+		req.Header.Set("If-None-Match", etag)
+	}
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, 0, fmt.Errorf("executing request: %w", err)
+		return nil, 0, nil, fmt.Errorf("executing request: %w", err)
 	}
 	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
+	limit := c.MaxResponseBytes
+	if limit <= 0 {
+		limit = DefaultMaxResponseBytes
+	}
+	// Read one byte past the limit to tell a body of exactly limit bytes from
+	// an oversized one.
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, resp.StatusCode, nil, fmt.Errorf("reading response body: %w", err)
+	}
+	if int64(len(respBody)) > limit {
+		return nil, resp.StatusCode, nil, fmt.Errorf("reading response body: %w (limit %d bytes)", ErrResponseTooLarge, limit)
+	}
+
+	return respBody, resp.StatusCode, resp.Header, nil
+}
+
+// maxErrorBodyBytes is how much of a non-JSON response body is kept in the
+// returned error.
+const maxErrorBodyBytes = 512
+
+// ErrUnexpectedContentType is returned when a success response carries a body
+// that is not JSON, such as a sign-in page served by a proxy in front of the
+// gateway.
+var ErrUnexpectedContentType = errors.New("unexpected content type")
+
+// nonJSONResponseError returns an error when a response carries a body that is
+// not JSON, so callers report a readable excerpt instead of a JSON parse error:
+// an *APIError for an error status, such as an HTML error page from a proxy,
+// and ErrUnexpectedContentType for a 2xx status. 404 responses are left to the
+// callers, which treat them as missing objects whatever the body.
+func nonJSONResponseError(statusCode int, contentType string, body []byte) error {
+	trimmed := bytes.TrimSpace(body)
+	if statusCode == http.StatusNotFound || len(trimmed) == 0 {
+		return nil
+	}
+	if strings.Contains(strings.ToLower(contentType), "json") || trimmed[0] == '{' || trimmed[0] == '[' {
+		return nil
+	}
+
+	excerpt := truncateBody(trimmed)
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	if mediaType == "" {
+		mediaType = "unknown content type"
+	}
+	mediaType = strings.TrimSpace(mediaType)
+	if statusCode >= 200 && statusCode < 300 {
+		return fmt.Errorf("%w (%s): %s", ErrUnexpectedContentType, mediaType, excerpt)
+	}
+	return &APIError{
+		StatusCode: statusCode,
+		Body:       excerpt,
+		Detail:     fmt.Sprintf("non-JSON response (%s): %s", mediaType, excerpt),
+	}
+}
+
+// truncateBody shortens body to maxErrorBodyBytes for inclusion in an error.
+func truncateBody(body []byte) string {
+	if len(body) <= maxErrorBodyBytes {
+		return string(body)
+	}
+	return strings.ToValidUTF8(string(body[:maxErrorBodyBytes]), "") + fmt.Sprintf("... (truncated, %d bytes total)", len(body))
+}
+
+// ErrResponseTooLarge is returned when a response body exceeds the client's
+// MaxResponseBytes.
+var ErrResponseTooLarge = errors.New("response body too large")
+
+// IsReservedHeader reports whether name is a header the client manages itself
+// and that DefaultHeaders therefore cannot set.
+func IsReservedHeader(name string) bool {
+	switch http.CanonicalHeaderKey(name) {
+	case "Authorization", "Content-Type", "Idempotency-Key", "If-None-Match":
+		return true
+	}
+	return false
+}
+
+// isIdempotentMethod reports whether repeating a request with method has the
+// same effect as sending it once.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// shouldRetry reports whether an attempt is re-sent and how long to wait
+// first. Responses are classified by RetryPolicy; attempts that failed without
+// one by isRetryableError.
+func (c *Client) shouldRetry(ctx context.Context, statusCode, attempt int, err error) (bool, time.Duration) {
+	if err != nil {
+		return isRetryableError(ctx, err), retryBackoff(attempt)
+	}
+	policy := c.RetryPolicy
+	if policy == nil {
+		policy = DefaultRetryPolicy
+	}
+	retry, wait := policy(statusCode, attempt)
+	return retry, max(wait, 0)
+}
+
+// isRetryableError reports whether an attempt that failed without a response
+// failed transiently. Errors caused by ctx being canceled or expiring, and
+// oversized responses, are never retried.
+func isRetryableError(ctx context.Context, err error) bool {
+	if errors.Is(err, ErrResponseTooLarge) {
+		return false
+	}
+	return ctx.Err() == nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+// newUUID returns a random version 4 UUID.
+func newUUID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
+
+// ForbiddenError is returned when the gateway rejects a request with
+// 403 Forbidden, typically because the bearer token lacks the required scopes.
+type ForbiddenError struct {
+	Body string
+}
+
+func (e *ForbiddenError) Error() string {
+	return fmt.Sprintf("forbidden (status 403): %s", e.Body)
+}
+
+// APIError is returned when the gateway answers with an unexpected status
+// code other than 403 Forbidden.
+type APIError struct {
+	StatusCode int
+	Body       string
+
+	// Detail is the human-readable message extracted from the response body,
+	// or empty if the body carries none.
+	Detail string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("unexpected status code %d: %s", e.StatusCode, e.Body)
+}
+
+// unexpectedStatusError builds the error returned for a non-success status code.
+func unexpectedStatusError(statusCode int, body []byte) error {
+	if statusCode == http.StatusForbidden {
+		return &ForbiddenError{Body: string(body)}
+	}
+	return &APIError{StatusCode: statusCode, Body: string(body), Detail: errorDetail(body)}
+}
+
+// errorDetail extracts the message from an error response body. It understands
+// {"detail": "..."}, validation errors of the form
+// {"detail": [{"loc": [...], "msg": "..."}]}, and {"message": "..."}.
+func errorDetail(body []byte) string {
+	var payload struct {
+		Detail  json.RawMessage `json:"detail"`
+		Message string          `json:"message"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return ""
+	}
+
+	var detail string
+	if err := json.Unmarshal(payload.Detail, &detail); err == nil && detail != "" {
+		return detail
+	}
+
+	var validation []struct {
+		Loc []interface{} `json:"loc"`
+		Msg string        `json:"msg"`
+	}
+	if err := json.Unmarshal(payload.Detail, &validation); err == nil && len(validation) > 0 {
+		messages := make([]string, 0, len(validation))
+		for _, v := range validation {
+			loc := make([]string, 0, len(v.Loc))
+			for _, part := range v.Loc {
+				loc = append(loc, fmt.Sprint(part))
+			}
+			if len(loc) > 0 {
+				messages = append(messages, strings.Join(loc, ".")+": "+v.Msg)
+			} else {
+				messages = append(messages, v.Msg)
+			}
+		}
+		return strings.Join(messages, "; ")
+	}
+
+	return payload.Message
+}
+
+// listQuery builds the query parameters shared by all list endpoints.
+func listQuery(includeInactive bool) map[string]string {
+	return map[string]string{
+		"include_inactive": strconv.FormatBool(includeInactive),
+	}
+}
+
+// listEnvelope is the object-wrapped form some gateway versions return for
+// list endpoints, e.g. {"data": [...], "total": n}.
+type listEnvelope struct {
+	Data  json.RawMessage `json:"data"`
+	Total int             `json:"total"`
+}
+
+// decodeList decodes a list response body into out, accepting either a bare
+// JSON array or an object envelope carrying the array under "data".
+func decodeList(body []byte, out interface{}) error {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		return json.Unmarshal(body, out)
+	}
+
+	var envelope listEnvelope
+	if err := json.Unmarshal(trimmed, &envelope); err != nil {
+		return err
+	}
+	if envelope.Data == nil {
+		return fmt.Errorf("list response object has no data field")
+	}
+	return json.Unmarshal(envelope.Data, out)
+}
+
+// ErrPatchNotSupported is returned by the Patch* methods when the gateway does
+// not accept PATCH requests. Callers fall back to the matching Update* method.
+var ErrPatchNotSupported = errors.New("gateway does not support PATCH")
+
+// MergePatch returns a JSON merge patch (RFC 7386) of the top-level fields of
+// planned whose JSON encoding differs from prior. Fields planned omits, such
+// as empty omitempty fields, are left out rather than set to null, so they are
+// left unchanged just as with a full update.
+func MergePatch(prior, planned interface{}) (map[string]json.RawMessage, error) {
+	priorFields, err := jsonFields(prior)
 	if err != nil {
-		return nil, resp.StatusCode, fmt.Errorf("reading response body: %w", err)
+		return nil, err
+	}
+	plannedFields, err := jsonFields(planned)
+	if err != nil {
+		return nil, err
+	}
+
+	patch := make(map[string]json.RawMessage)
+	for name, value := range plannedFields {
+		if old, ok := priorFields[name]; !ok || !bytes.Equal(old, value) {
+			patch[name] = value
+		}
+	}
+	return patch, nil
+}
+
+// jsonFields encodes v and splits the resulting JSON object into its fields.
+func jsonFields(v interface{}) (map[string]json.RawMessage, error) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling update: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return nil, fmt.Errorf("splitting update fields: %w", err)
+	}
+	return fields, nil
+}
+
+// patch sends a PATCH request with the given merge patch to reqPath and
+// decodes the response into out. It returns ErrPatchNotSupported when the
+// gateway answers 405 or 501, and remembers that for later calls.
+func (c *Client) patch(ctx context.Context, reqPath string, changes map[string]json.RawMessage, out interface{}) error {
+	if c.patchUnsupported.Load() {
+		return ErrPatchNotSupported
+	}
+
+	body, statusCode, err := c.doWrite(ctx, http.MethodPatch, reqPath, changes)
+	if err != nil {
+		return err
+	}
+	if statusCode == http.StatusMethodNotAllowed || statusCode == http.StatusNotImplemented {
+		c.patchUnsupported.Store(true)
+		return ErrPatchNotSupported
+	}
+	if statusCode != http.StatusOK {
+		return unexpectedStatusError(statusCode, body)
 	}
 
-	return respBody, resp.StatusCode, nil
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("decoding patch response: %w", err)
+	}
+	return nil
 }
 
-// HealthResponse represents the response from GET /health.
+// HealthResponse represents the response from GET /health. Fields other than
+// Status are optional and only reported by some gateway versions.
 type HealthResponse struct {
-	Status string `json:"status"`
+	Status        string                           `json:"status"`
+	Version       string                           `json:"version,omitempty"`
+	UptimeSeconds *float64                         `json:"uptime_seconds,omitempty"`
+	Components    map[string]HealthComponentStatus `json:"components,omitempty"`
 }
 
-// GetHealth calls GET /health (no auth required).
+// HealthComponentStatus is the status of a single component check. The gateway
+// reports it either as a bare string or as an object with a status field.
+type HealthComponentStatus string
+
+func (s *HealthComponentStatus) UnmarshalJSON(data []byte) error {
+	var status string
+	if err := json.Unmarshal(data, &status); err == nil {
+		*s = HealthComponentStatus(status)
+		return nil
+	}
+
+	var check struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(data, &check); err != nil {
+		return fmt.Errorf("decoding component status: %w", err)
+	}
+	*s = HealthComponentStatus(check.Status)
+	return nil
+}
+
+// ErrNotGateway is returned by GetHealth when the health endpoint answers with
+// a body that is not a gateway health response, e.g. an HTML page served by an
+// unrelated service the endpoint points at by mistake.
+var ErrNotGateway = errors.New("response does not look like a ContextForge gateway health check")
+
+// GetHealth calls GET on HealthPath, /health by default (no auth required).
 func (c *Client) GetHealth(ctx context.Context) (*HealthResponse, error) {
-	body, statusCode, err := c.doRequest(ctx, http.MethodGet, "/health", nil)
+	body, statusCode, err := c.doRequest(ctx, http.MethodGet, c.healthPath(), nil)
+	return decodeHealth(body, statusCode, err)
+}
+
+// ProbeHealth is GetHealth sent as a single GET on HealthPath of the base URL
+// in use: the request bypasses the response cache and is neither retried nor
+// failed over, so an unreachable gateway fails after one attempt.
+func (c *Client) ProbeHealth(ctx context.Context) (*HealthResponse, error) {
+	reqURL, err := requestURL(c.baseURLs()[c.activeBaseIndex()], c.healthPath(), nil)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err = c.withTraceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body, statusCode, header, err := c.send(ctx, http.MethodGet, reqURL, nil, "")
+	if err == nil {
+		err = nonJSONResponseError(statusCode, header.Get("Content-Type"), body)
+	}
+	return decodeHealth(body, statusCode, err)
+}
+
+// healthPath returns HealthPath, or DefaultHealthPath if it is unset.
+func (c *Client) healthPath() string {
+	if c.HealthPath == "" {
+		return DefaultHealthPath
+	}
+	return c.HealthPath
+}
+
+// decodeHealth decodes the response to a health request that returned body,
+// statusCode and err.
+func decodeHealth(body []byte, statusCode int, err error) (*HealthResponse, error) {
+	if errors.Is(err, ErrUnexpectedContentType) {
+		// A successful response that is not JSON, e.g. a web page, does not
+		// come from a gateway.
+		return nil, fmt.Errorf("%w: %w", ErrNotGateway, err)
+	}
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var result HealthResponse
 	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, fmt.Errorf("decoding health response: %w", err)
+		return nil, fmt.Errorf("decoding health response: %w: %w", ErrNotGateway, err)
 	}
 	return &result, nil
 }
 
+// Ping times a single GET on HealthPath of the base URL in use and returns the
+// round-trip latency, including reading the response. The request bypasses the
+// response cache and is neither retried nor failed over, so the duration
+// reflects one round trip. A gateway that cannot be reached, or that answers
+// with a status other than 200, returns an error instead of a duration.
+func (c *Client) Ping(ctx context.Context) (time.Duration, error) {
+	reqURL, err := requestURL(c.baseURLs()[c.activeBaseIndex()], c.healthPath(), nil)
+	if err != nil {
+		return 0, err
+	}
+	ctx, err = c.withTraceID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+	body, statusCode, _, err := c.send(ctx, http.MethodGet, reqURL, nil, "")
+	latency := time.Since(start)
+	if err != nil {
+		return 0, err
+	}
+	if statusCode != http.StatusOK {
+		return 0, unexpectedStatusError(statusCode, body)
+	}
+	return latency, nil
+}
+
+// OpenAPISchema is the gateway's own OpenAPI document.
+type OpenAPISchema struct {
+	// Raw is the document exactly as the gateway returned it.
+	Raw json.RawMessage
+
+	// OpenAPIVersion is the OpenAPI specification version the document
+	// follows, e.g. "3.1.0".
+	OpenAPIVersion string
+
+	// Version is the version of the gateway API the document describes.
+	Version string
+}
+
+// GetOpenAPISchema calls GET /openapi.json.
+func (c *Client) GetOpenAPISchema(ctx context.Context) (*OpenAPISchema, error) {
+	body, statusCode, err := c.doRequest(ctx, http.MethodGet, "/openapi.json", nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return nil, unexpectedStatusError(statusCode, body)
+	}
+
+	var doc struct {
+		OpenAPI string `json:"openapi"`
+		Info    struct {
+			Version string `json:"version"`
+		} `json:"info"`
+	}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, fmt.Errorf("decoding OpenAPI schema: %w", err)
+	}
+	return &OpenAPISchema{
+		Raw:            json.RawMessage(body),
+		OpenAPIVersion: doc.OpenAPI,
+		Version:        doc.Info.Version,
+	}, nil
+}
+
 // ServerConfig represents the server configuration in create/update requests.
 type ServerConfig struct {
 	Name        string   `json:"name"`
 	Description string   `json:"description,omitempty"`
 	Tags        []string `json:"tags,omitempty"`
+	ToolIDs     []string `json:"tool_ids,omitempty"`
+	ResourceIDs []string `json:"resource_ids,omitempty"`
+	PromptIDs   []string `json:"prompt_ids,omitempty"`
+	IsActive    *bool    `json:"is_active,omitempty"`
 }
 
 // CreateServerRequest represents the request body for POST /servers.
 type CreateServerRequest struct {
 	Server     ServerConfig `json:"server"`
 	Visibility string       `json:"visibility,omitempty"`
+	TeamID     string       `json:"team_id,omitempty"`
 }
 
-// Server represents a server returned by the API.
+// Server represents a server returned by the API. TeamID is nil when the
+// response leaves it out.
 type Server struct {
 	ID          string   `json:"id"`
 	Name        string   `json:"name"`
 	Description string   `json:"description,omitempty"`
 	Tags        []string `json:"tags,omitempty"`
 	ToolIDs     []string `json:"tool_ids,omitempty"`
+	ResourceIDs []string `json:"resource_ids,omitempty"`
+	PromptIDs   []string `json:"prompt_ids,omitempty"`
 	Visibility  string   `json:"visibility,omitempty"`
+	TeamID      *string  `json:"team_id,omitempty"`
 	IsActive    bool     `json:"is_active"`
+	Status      string   `json:"status,omitempty"`
 	CreatedAt   string   `json:"created_at,omitempty"`
 	UpdatedAt   string   `json:"updated_at,omitempty"`
+	CreatedBy   string   `json:"created_by,omitempty"`
 }
 
+// ServerStatusActive is the status a server reports once it is ready to serve.
+const ServerStatusActive = "active"
+
 // ListServers calls GET /servers.
 func (c *Client) ListServers(ctx context.Context, includeInactive bool) ([]Server, error) {
 	path := "/servers"
-	body, statusCode, err := c.doRequestWithQuery(ctx, http.MethodGet, path, map[string]string{
-		"include_inactive": fmt.Sprintf("%t", includeInactive),
-	}, nil)
+	body, statusCode, err := c.doRequestWithQuery(ctx, http.MethodGet, path, listQuery(includeInactive), nil)
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var servers []Server
-	if err := json.Unmarshal(body, &servers); err != nil {
+	if err := decodeList(body, &servers); err != nil {
 		return nil, fmt.Errorf("decoding servers response: %w", err)
 	}
 	return servers, nil
 }
 
-// CreateServer calls POST /servers.
+// CreateServer calls POST /servers. An empty Visibility is replaced by
+// DefaultVisibility.
 func (c *Client) CreateServer(ctx context.Context, req CreateServerRequest) (*Server, error) {
-	body, statusCode, err := c.doRequest(ctx, http.MethodPost, "/servers", req)
+	if req.Visibility == "" {
+		req.Visibility = c.DefaultVisibility
+	}
+	body, statusCode, err := c.doCreate(ctx, "/servers", req)
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK && statusCode != http.StatusCreated {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var server Server
@@ -177,52 +1403,119 @@ func (c *Client) CreateServer(ctx context.Context, req CreateServerRequest) (*Se
 
 // GetServer calls GET /servers/{id}.
 func (c *Client) GetServer(ctx context.Context, id string) (*Server, error) {
-	body, statusCode, err := c.doRequest(ctx, http.MethodGet, "/servers/"+url.PathEscape(id), nil)
-	if err != nil {
-		return nil, err
+	return getObject[Server](ctx, c, "/servers/"+url.PathEscape(id), "server")
+}
+
+// DeleteServer calls DELETE /servers/{id}. When force is set, the request
+// carries force=true so the gateway also detaches or removes dependents.
+func (c *Client) DeleteServer(ctx context.Context, id string, force bool) error {
+	var query map[string]string
+	if force {
+		query = map[string]string{"force": "true"}
 	}
-	if statusCode == http.StatusNotFound {
-		return nil, nil
+	body, statusCode, err := c.doRequestWithQuery(ctx, http.MethodDelete, "/servers/"+url.PathEscape(id), query, nil)
+	if err != nil {
+		return err
 	}
-	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+	if statusCode != http.StatusOK && statusCode != http.StatusNoContent && statusCode != http.StatusNotFound {
+		return unexpectedStatusError(statusCode, body)
 	}
+	return nil
+}
 
-	var server Server
-	if err := json.Unmarshal(body, &server); err != nil {
-		return nil, fmt.Errorf("decoding server response: %w", err)
+// WaitForServerActive polls GET /servers/{id} with exponential backoff until the
+// server reports an active status or ctx is done. Servers from gateways that do
+// not report a status are treated as active.
+func (c *Client) WaitForServerActive(ctx context.Context, id string) (*Server, error) {
+	ctx = context.WithValue(ctx, bypassCacheKey{}, true)
+	delay := pollInitialInterval
+	for {
+		server, err := c.GetServer(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if server == nil {
+			return nil, fmt.Errorf("server %s not found while waiting for it to become active", id)
+		}
+		if server.Status == "" || server.Status == ServerStatusActive {
+			return server, nil
+		}
+
+		if err := c.sleep(ctx, delay); err != nil {
+			return nil, fmt.Errorf("waiting for server %s to become active (last status %q): %w", id, server.Status, err)
+		}
+
+		delay *= 2
+		if delay > pollMaxInterval {
+			delay = pollMaxInterval
+		}
 	}
-	return &server, nil
 }
 
-// DeleteServer calls DELETE /servers/{id}.
-func (c *Client) DeleteServer(ctx context.Context, id string) error {
-	body, statusCode, err := c.doRequest(ctx, http.MethodDelete, "/servers/"+url.PathEscape(id), nil)
+// ListServerTools calls GET /servers/{id}/tools, which lists the tools
+// associated with a server. It returns nil if the server does not exist.
+func (c *Client) ListServerTools(ctx context.Context, id string) ([]Tool, error) {
+	return listServerAssociations[Tool](ctx, c, id, "tools")
+}
+
+// ListServerResources calls GET /servers/{id}/resources, which lists the
+// resources associated with a server. It returns nil if the server does not
+// exist.
+func (c *Client) ListServerResources(ctx context.Context, id string) ([]Resource, error) {
+	return listServerAssociations[Resource](ctx, c, id, "resources")
+}
+
+// ListServerPrompts calls GET /servers/{id}/prompts, which lists the prompts
+// associated with a server. It returns nil if the server does not exist.
+func (c *Client) ListServerPrompts(ctx context.Context, id string) ([]Prompt, error) {
+	return listServerAssociations[Prompt](ctx, c, id, "prompts")
+}
+
+// listServerAssociations lists the objects of kind, e.g. "tools", associated
+// with a server, or returns nil if the server does not exist.
+func listServerAssociations[T any](ctx context.Context, c *Client, id, kind string) ([]T, error) {
+	body, statusCode, err := c.doRequest(ctx, http.MethodGet, "/servers/"+url.PathEscape(id)+"/"+kind, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if statusCode != http.StatusOK && statusCode != http.StatusNoContent && statusCode != http.StatusNotFound {
-		return fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+	if statusCode == http.StatusNotFound {
+		return nil, nil
 	}
-	return nil
+	if statusCode != http.StatusOK {
+		return nil, unexpectedStatusError(statusCode, body)
+	}
+
+	var objects []T
+	if err := decodeList(body, &objects); err != nil {
+		return nil, fmt.Errorf("decoding server %s response: %w", kind, err)
+	}
+	return objects, nil
 }
 
-// ServerUpdate represents the request body for PUT /servers/{id}.
+// ServerUpdate represents the request body for PUT /servers/{id}. TeamID is a
+// pointer so that an empty string is sent to remove the server from its team,
+// while nil leaves the team unchanged. The association IDs are pointers for the
+// same reason: an empty list is sent as [] to clear them, while nil leaves them
+// unchanged.
 type ServerUpdate struct {
-	Name        string   `json:"name"`
-	Description string   `json:"description"`
-	Tags        []string `json:"tags"`
-	ToolIDs     []string `json:"tool_ids"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Tags        []string  `json:"tags"`
+	ToolIDs     *[]string `json:"tool_ids,omitempty"`
+	ResourceIDs *[]string `json:"resource_ids,omitempty"`
+	PromptIDs   *[]string `json:"prompt_ids,omitempty"`
+	TeamID      *string   `json:"team_id,omitempty"`
+	IsActive    *bool     `json:"is_active,omitempty"`
 }
 
 // UpdateServer calls PUT /servers/{id}.
 func (c *Client) UpdateServer(ctx context.Context, id string, req ServerUpdate) (*Server, error) {
-	body, statusCode, err := c.doRequest(ctx, http.MethodPut, "/servers/"+url.PathEscape(id), req)
+	body, statusCode, err := c.doWrite(ctx, http.MethodPut, "/servers/"+url.PathEscape(id), req)
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var server Server
@@ -248,6 +1541,7 @@ type GatewayCreate struct {
 	URL                string                 `json:"url"`
 	Description        string                 `json:"description,omitempty"`
 	Transport          string                 `json:"transport,omitempty"`
+	SSEPath            string                 `json:"sse_path,omitempty"`
 	Capabilities       map[string]interface{} `json:"capabilities,omitempty"`
 	HealthCheck        *GatewayHealthCheck    `json:"health_check,omitempty"`
 	IsActive           bool                   `json:"is_active"`
@@ -258,27 +1552,33 @@ type GatewayCreate struct {
 }
 
 // GatewayUpdate represents the request body for PUT /gateways/{id}.
+// Capabilities is a pointer so that an empty map is sent as {} to clear them,
+// while nil leaves them unchanged.
 type GatewayUpdate struct {
-	Name               string                 `json:"name,omitempty"`
-	URL                string                 `json:"url,omitempty"`
-	Description        string                 `json:"description,omitempty"`
-	Transport          string                 `json:"transport,omitempty"`
-	Capabilities       map[string]interface{} `json:"capabilities,omitempty"`
-	HealthCheck        *GatewayHealthCheck    `json:"health_check,omitempty"`
-	IsActive           *bool                  `json:"is_active,omitempty"`
-	Tags               []string               `json:"tags,omitempty"`
-	PassthroughHeaders []string               `json:"passthrough_headers,omitempty"`
-	AuthType           string                 `json:"auth_type,omitempty"`
-	AuthValue          string                 `json:"auth_value,omitempty"`
+	Name               string                  `json:"name,omitempty"`
+	URL                string                  `json:"url,omitempty"`
+	Description        string                  `json:"description,omitempty"`
+	Transport          string                  `json:"transport,omitempty"`
+	SSEPath            string                  `json:"sse_path,omitempty"`
+	Capabilities       *map[string]interface{} `json:"capabilities,omitempty"`
+	HealthCheck        *GatewayHealthCheck     `json:"health_check,omitempty"`
+	IsActive           *bool                   `json:"is_active,omitempty"`
+	Tags               []string                `json:"tags,omitempty"`
+	PassthroughHeaders []string                `json:"passthrough_headers,omitempty"`
+	AuthType           string                  `json:"auth_type,omitempty"`
+	AuthValue          string                  `json:"auth_value,omitempty"`
 }
 
-// Gateway represents a gateway returned by the API.
+// Gateway represents a gateway returned by the API. Description, Transport
+// and SSEPath are nil when the response leaves them out, as opposed to
+// reporting them empty.
 type Gateway struct {
 	ID                 string                 `json:"id"`
 	Name               string                 `json:"name"`
 	URL                string                 `json:"url"`
-	Description        string                 `json:"description,omitempty"`
-	Transport          string                 `json:"transport,omitempty"`
+	Description        *string                `json:"description,omitempty"`
+	Transport          *string                `json:"transport,omitempty"`
+	SSEPath            *string                `json:"sse_path,omitempty"`
 	Capabilities       map[string]interface{} `json:"capabilities,omitempty"`
 	HealthCheck        *GatewayHealthCheck    `json:"health_check,omitempty"`
 	IsActive           bool                   `json:"is_active"`
@@ -286,37 +1586,97 @@ type Gateway struct {
 	PassthroughHeaders []string               `json:"passthrough_headers,omitempty"`
 	AuthType           string                 `json:"auth_type,omitempty"`
 	AuthValue          string                 `json:"auth_value,omitempty"`
+	LastError          string                 `json:"last_error,omitempty"`
 	CreatedAt          string                 `json:"created_at,omitempty"`
 	UpdatedAt          string                 `json:"updated_at,omitempty"`
 }
 
-// ListGateways calls GET /gateways.
-func (c *Client) ListGateways(ctx context.Context, includeInactive bool) ([]Gateway, error) {
-	body, statusCode, err := c.doRequestWithQuery(ctx, http.MethodGet, "/gateways", map[string]string{
-		"include_inactive": fmt.Sprintf("%t", includeInactive),
-	}, nil)
+// UnmarshalJSON decodes a gateway, reading the active flag from
+// is_active or, for gateway versions that renamed it, enabled.
+func (g *Gateway) UnmarshalJSON(data []byte) error {
+	type gatewayFields Gateway
+	aux := struct {
+		*gatewayFields
+		IsActive *bool `json:"is_active"`
+		Enabled  *bool `json:"enabled"`
+	}{gatewayFields: (*gatewayFields)(g)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	g.IsActive = activeFlag(aux.IsActive, aux.Enabled)
+	return nil
+}
+
+// activeFlag returns the active state reported under is_active or, when that
+// key is absent, under enabled. An object reporting neither is inactive.
+func activeFlag(isActive, enabled *bool) bool {
+	if isActive != nil {
+		return *isActive
+	}
+	return enabled != nil && *enabled
+}
+
+// ListGateways calls GET /gateways. When tags is non-empty, only gateways
+// carrying at least one of the tags are returned. The tags are sent as a
+// query parameter so the gateway can filter server-side, and the results are
+// filtered again locally for gateway versions that ignore the parameter.
+func (c *Client) ListGateways(ctx context.Context, includeInactive bool, tags []string) ([]Gateway, error) {
+	query := listQuery(includeInactive)
+	if len(tags) > 0 {
+		query["tags"] = strings.Join(tags, ",")
+	}
+	body, statusCode, err := c.doRequestWithQuery(ctx, http.MethodGet, "/gateways", query, nil)
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var gateways []Gateway
-	if err := json.Unmarshal(body, &gateways); err != nil {
+	if err := decodeList(body, &gateways); err != nil {
 		return nil, fmt.Errorf("decoding gateways response: %w", err)
 	}
-	return gateways, nil
+	if len(tags) == 0 {
+		return gateways, nil
+	}
+
+	filtered := make([]Gateway, 0, len(gateways))
+	for _, g := range gateways {
+		if hasAnyTag(g.Tags, tags) {
+			filtered = append(filtered, g)
+		}
+	}
+	return filtered, nil
 }
 
-// CreateGateway calls POST /gateways.
+// hasAnyTag reports whether tags contains at least one of wanted.
+func hasAnyTag(tags, wanted []string) bool {
+	for _, tag := range tags {
+		for _, w := range wanted {
+			if tag == w {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CreateGateway calls POST /gateways. Gateways that register the upstream
+// server asynchronously answer 202 Accepted with a job; CreateGateway then
+// polls the job until it finishes, bounded by ctx, and returns the created
+// gateway.
 func (c *Client) CreateGateway(ctx context.Context, req GatewayCreate) (*Gateway, error) {
-	body, statusCode, err := c.doRequest(ctx, http.MethodPost, "/gateways", req)
+	body, statusCode, header, err := c.do(context.WithValue(ctx, createKey{}, true), http.MethodPost, "/gateways", nil, req)
+	if err == nil && statusCode == http.StatusAccepted {
+		return c.awaitGatewayJob(ctx, body, header)
+	}
+	body, statusCode, err = c.completeWrite(ctx, http.MethodPost, "/gateways", body, statusCode, header, err)
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK && statusCode != http.StatusCreated {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var gateway Gateway
@@ -326,34 +1686,141 @@ func (c *Client) CreateGateway(ctx context.Context, req GatewayCreate) (*Gateway
 	return &gateway, nil
 }
 
-// GetGateway calls GET /gateways/{id}.
-func (c *Client) GetGateway(ctx context.Context, id string) (*Gateway, error) {
-	body, statusCode, err := c.doRequest(ctx, http.MethodGet, "/gateways/"+url.PathEscape(id), nil)
+// Job statuses reported by the gateway for asynchronous operations.
+const (
+	JobStatusPending   = "pending"
+	JobStatusRunning   = "running"
+	JobStatusCompleted = "completed"
+	JobStatusFailed    = "failed"
+)
+
+// Job is an asynchronous operation started by a 202 Accepted response.
+type Job struct {
+	ID         string `json:"id"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+	ResourceID string `json:"resource_id,omitempty"`
+	// JobURL is where the job can be polled, when the accepting response
+	// gives it in the body rather than in a Location header.
+	JobURL string `json:"job_url,omitempty"`
+	// Result holds the created object, when the gateway embeds it in the
+	// finished job.
+	Result json.RawMessage `json:"result,omitempty"`
+}
+
+// Done reports whether the job has finished, successfully or not.
+func (j *Job) Done() bool {
+	return j.Status == JobStatusCompleted || j.Status == JobStatusFailed
+}
+
+// GetJob calls GET on jobURL, the location of a job as given by the gateway.
+// jobURL may be relative to the endpoint or absolute, but must point inside
+// it.
+func (c *Client) GetJob(ctx context.Context, jobURL string) (*Job, error) {
+	jobPath, err := c.locationPath(jobURL)
 	if err != nil {
 		return nil, err
 	}
-	if statusCode == http.StatusNotFound {
-		return nil, nil
+	body, statusCode, err := c.doRequest(ctx, http.MethodGet, jobPath, nil)
+	if err != nil {
+		return nil, err
 	}
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
-	var gateway Gateway
-	if err := json.Unmarshal(body, &gateway); err != nil {
-		return nil, fmt.Errorf("decoding gateway response: %w", err)
+	var job Job
+	if err := json.Unmarshal(body, &job); err != nil {
+		return nil, fmt.Errorf("decoding job response: %w", err)
 	}
-	return &gateway, nil
+	return &job, nil
+}
+
+// WaitForJob polls GetJob with exponential backoff until the job finishes or
+// ctx is done. A failed job is returned as an error.
+func (c *Client) WaitForJob(ctx context.Context, jobURL string) (*Job, error) {
+	ctx = context.WithValue(ctx, bypassCacheKey{}, true)
+	delay := pollInitialInterval
+	for {
+		job, err := c.GetJob(ctx, jobURL)
+		if err != nil {
+			return nil, err
+		}
+		if job.Status == JobStatusFailed {
+			return nil, fmt.Errorf("job %s failed: %s", job.ID, job.Error)
+		}
+		if job.Done() {
+			return job, nil
+		}
+
+		if err := c.sleep(ctx, delay); err != nil {
+			return nil, fmt.Errorf("waiting for job %s to finish (last status %q): %w", job.ID, job.Status, err)
+		}
+
+		delay *= 2
+		if delay > pollMaxInterval {
+			delay = pollMaxInterval
+		}
+	}
+}
+
+// awaitGatewayJob waits for the job a 202 Accepted create response started
+// and returns the gateway it created. The job is found through the Location
+// header or, failing that, the job_url in the response body.
+func (c *Client) awaitGatewayJob(ctx context.Context, body []byte, header http.Header) (*Gateway, error) {
+	jobURL := header.Get("Location")
+	if jobURL == "" && len(bytes.TrimSpace(body)) > 0 {
+		var accepted Job
+		if err := json.Unmarshal(body, &accepted); err != nil {
+			return nil, fmt.Errorf("decoding accepted job response: %w", err)
+		}
+		jobURL = accepted.JobURL
+	}
+	if jobURL == "" {
+		return nil, fmt.Errorf("gateway accepted the create without saying where to poll for the job")
+	}
+
+	tflog.Debug(ctx, "waiting for asynchronous gateway create", map[string]interface{}{"job_url": jobURL})
+	job, err := c.WaitForJob(ctx, jobURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.ResourceID != "" {
+		gateway, err := c.GetGateway(ctx, job.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+		if gateway == nil {
+			return nil, fmt.Errorf("gateway %s created by job %s not found", job.ResourceID, job.ID)
+		}
+		return gateway, nil
+	}
+	if len(job.Result) > 0 {
+		var gateway Gateway
+		if err := json.Unmarshal(job.Result, &gateway); err != nil {
+			return nil, fmt.Errorf("decoding job result: %w", err)
+		}
+		if gateway.ID != "" {
+			return &gateway, nil
+		}
+	}
+	return nil, fmt.Errorf("job %s completed without reporting the created gateway", job.ID)
+}
+
+// GetGateway calls GET /gateways/{id}.
+func (c *Client) GetGateway(ctx context.Context, id string) (*Gateway, error) {
+	return getObject[Gateway](ctx, c, "/gateways/"+url.PathEscape(id), "gateway")
 }
 
 // UpdateGateway calls PUT /gateways/{id}.
 func (c *Client) UpdateGateway(ctx context.Context, id string, req GatewayUpdate) (*Gateway, error) {
-	body, statusCode, err := c.doRequest(ctx, http.MethodPut, "/gateways/"+url.PathEscape(id), req)
+	body, statusCode, err := c.doWrite(ctx, http.MethodPut, "/gateways/"+url.PathEscape(id), req)
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var gateway Gateway
@@ -370,11 +1837,39 @@ func (c *Client) DeleteGateway(ctx context.Context, id string) error {
 		return err
 	}
 	if statusCode != http.StatusOK && statusCode != http.StatusNoContent && statusCode != http.StatusNotFound {
-		return fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return unexpectedStatusError(statusCode, body)
 	}
 	return nil
 }
 
+// GatewayRefreshResult is the response from re-running tool discovery on a
+// gateway.
+type GatewayRefreshResult struct {
+	ToolsDiscovered int `json:"tools_discovered"`
+}
+
+// RefreshGatewayTools calls POST /gateways/{id}/refresh, which makes the
+// gateway reconnect to its backend and rediscover the tools it exposes. It
+// returns nil if the gateway does not exist.
+func (c *Client) RefreshGatewayTools(ctx context.Context, id string) (*GatewayRefreshResult, error) {
+	body, statusCode, err := c.doRequest(ctx, http.MethodPost, "/gateways/"+url.PathEscape(id)+"/refresh", nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if statusCode != http.StatusOK {
+		return nil, unexpectedStatusError(statusCode, body)
+	}
+
+	var result GatewayRefreshResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("decoding gateway refresh response: %w", err)
+	}
+	return &result, nil
+}
+
 // --- Tool types and methods ---
 
 // ToolCreate represents the tool fields for creation.
@@ -383,6 +1878,7 @@ type ToolCreate struct {
 	Description string                 `json:"description,omitempty"`
 	InputSchema map[string]interface{} `json:"inputSchema,omitempty"`
 	Tags        []string               `json:"tags,omitempty"`
+	Annotations map[string]interface{} `json:"annotations,omitempty"`
 }
 
 // CreateToolRequest represents the request body for POST /tools.
@@ -392,55 +1888,80 @@ type CreateToolRequest struct {
 	TeamID     string     `json:"team_id,omitempty"`
 }
 
-// ToolUpdate represents the request body for PUT /tools/{id}.
+// ToolUpdate represents the request body for PUT /tools/{id}. Annotations is
+// a pointer so that an empty map is sent as {} to clear them, while nil
+// leaves them unchanged.
 type ToolUpdate struct {
-	Name        string                 `json:"name,omitempty"`
-	Description string                 `json:"description,omitempty"`
-	InputSchema map[string]interface{} `json:"inputSchema,omitempty"`
-	Tags        []string               `json:"tags,omitempty"`
+	Name        string                  `json:"name,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	InputSchema map[string]interface{}  `json:"inputSchema,omitempty"`
+	Tags        []string                `json:"tags,omitempty"`
+	Annotations *map[string]interface{} `json:"annotations,omitempty"`
+	IsActive    *bool                   `json:"is_active,omitempty"`
 }
 
-// Tool represents a tool returned by the API.
+// Tool represents a tool returned by the API. Description and Visibility are
+// nil when the response leaves them out.
 type Tool struct {
 	ID          string                 `json:"id"`
 	Name        string                 `json:"name"`
-	Description string                 `json:"description,omitempty"`
+	Description *string                `json:"description,omitempty"`
 	InputSchema map[string]interface{} `json:"inputSchema,omitempty"`
 	Tags        []string               `json:"tags,omitempty"`
+	Annotations map[string]interface{} `json:"annotations,omitempty"`
 	IsActive    bool                   `json:"is_active"`
 	GatewayID   string                 `json:"gateway_id,omitempty"`
-	Visibility  string                 `json:"visibility,omitempty"`
+	Visibility  *string                `json:"visibility,omitempty"`
 	CreatedAt   string                 `json:"created_at,omitempty"`
 	UpdatedAt   string                 `json:"updated_at,omitempty"`
+	CreatedBy   string                 `json:"created_by,omitempty"`
+}
+
+// UnmarshalJSON decodes a tool, reading the active flag from
+// is_active or, for gateway versions that renamed it, enabled.
+func (t *Tool) UnmarshalJSON(data []byte) error {
+	type toolFields Tool
+	aux := struct {
+		*toolFields
+		IsActive *bool `json:"is_active"`
+		Enabled  *bool `json:"enabled"`
+	}{toolFields: (*toolFields)(t)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.IsActive = activeFlag(aux.IsActive, aux.Enabled)
+	return nil
 }
 
 // ListTools calls GET /tools.
 func (c *Client) ListTools(ctx context.Context, includeInactive bool) ([]Tool, error) {
-	body, statusCode, err := c.doRequestWithQuery(ctx, http.MethodGet, "/tools", map[string]string{
-		"include_inactive": fmt.Sprintf("%t", includeInactive),
-	}, nil)
+	body, statusCode, err := c.doRequestWithQuery(ctx, http.MethodGet, "/tools", listQuery(includeInactive), nil)
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var tools []Tool
-	if err := json.Unmarshal(body, &tools); err != nil {
+	if err := decodeList(body, &tools); err != nil {
 		return nil, fmt.Errorf("decoding tools response: %w", err)
 	}
 	return tools, nil
 }
 
-// CreateTool calls POST /tools.
+// CreateTool calls POST /tools. An empty Visibility is replaced by
+// DefaultVisibility.
 func (c *Client) CreateTool(ctx context.Context, req CreateToolRequest) (*Tool, error) {
-	body, statusCode, err := c.doRequest(ctx, http.MethodPost, "/tools", req)
+	if req.Visibility == "" {
+		req.Visibility = c.DefaultVisibility
+	}
+	body, statusCode, err := c.doCreate(ctx, "/tools", req)
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK && statusCode != http.StatusCreated {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var tool Tool
@@ -450,34 +1971,76 @@ func (c *Client) CreateTool(ctx context.Context, req CreateToolRequest) (*Tool,
 	return &tool, nil
 }
 
-// GetTool calls GET /tools/{id}.
-func (c *Client) GetTool(ctx context.Context, id string) (*Tool, error) {
-	body, statusCode, err := c.doRequest(ctx, http.MethodGet, "/tools/"+url.PathEscape(id), nil)
+// ToolValidation is the gateway's verdict on a tool definition.
+type ToolValidation struct {
+	Valid    bool
+	Errors   []string
+	Warnings []string
+}
+
+// ErrValidationUnsupported is returned by ValidateTool when the gateway has no
+// tool validation endpoint.
+var ErrValidationUnsupported = errors.New("gateway does not support tool validation")
+
+// ValidateTool calls POST /tools/validate to check a tool definition without
+// creating it. A definition the gateway rejects with 400 or 422 is reported as
+// an invalid ToolValidation carrying the response detail rather than as an
+// error. An empty Visibility is replaced by DefaultVisibility, as in
+// CreateTool. The request creates nothing, so it leaves the response cache
+// intact.
+func (c *Client) ValidateTool(ctx context.Context, req CreateToolRequest) (*ToolValidation, error) {
+	if req.Visibility == "" {
+		req.Visibility = c.DefaultVisibility
+	}
+	ctx = context.WithValue(ctx, noSideEffectsKey{}, true)
+	body, statusCode, err := c.doRequest(ctx, http.MethodPost, "/tools/validate", req)
 	if err != nil {
 		return nil, err
 	}
-	if statusCode == http.StatusNotFound {
-		return nil, nil
-	}
-	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
-	}
+	switch statusCode {
+	case http.StatusOK:
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return nil, ErrValidationUnsupported
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		detail := errorDetail(body)
+		if detail == "" {
+			detail = truncateBody(bytes.TrimSpace(body))
+		}
+		return &ToolValidation{Errors: []string{detail}}, nil
+	default:
+		return nil, unexpectedStatusError(statusCode, body)
+	}
+
+	// A response without "valid" is taken to accept the tool unless it
+	// lists errors.
+	var payload struct {
+		Valid    *bool    `json:"valid"`
+		Errors   []string `json:"errors"`
+		Warnings []string `json:"warnings"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("decoding tool validation response: %w", err)
+	}
+	return &ToolValidation{
+		Valid:    (payload.Valid == nil || *payload.Valid) && len(payload.Errors) == 0,
+		Errors:   payload.Errors,
+		Warnings: payload.Warnings,
+	}, nil
+}
 
-	var tool Tool
-	if err := json.Unmarshal(body, &tool); err != nil {
-		return nil, fmt.Errorf("decoding tool response: %w", err)
-	}
-	return &tool, nil
+// GetTool calls GET /tools/{id}.
+func (c *Client) GetTool(ctx context.Context, id string) (*Tool, error) {
+	return getObject[Tool](ctx, c, "/tools/"+url.PathEscape(id), "tool")
 }
 
 // UpdateTool calls PUT /tools/{id}.
 func (c *Client) UpdateTool(ctx context.Context, id string, req ToolUpdate) (*Tool, error) {
-	body, statusCode, err := c.doRequest(ctx, http.MethodPut, "/tools/"+url.PathEscape(id), req)
+	body, statusCode, err := c.doWrite(ctx, http.MethodPut, "/tools/"+url.PathEscape(id), req)
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var tool Tool
@@ -487,6 +2050,17 @@ func (c *Client) UpdateTool(ctx context.Context, id string, req ToolUpdate) (*To
 	return &tool, nil
 }
 
+// PatchTool calls PATCH /tools/{id} with the fields in changes, typically built
+// with MergePatch. It returns ErrPatchNotSupported if the gateway only accepts
+// full updates through UpdateTool.
+func (c *Client) PatchTool(ctx context.Context, id string, changes map[string]json.RawMessage) (*Tool, error) {
+	var tool Tool
+	if err := c.patch(ctx, "/tools/"+url.PathEscape(id), changes, &tool); err != nil {
+		return nil, err
+	}
+	return &tool, nil
+}
+
 // DeleteTool calls DELETE /tools/{id}.
 func (c *Client) DeleteTool(ctx context.Context, id string) error {
 	body, statusCode, err := c.doRequest(ctx, http.MethodDelete, "/tools/"+url.PathEscape(id), nil)
@@ -494,11 +2068,47 @@ func (c *Client) DeleteTool(ctx context.Context, id string) error {
 		return err
 	}
 	if statusCode != http.StatusOK && statusCode != http.StatusNoContent && statusCode != http.StatusNotFound {
-		return fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return unexpectedStatusError(statusCode, body)
 	}
 	return nil
 }
 
+// ToolInvokeRequest is the request body for POST /tools/{id}/invoke.
+type ToolInvokeRequest struct {
+	Arguments map[string]interface{} `json:"arguments"`
+}
+
+// ToolResult is the result of invoking a tool, an MCP CallToolResult.
+type ToolResult struct {
+	Content json.RawMessage `json:"content"`
+	IsError bool            `json:"isError"`
+}
+
+// InvokeTool calls POST /tools/{id}/invoke with the given arguments. A tool
+// that runs but reports failure returns a result with IsError set rather than
+// an error. Returns nil, nil if the tool is not found.
+func (c *Client) InvokeTool(ctx context.Context, id string, args map[string]interface{}) (*ToolResult, error) {
+	if args == nil {
+		args = map[string]interface{}{}
+	}
+	body, statusCode, err := c.doRequest(ctx, http.MethodPost, "/tools/"+url.PathEscape(id)+"/invoke", ToolInvokeRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if statusCode != http.StatusOK {
+		return nil, unexpectedStatusError(statusCode, body)
+	}
+
+	var result ToolResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("decoding tool result: %w", err)
+	}
+	return &result, nil
+}
+
 // --- Resource types and methods ---
 
 // ResourceCreate represents the resource fields for creation.
@@ -524,49 +2134,87 @@ type ResourceUpdate struct {
 	Description string   `json:"description,omitempty"`
 	MimeType    string   `json:"mimeType,omitempty"`
 	Tags        []string `json:"tags,omitempty"`
+	IsActive    *bool    `json:"is_active,omitempty"`
 }
 
-// Resource represents a resource returned by the API.
+// Resource represents a resource returned by the API. Description and
+// Visibility are nil when the response leaves them out.
 type Resource struct {
 	ID          string   `json:"id"`
 	URI         string   `json:"uri"`
 	Name        string   `json:"name"`
-	Description string   `json:"description,omitempty"`
+	Description *string  `json:"description,omitempty"`
 	MimeType    string   `json:"mimeType,omitempty"`
 	Tags        []string `json:"tags,omitempty"`
 	IsActive    bool     `json:"is_active"`
-	Visibility  string   `json:"visibility,omitempty"`
+	Visibility  *string  `json:"visibility,omitempty"`
 	CreatedAt   string   `json:"created_at,omitempty"`
 	UpdatedAt   string   `json:"updated_at,omitempty"`
+	CreatedBy   string   `json:"created_by,omitempty"`
+}
+
+// UnmarshalJSON decodes a resource, reading the active flag from
+// is_active or, for gateway versions that renamed it, enabled.
+func (r *Resource) UnmarshalJSON(data []byte) error {
+	type resourceFields Resource
+	aux := struct {
+		*resourceFields
+		IsActive *bool `json:"is_active"`
+		Enabled  *bool `json:"enabled"`
+	}{resourceFields: (*resourceFields)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.IsActive = activeFlag(aux.IsActive, aux.Enabled)
+	return nil
 }
 
-// ListResources calls GET /resources.
-func (c *Client) ListResources(ctx context.Context, includeInactive bool) ([]Resource, error) {
-	body, statusCode, err := c.doRequestWithQuery(ctx, http.MethodGet, "/resources", map[string]string{
-		"include_inactive": fmt.Sprintf("%t", includeInactive),
-	}, nil)
+// ListResources calls GET /resources. When mimeType is non-empty, only
+// resources with that MIME type, ignoring case, are returned. It is sent as a
+// query parameter so the gateway can filter server-side, and the results are
+// filtered again locally for gateway versions that ignore the parameter.
+func (c *Client) ListResources(ctx context.Context, includeInactive bool, mimeType string) ([]Resource, error) {
+	query := listQuery(includeInactive)
+	if mimeType != "" {
+		query["mime_type"] = mimeType
+	}
+	body, statusCode, err := c.doRequestWithQuery(ctx, http.MethodGet, "/resources", query, nil)
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var resources []Resource
-	if err := json.Unmarshal(body, &resources); err != nil {
+	if err := decodeList(body, &resources); err != nil {
 		return nil, fmt.Errorf("decoding resources response: %w", err)
 	}
-	return resources, nil
+	if mimeType == "" {
+		return resources, nil
+	}
+
+	filtered := make([]Resource, 0, len(resources))
+	for _, r := range resources {
+		if strings.EqualFold(r.MimeType, mimeType) {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered, nil
 }
 
-// CreateResource calls POST /resources.
+// CreateResource calls POST /resources. An empty Visibility is replaced by
+// DefaultVisibility.
 func (c *Client) CreateResource(ctx context.Context, req CreateResourceRequest) (*Resource, error) {
-	body, statusCode, err := c.doRequest(ctx, http.MethodPost, "/resources", req)
+	if req.Visibility == "" {
+		req.Visibility = c.DefaultVisibility
+	}
+	body, statusCode, err := c.doCreate(ctx, "/resources", req)
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK && statusCode != http.StatusCreated {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var resource Resource
@@ -578,7 +2226,22 @@ func (c *Client) CreateResource(ctx context.Context, req CreateResourceRequest)
 
 // GetResource calls GET /resources/{id}/info.
 func (c *Client) GetResource(ctx context.Context, id string) (*Resource, error) {
-	body, statusCode, err := c.doRequest(ctx, http.MethodGet, "/resources/"+url.PathEscape(id)+"/info", nil)
+	return getObject[Resource](ctx, c, "/resources/"+url.PathEscape(id)+"/info", "resource")
+}
+
+// ResourceContent represents the content of a resource returned by
+// GET /resources/{id}. Text resources set Text; binary resources set Blob,
+// which the API transmits base64-encoded.
+type ResourceContent struct {
+	URI      string  `json:"uri,omitempty"`
+	MimeType string  `json:"mimeType,omitempty"`
+	Text     *string `json:"text,omitempty"`
+	Blob     []byte  `json:"blob,omitempty"`
+}
+
+// ReadResourceContent calls GET /resources/{id}.
+func (c *Client) ReadResourceContent(ctx context.Context, id string) (*ResourceContent, error) {
+	body, statusCode, err := c.doRequest(ctx, http.MethodGet, "/resources/"+url.PathEscape(id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -586,7 +2249,24 @@ func (c *Client) GetResource(ctx context.Context, id string) (*Resource, error)
 		return nil, nil
 	}
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
+	}
+
+	var content ResourceContent
+	if err := json.Unmarshal(body, &content); err != nil {
+		return nil, fmt.Errorf("decoding resource content response: %w", err)
+	}
+	return &content, nil
+}
+
+// UpdateResource calls PUT /resources/{id}.
+func (c *Client) UpdateResource(ctx context.Context, id string, req ResourceUpdate) (*Resource, error) {
+	body, statusCode, err := c.doWrite(ctx, http.MethodPut, "/resources/"+url.PathEscape(id), req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var resource Resource
@@ -596,14 +2276,24 @@ func (c *Client) GetResource(ctx context.Context, id string) (*Resource, error)
 	return &resource, nil
 }
 
-// UpdateResource calls PUT /resources/{id}.
-func (c *Client) UpdateResource(ctx context.Context, id string, req ResourceUpdate) (*Resource, error) {
-	body, statusCode, err := c.doRequest(ctx, http.MethodPut, "/resources/"+url.PathEscape(id), req)
+// ResourceContentUpload is the body UploadResourceContent sends to replace a
+// resource's content. Text content is sent in Content; binary content in Blob,
+// which the API transmits base64-encoded.
+type ResourceContentUpload struct {
+	Content *string `json:"content,omitempty"`
+	Blob    []byte  `json:"blob,omitempty"`
+}
+
+// UploadResourceContent calls PUT /resources/{id} with the resource's content.
+// The gateway registers a resource's metadata first, so content is uploaded in
+// a second request once the resource exists.
+func (c *Client) UploadResourceContent(ctx context.Context, id string, content ResourceContentUpload) (*Resource, error) {
+	body, statusCode, err := c.doWrite(ctx, http.MethodPut, "/resources/"+url.PathEscape(id), content)
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var resource Resource
@@ -613,6 +2303,16 @@ func (c *Client) UpdateResource(ctx context.Context, id string, req ResourceUpda
 	return &resource, nil
 }
 
+// PatchResource calls PATCH /resources/{id} with the fields in changes. It
+// returns ErrPatchNotSupported if the gateway only accepts UpdateResource.
+func (c *Client) PatchResource(ctx context.Context, id string, changes map[string]json.RawMessage) (*Resource, error) {
+	var resource Resource
+	if err := c.patch(ctx, "/resources/"+url.PathEscape(id), changes, &resource); err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
 // DeleteResource calls DELETE /resources/{id}.
 func (c *Client) DeleteResource(ctx context.Context, id string) error {
 	body, statusCode, err := c.doRequest(ctx, http.MethodDelete, "/resources/"+url.PathEscape(id), nil)
@@ -620,7 +2320,7 @@ func (c *Client) DeleteResource(ctx context.Context, id string) error {
 		return err
 	}
 	if statusCode != http.StatusOK && statusCode != http.StatusNoContent && statusCode != http.StatusNotFound {
-		return fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return unexpectedStatusError(statusCode, body)
 	}
 	return nil
 }
@@ -655,48 +2355,91 @@ type PromptUpdate struct {
 	Description string           `json:"description,omitempty"`
 	Arguments   []PromptArgument `json:"arguments,omitempty"`
 	Tags        []string         `json:"tags,omitempty"`
+	IsActive    *bool            `json:"is_active,omitempty"`
 }
 
-// Prompt represents a prompt returned by the API.
+// Prompt represents a prompt returned by the API. Description and Visibility
+// are nil when the response leaves them out.
 type Prompt struct {
 	ID          string           `json:"id"`
 	Name        string           `json:"name"`
-	Description string           `json:"description,omitempty"`
+	Description *string          `json:"description,omitempty"`
 	Arguments   []PromptArgument `json:"arguments,omitempty"`
 	Tags        []string         `json:"tags,omitempty"`
 	IsActive    bool             `json:"is_active"`
-	Visibility  string           `json:"visibility,omitempty"`
+	Visibility  *string          `json:"visibility,omitempty"`
 	CreatedAt   string           `json:"created_at,omitempty"`
 	UpdatedAt   string           `json:"updated_at,omitempty"`
+	CreatedBy   string           `json:"created_by,omitempty"`
+}
+
+// UnmarshalJSON decodes a prompt, reading the active flag from
+// is_active or, for gateway versions that renamed it, enabled.
+func (p *Prompt) UnmarshalJSON(data []byte) error {
+	type promptFields Prompt
+	aux := struct {
+		*promptFields
+		IsActive *bool `json:"is_active"`
+		Enabled  *bool `json:"enabled"`
+	}{promptFields: (*promptFields)(p)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	p.IsActive = activeFlag(aux.IsActive, aux.Enabled)
+	return nil
 }
 
-// ListPrompts calls GET /prompts.
-func (c *Client) ListPrompts(ctx context.Context, includeInactive bool) ([]Prompt, error) {
-	body, statusCode, err := c.doRequestWithQuery(ctx, http.MethodGet, "/prompts", map[string]string{
-		"include_inactive": fmt.Sprintf("%t", includeInactive),
-	}, nil)
+// ListPrompts calls GET /prompts. When search is non-empty, only prompts whose
+// name contains it, ignoring case, are returned. The search is sent as a query
+// parameter so the gateway can filter server-side, and the results are
+// filtered again locally for gateway versions that ignore the parameter.
+func (c *Client) ListPrompts(ctx context.Context, includeInactive bool, search string) ([]Prompt, error) {
+	query := listQuery(includeInactive)
+	if search != "" {
+		query["search"] = search
+	}
+	body, statusCode, err := c.doRequestWithQuery(ctx, http.MethodGet, "/prompts", query, nil)
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var prompts []Prompt
-	if err := json.Unmarshal(body, &prompts); err != nil {
+	if err := decodeList(body, &prompts); err != nil {
 		return nil, fmt.Errorf("decoding prompts response: %w", err)
 	}
-	return prompts, nil
+	if search == "" {
+		return prompts, nil
+	}
+
+	filtered := make([]Prompt, 0, len(prompts))
+	for _, p := range prompts {
+		if containsFold(p.Name, search) {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered, nil
+}
+
+// containsFold reports whether substr is within s, ignoring case.
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
 }
 
-// CreatePrompt calls POST /prompts.
+// CreatePrompt calls POST /prompts. An empty Visibility is replaced by
+// DefaultVisibility.
 func (c *Client) CreatePrompt(ctx context.Context, req CreatePromptRequest) (*Prompt, error) {
-	body, statusCode, err := c.doRequest(ctx, http.MethodPost, "/prompts", req)
+	if req.Visibility == "" {
+		req.Visibility = c.DefaultVisibility
+	}
+	body, statusCode, err := c.doCreate(ctx, "/prompts", req)
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK && statusCode != http.StatusCreated {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var prompt Prompt
@@ -708,7 +2451,22 @@ func (c *Client) CreatePrompt(ctx context.Context, req CreatePromptRequest) (*Pr
 
 // GetPrompt calls GET /prompts/{id}.
 func (c *Client) GetPrompt(ctx context.Context, id string) (*Prompt, error) {
-	body, statusCode, err := c.doRequest(ctx, http.MethodGet, "/prompts/"+url.PathEscape(id), nil)
+	return getObject[Prompt](ctx, c, "/prompts/"+url.PathEscape(id), "prompt")
+}
+
+// PromptResult is the response from rendering a prompt.
+type PromptResult struct {
+	Messages    json.RawMessage `json:"messages"`
+	Description string          `json:"description,omitempty"`
+}
+
+// RenderPrompt calls POST /prompts/{id} with the prompt arguments and returns
+// the rendered messages. Returns nil, nil if the prompt is not found.
+func (c *Client) RenderPrompt(ctx context.Context, id string, args map[string]string) (*PromptResult, error) {
+	if args == nil {
+		args = map[string]string{}
+	}
+	body, statusCode, err := c.doRequest(ctx, http.MethodPost, "/prompts/"+url.PathEscape(id), args)
 	if err != nil {
 		return nil, err
 	}
@@ -716,24 +2474,24 @@ func (c *Client) GetPrompt(ctx context.Context, id string) (*Prompt, error) {
 		return nil, nil
 	}
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
-	var prompt Prompt
-	if err := json.Unmarshal(body, &prompt); err != nil {
-		return nil, fmt.Errorf("decoding prompt response: %w", err)
+	var result PromptResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("decoding prompt render response: %w", err)
 	}
-	return &prompt, nil
+	return &result, nil
 }
 
 // UpdatePrompt calls PUT /prompts/{id}.
 func (c *Client) UpdatePrompt(ctx context.Context, id string, req PromptUpdate) (*Prompt, error) {
-	body, statusCode, err := c.doRequest(ctx, http.MethodPut, "/prompts/"+url.PathEscape(id), req)
+	body, statusCode, err := c.doWrite(ctx, http.MethodPut, "/prompts/"+url.PathEscape(id), req)
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var prompt Prompt
@@ -743,6 +2501,16 @@ func (c *Client) UpdatePrompt(ctx context.Context, id string, req PromptUpdate)
 	return &prompt, nil
 }
 
+// PatchPrompt calls PATCH /prompts/{id} with the fields in changes. It returns
+// ErrPatchNotSupported if the gateway only accepts UpdatePrompt.
+func (c *Client) PatchPrompt(ctx context.Context, id string, changes map[string]json.RawMessage) (*Prompt, error) {
+	var prompt Prompt
+	if err := c.patch(ctx, "/prompts/"+url.PathEscape(id), changes, &prompt); err != nil {
+		return nil, err
+	}
+	return &prompt, nil
+}
+
 // DeletePrompt calls DELETE /prompts/{id}.
 func (c *Client) DeletePrompt(ctx context.Context, id string) error {
 	body, statusCode, err := c.doRequest(ctx, http.MethodDelete, "/prompts/"+url.PathEscape(id), nil)
@@ -750,17 +2518,222 @@ func (c *Client) DeletePrompt(ctx context.Context, id string) error {
 		return err
 	}
 	if statusCode != http.StatusOK && statusCode != http.StatusNoContent && statusCode != http.StatusNotFound {
-		return fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return unexpectedStatusError(statusCode, body)
+	}
+	return nil
+}
+
+// --- Tag types and methods ---
+
+// TaggableKind is the collection path of an object type that supports tags.
+type TaggableKind string
+
+const (
+	TaggableTools     TaggableKind = "tools"
+	TaggableServers   TaggableKind = "servers"
+	TaggablePrompts   TaggableKind = "prompts"
+	TaggableResources TaggableKind = "resources"
+)
+
+// TagsRequest is the request body for the /{kind}/{id}/tags endpoint.
+type TagsRequest struct {
+	Tags []string `json:"tags"`
+}
+
+// TagsResponse is the tag set returned after a tag change.
+type TagsResponse struct {
+	Tags []string `json:"tags"`
+}
+
+// AddTags calls POST /{kind}/{id}/tags, adding tags to the object without
+// touching its other fields. It returns the object's resulting tags.
+func (c *Client) AddTags(ctx context.Context, kind TaggableKind, id string, tags []string) ([]string, error) {
+	return c.changeTags(ctx, http.MethodPost, kind, id, tags)
+}
+
+// RemoveTags calls DELETE /{kind}/{id}/tags, removing tags from the object
+// without touching its other fields. It returns the object's resulting tags.
+func (c *Client) RemoveTags(ctx context.Context, kind TaggableKind, id string, tags []string) ([]string, error) {
+	return c.changeTags(ctx, http.MethodDelete, kind, id, tags)
+}
+
+func (c *Client) changeTags(ctx context.Context, method string, kind TaggableKind, id string, tags []string) ([]string, error) {
+	body, statusCode, err := c.doRequest(ctx, method, "/"+string(kind)+"/"+url.PathEscape(id)+"/tags", TagsRequest{Tags: tags})
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return nil, unexpectedStatusError(statusCode, body)
+	}
+
+	var result TagsResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("decoding tags response: %w", err)
+	}
+	return result.Tags, nil
+}
+
+// --- Federation types and methods ---
+
+// FederationPeer is a peer gateway this gateway federates with.
+type FederationPeer struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	Status   string `json:"status,omitempty"`
+	LastSeen string `json:"last_seen,omitempty"`
+}
+
+// Federation represents the response from GET /federation.
+type Federation struct {
+	Peers []FederationPeer `json:"peers"`
+}
+
+// GetFederation calls GET /federation.
+func (c *Client) GetFederation(ctx context.Context) (*Federation, error) {
+	body, statusCode, err := c.doRequest(ctx, http.MethodGet, "/federation", nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return nil, unexpectedStatusError(statusCode, body)
+	}
+
+	var federation Federation
+	if err := json.Unmarshal(body, &federation); err != nil {
+		return nil, fmt.Errorf("decoding federation response: %w", err)
+	}
+	return &federation, nil
+}
+
+// --- Metrics types and methods ---
+
+// Metrics represents the aggregate metrics returned by GET /metrics.
+type Metrics struct {
+	TotalTools       int64 `json:"total_tools"`
+	TotalServers     int64 `json:"total_servers"`
+	TotalGateways    int64 `json:"total_gateways"`
+	RequestsLastHour int64 `json:"requests_last_hour"`
+}
+
+// GetMetrics calls GET /metrics.
+func (c *Client) GetMetrics(ctx context.Context) (*Metrics, error) {
+	body, statusCode, err := c.doRequest(ctx, http.MethodGet, "/metrics", nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return nil, unexpectedStatusError(statusCode, body)
+	}
+
+	var metrics Metrics
+	if err := json.Unmarshal(body, &metrics); err != nil {
+		return nil, fmt.Errorf("decoding metrics response: %w", err)
+	}
+	return &metrics, nil
+}
+
+// --- User types and methods ---
+
+// UserCreate represents the request body for POST /users.
+type UserCreate struct {
+	Email   string   `json:"email"`
+	Role    string   `json:"role,omitempty"`
+	TeamIDs []string `json:"team_ids,omitempty"`
+}
+
+// UserUpdate represents the request body for PUT /users/{id}.
+type UserUpdate struct {
+	Email   string   `json:"email,omitempty"`
+	Role    string   `json:"role,omitempty"`
+	TeamIDs []string `json:"team_ids"`
+}
+
+// User represents a gateway user returned by the API.
+type User struct {
+	ID        string   `json:"id"`
+	Email     string   `json:"email"`
+	Role      string   `json:"role,omitempty"`
+	TeamIDs   []string `json:"team_ids,omitempty"`
+	CreatedAt string   `json:"created_at,omitempty"`
+	UpdatedAt string   `json:"updated_at,omitempty"`
+}
+
+// ListUsers calls GET /users.
+func (c *Client) ListUsers(ctx context.Context) ([]User, error) {
+	body, statusCode, err := c.doRequest(ctx, http.MethodGet, "/users", nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return nil, unexpectedStatusError(statusCode, body)
+	}
+
+	var users []User
+	if err := decodeList(body, &users); err != nil {
+		return nil, fmt.Errorf("decoding users response: %w", err)
+	}
+	return users, nil
+}
+
+// CreateUser calls POST /users.
+func (c *Client) CreateUser(ctx context.Context, req UserCreate) (*User, error) {
+	body, statusCode, err := c.doCreate(ctx, "/users", req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK && statusCode != http.StatusCreated {
+		return nil, unexpectedStatusError(statusCode, body)
+	}
+
+	var user User
+	if err := json.Unmarshal(body, &user); err != nil {
+		return nil, fmt.Errorf("decoding user response: %w", err)
+	}
+	return &user, nil
+}
+
+// GetUser calls GET /users/{id}.
+func (c *Client) GetUser(ctx context.Context, id string) (*User, error) {
+	return getObject[User](ctx, c, "/users/"+url.PathEscape(id), "user")
+}
+
+// UpdateUser calls PUT /users/{id}.
+func (c *Client) UpdateUser(ctx context.Context, id string, req UserUpdate) (*User, error) {
+	body, statusCode, err := c.doWrite(ctx, http.MethodPut, "/users/"+url.PathEscape(id), req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return nil, unexpectedStatusError(statusCode, body)
+	}
+
+	var user User
+	if err := json.Unmarshal(body, &user); err != nil {
+		return nil, fmt.Errorf("decoding user response: %w", err)
+	}
+	return &user, nil
+}
+
+// DeleteUser calls DELETE /users/{id}.
+func (c *Client) DeleteUser(ctx context.Context, id string) error {
+	body, statusCode, err := c.doRequest(ctx, http.MethodDelete, "/users/"+url.PathEscape(id), nil)
+	if err != nil {
+		return err
+	}
+	if statusCode != http.StatusOK && statusCode != http.StatusNoContent && statusCode != http.StatusNotFound {
+		return unexpectedStatusError(statusCode, body)
 	}
 	return nil
 }
 
 // --- Root types and methods ---
 
-// Root represents a root returned by the API.
+// Root represents a root returned by the API. Name is nil when the response
+// leaves it out, and is left out of a create request when nil.
 type Root struct {
-	URI  string `json:"uri"`
-	Name string `json:"name,omitempty"`
+	URI  string  `json:"uri"`
+	Name *string `json:"name,omitempty"`
 }
 
 // ListRoots calls GET /roots.
@@ -770,24 +2743,28 @@ func (c *Client) ListRoots(ctx context.Context) ([]Root, error) {
 		return nil, err
 	}
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var roots []Root
-	if err := json.Unmarshal(body, &roots); err != nil {
+	if err := decodeList(body, &roots); err != nil {
 		return nil, fmt.Errorf("decoding roots response: %w", err)
 	}
 	return roots, nil
 }
 
-// CreateRoot calls POST /roots.
+// CreateRoot calls POST /roots. A root is fully described by the request, so
+// an acknowledgement without a body returns the requested root.
 func (c *Client) CreateRoot(ctx context.Context, req Root) (*Root, error) {
-	body, statusCode, err := c.doRequest(ctx, http.MethodPost, "/roots", req)
+	body, statusCode, err := c.doCreate(ctx, "/roots", req)
+	if errors.Is(err, ErrEmptyResponse) {
+		return &req, nil
+	}
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK && statusCode != http.StatusCreated {
-		return nil, fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return nil, unexpectedStatusError(statusCode, body)
 	}
 
 	var root Root
@@ -804,7 +2781,53 @@ func (c *Client) DeleteRoot(ctx context.Context, uri string) error {
 		return err
 	}
 	if statusCode != http.StatusOK && statusCode != http.StatusNoContent && statusCode != http.StatusNotFound {
-		return fmt.Errorf("unexpected status code %d: %s", statusCode, string(body))
+		return unexpectedStatusError(statusCode, body)
 	}
 	return nil
 }
+
+// --- Passthrough config types and methods ---
+
+// PassthroughConfig is the gateway-wide allowlist of request headers that may
+// be passed through to upstream servers, served at /config/passthrough.
+type PassthroughConfig struct {
+	PassthroughHeaders []string `json:"passthrough_headers"`
+}
+
+// GetPassthroughConfig calls GET /config/passthrough.
+func (c *Client) GetPassthroughConfig(ctx context.Context) (*PassthroughConfig, error) {
+	body, statusCode, err := c.doRequest(ctx, http.MethodGet, "/config/passthrough", nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return nil, unexpectedStatusError(statusCode, body)
+	}
+
+	var config PassthroughConfig
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, fmt.Errorf("decoding passthrough config response: %w", err)
+	}
+	return &config, nil
+}
+
+// UpdatePassthroughConfig calls PUT /config/passthrough, replacing the whole
+// allowlist. A nil PassthroughHeaders is sent as an empty list.
+func (c *Client) UpdatePassthroughConfig(ctx context.Context, req PassthroughConfig) (*PassthroughConfig, error) {
+	if req.PassthroughHeaders == nil {
+		req.PassthroughHeaders = []string{}
+	}
+	body, statusCode, err := c.doWrite(ctx, http.MethodPut, "/config/passthrough", req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return nil, unexpectedStatusError(statusCode, body)
+	}
+
+	var config PassthroughConfig
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, fmt.Errorf("decoding passthrough config response: %w", err)
+	}
+	return &config, nil
+}
@@ -4,21 +4,62 @@
 package client
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"log"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 )
 
-func TestGetHealth(t *testing.T) {
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/health" {
-			t.Errorf("expected path /health, got %s", r.URL.Path)
-		}
-		if r.Method != http.MethodGet {
-			t.Errorf("expected GET, got %s", r.Method)
+// stringPointer returns a pointer to s, for the optional string fields.
+func stringPointer(s string) *string {
+	return &s
+}
+
+// derefString returns the string p points to, or "" when p is nil.
+func derefString(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
+
+func TestNormalizeBaseURL(t *testing.T) {
+	for in, want := range map[string]string{
+		"http://localhost:4444":         "http://localhost:4444",
+		"http://localhost:4444/":        "http://localhost:4444",
+		"https://host//":                "https://host",
+		"https://host/api//":            "https://host/api",
+		"https://host//api///v1/":       "https://host/api/v1",
+		"https://host/api?x=1":          "https://host/api?x=1",
+		"https://user@host:8443//api//": "https://user@host:8443/api",
+	} {
+		if got := NormalizeBaseURL(in); got != want {
+			t.Errorf("NormalizeBaseURL(%q) = %q, want %q", in, got, want)
 		}
+	}
+}
+
+func TestNewClient_MessyBaseURLPaths(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
 		w.Header().Set("Content-Type", "application/json")
 		if err := json.NewEncoder(w).Encode(HealthResponse{Status: "ok"}); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -27,423 +68,448 @@ func TestGetHealth(t *testing.T) {
 	}))
 	defer server.Close()
 
-	c := NewClient(server.URL, "")
-	health, err := c.GetHealth(context.Background())
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
-	}
-	if health.Status != "ok" {
-		t.Errorf("expected status ok, got %s", health.Status)
+	for suffix, wantPath := range map[string]string{
+		"":          "/health",
+		"/":         "/health",
+		"//":        "/health",
+		"/api//":    "/api/health",
+		"//api///":  "/api/health",
+		"/api/v1//": "/api/v1/health",
+	} {
+		c := NewClient(server.URL+suffix, "")
+		if _, err := c.GetHealth(context.Background()); err != nil {
+			t.Fatalf("base URL %q: unexpected error: %v", server.URL+suffix, err)
+		}
+		if gotPath != wantPath {
+			t.Errorf("base URL %q: expected path %s, got %s", server.URL+suffix, wantPath, gotPath)
+		}
 	}
 }
 
-func TestListServers(t *testing.T) {
+func TestListTools_GzipResponse(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/servers" {
-			t.Errorf("expected path /servers, got %s", r.URL.Path)
-		}
-		if r.Header.Get("Authorization") != "Bearer test-token" {
-			t.Errorf("expected auth header, got %s", r.Header.Get("Authorization"))
+		if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			t.Errorf("expected Accept-Encoding to advertise gzip, got %q", r.Header.Get("Accept-Encoding"))
 		}
 		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode([]Server{
-			{ID: "srv-1", Name: "test-server"},
-		}); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		if err := json.NewEncoder(gz).Encode([]Tool{{ID: "tool-1", Name: "compressed"}}); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+		if err := gz.Close(); err != nil {
+			t.Errorf("failed to close gzip writer: %v", err)
 		}
 	}))
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	servers, err := c.ListServers(context.Background(), false)
+	tools, err := c.ListTools(context.Background(), false)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(servers) != 1 {
-		t.Fatalf("expected 1 server, got %d", len(servers))
-	}
-	if servers[0].ID != "srv-1" {
-		t.Errorf("expected server ID srv-1, got %s", servers[0].ID)
+	if len(tools) != 1 || tools[0].Name != "compressed" {
+		t.Errorf("expected decompressed tool list, got %+v", tools)
 	}
 }
 
-func TestCreateServer(t *testing.T) {
+func TestDisableCompression(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			t.Errorf("expected POST, got %s", r.Method)
-		}
-		if r.URL.Path != "/servers" {
-			t.Errorf("expected path /servers, got %s", r.URL.Path)
-		}
-		if r.Header.Get("Content-Type") != "application/json" {
-			t.Errorf("expected content-type application/json, got %s", r.Header.Get("Content-Type"))
-		}
-
-		var req CreateServerRequest
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			t.Fatalf("failed to decode request body: %v", err)
-		}
-		if req.Server.Name != "my-server" {
-			t.Errorf("expected server name my-server, got %s", req.Server.Name)
+		if enc := r.Header.Get("Accept-Encoding"); enc != "" {
+			t.Errorf("expected no Accept-Encoding header, got %q", enc)
 		}
-
 		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusCreated)
-		if err := json.NewEncoder(w).Encode(Server{
-			ID:         "srv-new",
-			Name:       req.Server.Name,
-			Visibility: req.Visibility,
-		}); err != nil {
-			t.Errorf("failed to encode response: %v", err)
+		if err := json.NewEncoder(w).Encode([]Tool{{ID: "tool-1"}}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
 	}))
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	srv, err := c.CreateServer(context.Background(), CreateServerRequest{
-		Server:     ServerConfig{Name: "my-server", Description: "A test server"},
-		Visibility: "private",
-	})
-	if err != nil {
+	c.DisableCompression()
+	if _, err := c.ListTools(context.Background(), false); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if srv.ID != "srv-new" {
-		t.Errorf("expected server ID srv-new, got %s", srv.ID)
-	}
 }
 
-func TestGetServer(t *testing.T) {
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/servers/srv-1" {
-			t.Errorf("expected path /servers/srv-1, got %s", r.URL.Path)
-		}
+func TestSetMinTLSVersion(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(Server{ID: "srv-1", Name: "test-server"}); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
+		if _, err := w.Write([]byte(`{"status": "ok"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
 		}
 	}))
+	server.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	srv, err := c.GetServer(context.Background(), "srv-1")
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+	c.HTTPClient = server.Client()
+	c.MaxRetries = 0
+
+	c.SetMinTLSVersion(tls.VersionTLS12)
+	if _, err := c.GetHealth(context.Background()); err != nil {
+		t.Fatalf("expected a TLS 1.2 connection to succeed, got %v", err)
 	}
-	if srv == nil {
-		t.Fatal("expected server, got nil")
+
+	c.SetMinTLSVersion(tls.VersionTLS13)
+	c.HTTPClient.CloseIdleConnections()
+	_, err := c.GetHealth(context.Background())
+	if err == nil {
+		t.Fatal("expected the connection to be refused when TLS 1.3 is required")
 	}
-	if srv.ID != "srv-1" {
-		t.Errorf("expected server ID srv-1, got %s", srv.ID)
+	if !strings.Contains(err.Error(), "protocol version") {
+		t.Errorf("expected a TLS protocol version error, got %v", err)
 	}
 }
 
-func TestGetServer_NotFound(t *testing.T) {
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusNotFound)
+func TestSetMinTLSVersion_DoesNotModifyDefaultTransport(t *testing.T) {
+	c := NewClient("https://example.com", "test-token")
+	c.SetMinTLSVersion(tls.VersionTLS13)
+
+	if c.HTTPClient.Transport == http.DefaultTransport {
+		t.Fatal("expected the client to use its own transport")
+	}
+	if cfg := http.DefaultTransport.(*http.Transport).TLSClientConfig; cfg != nil && cfg.MinVersion == tls.VersionTLS13 {
+		t.Error("expected http.DefaultTransport to be left unchanged")
+	}
+}
+
+func TestSetRootCAs(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"status": "ok"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
 	}))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	srv, err := c.GetServer(context.Background(), "nonexistent")
-	if err != nil {
+	c.MaxRetries = 0
+	if _, err := c.GetHealth(context.Background()); err == nil {
+		t.Fatal("expected the self-signed certificate to be rejected without a CA bundle")
+	}
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := c.SetRootCAs(caPEM); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if srv != nil {
-		t.Errorf("expected nil server for 404, got %v", srv)
+	if _, err := c.GetHealth(context.Background()); err != nil {
+		t.Fatalf("expected the certificate to be trusted, got %v", err)
 	}
 }
 
-func TestDeleteServer(t *testing.T) {
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodDelete {
-			t.Errorf("expected DELETE, got %s", r.Method)
-		}
-		if r.URL.Path != "/servers/srv-1" {
-			t.Errorf("expected path /servers/srv-1, got %s", r.URL.Path)
+func TestSetRootCAs_InvalidPEM(t *testing.T) {
+	c := NewClient("https://example.com", "test-token")
+	if err := c.SetRootCAs([]byte("not a certificate")); err == nil {
+		t.Fatal("expected an error for a bundle without certificates")
+	}
+}
+
+func TestSetInsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"status": "ok"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
 		}
-		w.WriteHeader(http.StatusNoContent)
 	}))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	err := c.DeleteServer(context.Background(), "srv-1")
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+	c.MaxRetries = 0
+	c.SetInsecureSkipVerify(true)
+	if _, err := c.GetHealth(context.Background()); err != nil {
+		t.Fatalf("expected verification to be skipped, got %v", err)
 	}
 }
 
-func TestUpdateServer(t *testing.T) {
+func TestGetHealth(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPut {
-			t.Errorf("expected PUT, got %s", r.Method)
-		}
-		if r.URL.Path != "/servers/srv-1" {
-			t.Errorf("expected path /servers/srv-1, got %s", r.URL.Path)
-		}
-		if r.Header.Get("Content-Type") != "application/json" {
-			t.Errorf("expected content-type application/json, got %s", r.Header.Get("Content-Type"))
-		}
-
-		var req ServerUpdate
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			t.Fatalf("failed to decode request body: %v", err)
+		if r.URL.Path != "/health" {
+			t.Errorf("expected path /health, got %s", r.URL.Path)
 		}
-		if req.Name != "updated-server" {
-			t.Errorf("expected server name updated-server, got %s", req.Name)
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
 		}
-
 		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(Server{
-			ID:          "srv-1",
-			Name:        req.Name,
-			Description: req.Description,
-		}); err != nil {
-			t.Errorf("failed to encode response: %v", err)
+		if err := json.NewEncoder(w).Encode(HealthResponse{Status: "ok"}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
 	}))
 	defer server.Close()
 
-	c := NewClient(server.URL, "test-token")
-	srv, err := c.UpdateServer(context.Background(), "srv-1", ServerUpdate{
-		Name:        "updated-server",
-		Description: "Updated description",
-	})
+	c := NewClient(server.URL, "")
+	health, err := c.GetHealth(context.Background())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if srv.Name != "updated-server" {
-		t.Errorf("expected server name updated-server, got %s", srv.Name)
+	if health.Status != "ok" {
+		t.Errorf("expected status ok, got %s", health.Status)
 	}
 }
 
-// --- Gateway Tests ---
-
-func TestCreateGateway(t *testing.T) {
+func TestGetHealth_Detailed(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			t.Errorf("expected POST, got %s", r.Method)
-		}
-		if r.URL.Path != "/gateways" {
-			t.Errorf("expected path /gateways, got %s", r.URL.Path)
-		}
-		if r.Header.Get("Content-Type") != "application/json" {
-			t.Errorf("expected content-type application/json, got %s", r.Header.Get("Content-Type"))
-		}
-
-		var req GatewayCreate
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			t.Fatalf("failed to decode request body: %v", err)
-		}
-		if req.Name != "test-gw" {
-			t.Errorf("expected gateway name test-gw, got %s", req.Name)
-		}
-
 		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusCreated)
-		if err := json.NewEncoder(w).Encode(Gateway{
-			ID:          "gw-1",
-			Name:        req.Name,
-			URL:         req.URL,
-			Description: req.Description,
-			Transport:   req.Transport,
-			IsActive:    req.IsActive,
-			Tags:        req.Tags,
-		}); err != nil {
-			t.Errorf("failed to encode response: %v", err)
-			return
+		if _, err := w.Write([]byte(`{
+			"status": "healthy",
+			"version": "0.9.0",
+			"uptime_seconds": 3600.5,
+			"components": {"database": "ok", "redis": {"status": "degraded", "latency_ms": 12}}
+		}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
 		}
 	}))
 	defer server.Close()
 
-	c := NewClient(server.URL, "test-token")
-	gw, err := c.CreateGateway(context.Background(), GatewayCreate{
-		Name:        "test-gw",
-		URL:         "https://example.com",
-		Description: "Test",
-		Transport:   "STREAMABLEHTTP",
-		IsActive:    true,
-		Tags:        []string{"test"},
-	})
+	c := NewClient(server.URL, "")
+	health, err := c.GetHealth(context.Background())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if gw.ID != "gw-1" {
-		t.Errorf("expected gateway ID gw-1, got %s", gw.ID)
+	if health.Version != "0.9.0" {
+		t.Errorf("expected version 0.9.0, got %s", health.Version)
 	}
-	if gw.Name != "test-gw" {
-		t.Errorf("expected gateway name test-gw, got %s", gw.Name)
+	if health.UptimeSeconds == nil || *health.UptimeSeconds != 3600.5 {
+		t.Errorf("expected uptime 3600.5, got %v", health.UptimeSeconds)
+	}
+	if health.Components["database"] != "ok" {
+		t.Errorf("expected database ok, got %s", health.Components["database"])
+	}
+	if health.Components["redis"] != "degraded" {
+		t.Errorf("expected redis degraded, got %s", health.Components["redis"])
 	}
 }
 
-func TestGetGateway(t *testing.T) {
+func TestPing(t *testing.T) {
+	const delay = 20 * time.Millisecond
+	var calls atomic.Int64
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/gateways/gw-1" {
-			t.Errorf("expected path /gateways/gw-1, got %s", r.URL.Path)
+		calls.Add(1)
+		if r.URL.Path != "/health" {
+			t.Errorf("expected path /health, got %s", r.URL.Path)
 		}
+		time.Sleep(delay)
 		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(Gateway{ID: "gw-1", Name: "test-gw"}); err != nil {
+		if err := json.NewEncoder(w).Encode(HealthResponse{Status: "ok"}); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
 	}))
 	defer server.Close()
 
-	c := NewClient(server.URL, "test-token")
-	gw, err := c.GetGateway(context.Background(), "gw-1")
+	c := NewClient(server.URL, "")
+	c.CacheTTL = time.Minute
+	if _, err := c.GetHealth(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	latency, err := c.Ping(context.Background())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if gw == nil {
-		t.Fatal("expected gateway, got nil")
+	if latency < delay || latency > 10*time.Second {
+		t.Errorf("expected a latency of at least %s, got %s", delay, latency)
 	}
-	if gw.ID != "gw-1" {
-		t.Errorf("expected gateway ID gw-1, got %s", gw.ID)
+	if n := calls.Load(); n != 2 {
+		t.Errorf("expected Ping to bypass the response cache, got %d requests", n)
 	}
 }
 
-func TestGetGateway_NotFound(t *testing.T) {
+func TestProbeHealth(t *testing.T) {
+	var calls atomic.Int64
+	status := http.StatusServiceUnavailable
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusNotFound)
+		calls.Add(1)
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status": "ok", "version": "0.9.0"}`))
 	}))
 	defer server.Close()
 
-	c := NewClient(server.URL, "test-token")
-	gw, err := c.GetGateway(context.Background(), "nonexistent")
+	c := NewClient(server.URL, "")
+	c.MaxRetries = 3
+	if _, err := c.ProbeHealth(context.Background()); err == nil {
+		t.Fatal("expected an error for a 503 response")
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("expected a single request without retries, got %d", n)
+	}
+
+	status = http.StatusOK
+	health, err := c.ProbeHealth(context.Background())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if gw != nil {
-		t.Errorf("expected nil gateway for 404, got %v", gw)
+	if health.Version != "0.9.0" {
+		t.Errorf("expected version 0.9.0, got %q", health.Version)
 	}
 }
 
-func TestUpdateGateway(t *testing.T) {
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPut {
-			t.Errorf("expected PUT, got %s", r.Method)
-		}
-		if r.URL.Path != "/gateways/gw-1" {
-			t.Errorf("expected path /gateways/gw-1, got %s", r.URL.Path)
-		}
-		if r.Header.Get("Content-Type") != "application/json" {
-			t.Errorf("expected content-type application/json, got %s", r.Header.Get("Content-Type"))
-		}
+func TestPing_Errors(t *testing.T) {
+	retryInitialInterval = time.Millisecond
+	defer func() { retryInitialInterval = 500 * time.Millisecond }()
 
-		var req GatewayUpdate
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			t.Fatalf("failed to decode request body: %v", err)
-		}
-		if req.Name != "updated-gw" {
-			t.Errorf("expected gateway name updated-gw, got %s", req.Name)
-		}
+	var calls atomic.Int64
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unhealthy.Close()
+
+	latency, err := NewClient(unhealthy.URL, "").Ping(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected a 503 APIError, got %v", err)
+	}
+	if latency != 0 {
+		t.Errorf("expected no latency on error, got %s", latency)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("expected a single attempt, got %d", n)
+	}
+
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	latency, err = NewClient(unreachable.URL, "").Ping(context.Background())
+	if err == nil {
+		t.Fatal("expected an error for an unreachable gateway")
+	}
+	if latency != 0 {
+		t.Errorf("expected no latency on error, got %s", latency)
+	}
+}
 
+func TestGetOpenAPISchema(t *testing.T) {
+	doc := `{"openapi": "3.1.0", "info": {"title": "MCP Gateway", "version": "0.9.0"}, "paths": {"/health": {}}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/openapi.json" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
 		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(Gateway{
-			ID:   "gw-1",
-			Name: req.Name,
-			URL:  req.URL,
-		}); err != nil {
-			t.Errorf("failed to encode response: %v", err)
-			return
+		if _, err := w.Write([]byte(doc)); err != nil {
+			t.Errorf("failed to write response: %v", err)
 		}
 	}))
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	gw, err := c.UpdateGateway(context.Background(), "gw-1", GatewayUpdate{
-		Name: "updated-gw",
-		URL:  "https://updated.example.com",
-	})
+	schema, err := c.GetOpenAPISchema(context.Background())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if gw.Name != "updated-gw" {
-		t.Errorf("expected gateway name updated-gw, got %s", gw.Name)
+	if schema.OpenAPIVersion != "3.1.0" || schema.Version != "0.9.0" {
+		t.Errorf("unexpected versions: %+v", schema)
+	}
+	if string(schema.Raw) != doc {
+		t.Errorf("expected the raw document to be kept, got %s", schema.Raw)
 	}
 }
 
-func TestDeleteGateway(t *testing.T) {
+func TestGetOpenAPISchema_NotFound(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodDelete {
-			t.Errorf("expected DELETE, got %s", r.Method)
-		}
-		if r.URL.Path != "/gateways/gw-1" {
-			t.Errorf("expected path /gateways/gw-1, got %s", r.URL.Path)
-		}
-		w.WriteHeader(http.StatusNoContent)
+		w.WriteHeader(http.StatusNotFound)
 	}))
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	err := c.DeleteGateway(context.Background(), "gw-1")
+	if _, err := c.GetOpenAPISchema(context.Background()); err == nil {
+		t.Fatal("expected an error when the gateway does not publish a schema")
+	}
+}
+func TestGetHealth_Minimal(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"status": "ok"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	health, err := c.GetHealth(context.Background())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
+	if health.Version != "" || health.UptimeSeconds != nil || health.Components != nil {
+		t.Errorf("expected optional fields to be empty, got %+v", health)
+	}
 }
 
-// --- Tool Tests ---
-
-func TestCreateTool(t *testing.T) {
+func TestGetHealth_NotGateway(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			t.Errorf("expected POST, got %s", r.Method)
-		}
-		if r.URL.Path != "/tools" {
-			t.Errorf("expected path /tools, got %s", r.URL.Path)
-		}
-		if r.Header.Get("Content-Type") != "application/json" {
-			t.Errorf("expected content-type application/json, got %s", r.Header.Get("Content-Type"))
+		w.Header().Set("Content-Type", "text/html")
+		if _, err := w.Write([]byte(`<html><body>Welcome</body></html>`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
 		}
+	}))
+	defer server.Close()
 
-		var req CreateToolRequest
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			t.Fatalf("failed to decode request body: %v", err)
-		}
-		if req.Tool.Name != "test-tool" {
-			t.Errorf("expected tool name test-tool, got %s", req.Tool.Name)
-		}
+	c := NewClient(server.URL, "")
+	_, err := c.GetHealth(context.Background())
+	if !errors.Is(err, ErrNotGateway) {
+		t.Fatalf("expected ErrNotGateway, got %v", err)
+	}
+}
 
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusCreated)
-		if err := json.NewEncoder(w).Encode(Tool{
-			ID:          "tool-1",
-			Name:        req.Tool.Name,
-			Description: req.Tool.Description,
-			Visibility:  req.Visibility,
-		}); err != nil {
-			t.Errorf("failed to encode response: %v", err)
-			return
-		}
+func TestDoRequest_ContextCanceled(t *testing.T) {
+	started := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-r.Context().Done()
 	}))
 	defer server.Close()
 
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
 	c := NewClient(server.URL, "test-token")
-	tool, err := c.CreateTool(context.Background(), CreateToolRequest{
-		Tool:       ToolCreate{Name: "test-tool", Description: "Test tool"},
-		Visibility: "private",
-	})
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
-	}
-	if tool.ID != "tool-1" {
-		t.Errorf("expected tool ID tool-1, got %s", tool.ID)
+	_, err := c.GetServer(ctx, "srv-1")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
 	}
-	if tool.Name != "test-tool" {
-		t.Errorf("expected tool name test-tool, got %s", tool.Name)
+}
+
+func TestDoRequest_ContextAlreadyCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not reach the server")
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c := NewClient(server.URL, "test-token")
+	_, err := c.ListTools(ctx, false)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
 	}
 }
 
-func TestGetTool(t *testing.T) {
+func TestListServers(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/tools/tool-1" {
-			t.Errorf("expected path /tools/tool-1, got %s", r.URL.Path)
+		if r.URL.Path != "/servers" {
+			t.Errorf("expected path /servers, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			t.Errorf("expected auth header, got %s", r.Header.Get("Authorization"))
 		}
 		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(Tool{ID: "tool-1", Name: "test-tool"}); err != nil {
+		if err := json.NewEncoder(w).Encode([]Server{
+			{ID: "srv-1", Name: "test-server"},
+		}); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
@@ -451,81 +517,99 @@ func TestGetTool(t *testing.T) {
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	tool, err := c.GetTool(context.Background(), "tool-1")
+	servers, err := c.ListServers(context.Background(), false)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if tool == nil {
-		t.Fatal("expected tool, got nil")
+	if len(servers) != 1 {
+		t.Fatalf("expected 1 server, got %d", len(servers))
 	}
-	if tool.ID != "tool-1" {
-		t.Errorf("expected tool ID tool-1, got %s", tool.ID)
+	if servers[0].ID != "srv-1" {
+		t.Errorf("expected server ID srv-1, got %s", servers[0].ID)
 	}
 }
 
-func TestGetTool_NotFound(t *testing.T) {
+func TestListServers_DataEnvelope(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusNotFound)
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"data": [{"id": "srv-1", "name": "a"}, {"id": "srv-2", "name": "b"}], "total": 2}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
 	}))
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	tool, err := c.GetTool(context.Background(), "nonexistent")
+	servers, err := c.ListServers(context.Background(), false)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if tool != nil {
-		t.Errorf("expected nil tool for 404, got %v", tool)
+	if len(servers) != 2 {
+		t.Fatalf("expected 2 servers, got %d", len(servers))
+	}
+	if servers[1].ID != "srv-2" {
+		t.Errorf("expected server ID srv-2, got %s", servers[1].ID)
 	}
 }
 
-func TestDeleteTool(t *testing.T) {
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodDelete {
-			t.Errorf("expected DELETE, got %s", r.Method)
-		}
-		if r.URL.Path != "/tools/tool-1" {
-			t.Errorf("expected path /tools/tool-1, got %s", r.URL.Path)
-		}
-		w.WriteHeader(http.StatusNoContent)
-	}))
-	defer server.Close()
+func TestDecodeList(t *testing.T) {
+	cases := map[string]struct {
+		body    string
+		want    int
+		wantErr bool
+	}{
+		"bare array":            {body: `[{"id": "a"}, {"id": "b"}]`, want: 2},
+		"empty bare array":      {body: `[]`, want: 0},
+		"data envelope":         {body: `{"data": [{"id": "a"}], "total": 1}`, want: 1},
+		"envelope leading ws":   {body: "\n  {\"data\": []}", want: 0},
+		"envelope without data": {body: `{"items": []}`, wantErr: true},
+		"malformed":             {body: `{"data": `, wantErr: true},
+	}
 
-	c := NewClient(server.URL, "test-token")
-	err := c.DeleteTool(context.Background(), "tool-1")
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var tools []Tool
+			err := decodeList([]byte(tc.body), &tools)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(tools) != tc.want {
+				t.Errorf("expected %d tools, got %d", tc.want, len(tools))
+			}
+		})
 	}
 }
 
-// --- Resource Tests ---
-
-func TestCreateResource(t *testing.T) {
+func TestCreateServer(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			t.Errorf("expected POST, got %s", r.Method)
 		}
-		if r.URL.Path != "/resources" {
-			t.Errorf("expected path /resources, got %s", r.URL.Path)
+		if r.URL.Path != "/servers" {
+			t.Errorf("expected path /servers, got %s", r.URL.Path)
 		}
 		if r.Header.Get("Content-Type") != "application/json" {
 			t.Errorf("expected content-type application/json, got %s", r.Header.Get("Content-Type"))
 		}
 
-		var req CreateResourceRequest
+		var req CreateServerRequest
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 			t.Fatalf("failed to decode request body: %v", err)
 		}
-		if req.Resource.Name != "test-res" {
-			t.Errorf("expected resource name test-res, got %s", req.Resource.Name)
+		if req.Server.Name != "my-server" {
+			t.Errorf("expected server name my-server, got %s", req.Server.Name)
 		}
 
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusCreated)
-		if err := json.NewEncoder(w).Encode(Resource{
-			ID:         "res-1",
-			URI:        req.Resource.URI,
-			Name:       req.Resource.Name,
+		if err := json.NewEncoder(w).Encode(Server{
+			ID:         "srv-new",
+			Name:       req.Server.Name,
 			Visibility: req.Visibility,
 		}); err != nil {
 			t.Errorf("failed to encode response: %v", err)
@@ -535,28 +619,61 @@ func TestCreateResource(t *testing.T) {
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	res, err := c.CreateResource(context.Background(), CreateResourceRequest{
-		Resource:   ResourceCreate{URI: "file:///test", Name: "test-res"},
+	srv, err := c.CreateServer(context.Background(), CreateServerRequest{
+		Server:     ServerConfig{Name: "my-server", Description: "A test server"},
 		Visibility: "private",
 	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if res.ID != "res-1" {
-		t.Errorf("expected resource ID res-1, got %s", res.ID)
+	if srv.ID != "srv-new" {
+		t.Errorf("expected server ID srv-new, got %s", srv.ID)
 	}
-	if res.Name != "test-res" {
-		t.Errorf("expected resource name test-res, got %s", res.Name)
+}
+
+func TestCreateServer_TeamID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if req["visibility"] != "team" {
+			t.Errorf("expected visibility team, got %v", req["visibility"])
+		}
+		if req["team_id"] != "team-platform" {
+			t.Errorf("expected team_id team-platform at the top level, got %v", req["team_id"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(Server{ID: "srv-team", Name: "team-server", Visibility: "team", TeamID: stringPointer("team-platform")}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	srv, err := c.CreateServer(context.Background(), CreateServerRequest{
+		Server:     ServerConfig{Name: "team-server"},
+		Visibility: "team",
+		TeamID:     "team-platform",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if derefString(srv.TeamID) != "team-platform" {
+		t.Errorf("expected team ID team-platform, got %q", derefString(srv.TeamID))
 	}
 }
 
-func TestGetResource(t *testing.T) {
+func TestGetServer(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/resources/res-1/info" {
-			t.Errorf("expected path /resources/res-1/info, got %s", r.URL.Path)
+		if r.URL.Path != "/servers/srv-1" {
+			t.Errorf("expected path /servers/srv-1, got %s", r.URL.Path)
 		}
 		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(Resource{ID: "res-1", Name: "test-res", URI: "file:///test"}); err != nil {
+		if err := json.NewEncoder(w).Encode(Server{ID: "srv-1", Name: "test-server"}); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
@@ -564,112 +681,116 @@ func TestGetResource(t *testing.T) {
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	res, err := c.GetResource(context.Background(), "res-1")
+	srv, err := c.GetServer(context.Background(), "srv-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if res == nil {
-		t.Fatal("expected resource, got nil")
+	if srv == nil {
+		t.Fatal("expected server, got nil")
 	}
-	if res.ID != "res-1" {
-		t.Errorf("expected resource ID res-1, got %s", res.ID)
+	if srv.ID != "srv-1" {
+		t.Errorf("expected server ID srv-1, got %s", srv.ID)
 	}
 }
 
-func TestGetResource_NotFound(t *testing.T) {
+func TestGetServer_NotFound(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNotFound)
 	}))
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	res, err := c.GetResource(context.Background(), "nonexistent")
+	srv, err := c.GetServer(context.Background(), "nonexistent")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if res != nil {
-		t.Errorf("expected nil resource for 404, got %v", res)
+	if srv != nil {
+		t.Errorf("expected nil server for 404, got %v", srv)
 	}
 }
 
-func TestDeleteResource(t *testing.T) {
+func TestGetServer_Forbidden(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodDelete {
-			t.Errorf("expected DELETE, got %s", r.Method)
-		}
-		if r.URL.Path != "/resources/res-1" {
-			t.Errorf("expected path /resources/res-1, got %s", r.URL.Path)
+		w.WriteHeader(http.StatusForbidden)
+		if _, err := w.Write([]byte(`{"detail":"insufficient scope"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
 		}
-		w.WriteHeader(http.StatusNoContent)
 	}))
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	err := c.DeleteResource(context.Background(), "res-1")
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+	_, err := c.GetServer(context.Background(), "srv-1")
+	var forbidden *ForbiddenError
+	if !errors.As(err, &forbidden) {
+		t.Fatalf("expected ForbiddenError, got %v", err)
+	}
+	if forbidden.Body != `{"detail":"insufficient scope"}` {
+		t.Errorf("unexpected forbidden body %q", forbidden.Body)
 	}
 }
 
-// --- Prompt Tests ---
+func TestDeleteServer_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
 
-func TestCreatePrompt(t *testing.T) {
+	c := NewClient(server.URL, "test-token")
+	err := c.DeleteServer(context.Background(), "srv-1", false)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	var forbidden *ForbiddenError
+	if errors.As(err, &forbidden) {
+		t.Errorf("expected generic error for 500, got ForbiddenError")
+	}
+}
+
+func TestWaitForServerActive(t *testing.T) {
+	pollInitialInterval = time.Millisecond
+	defer func() { pollInitialInterval = 500 * time.Millisecond }()
+
+	calls := 0
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			t.Errorf("expected POST, got %s", r.Method)
-		}
-		if r.URL.Path != "/prompts" {
-			t.Errorf("expected path /prompts, got %s", r.URL.Path)
-		}
-		if r.Header.Get("Content-Type") != "application/json" {
-			t.Errorf("expected content-type application/json, got %s", r.Header.Get("Content-Type"))
-		}
-
-		var req CreatePromptRequest
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			t.Fatalf("failed to decode request body: %v", err)
-		}
-		if req.Prompt.Name != "test-prompt" {
-			t.Errorf("expected prompt name test-prompt, got %s", req.Prompt.Name)
+		calls++
+		status := "initializing"
+		if calls >= 3 {
+			status = ServerStatusActive
 		}
-
 		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusCreated)
-		if err := json.NewEncoder(w).Encode(Prompt{
-			ID:          "prompt-1",
-			Name:        req.Prompt.Name,
-			Description: req.Prompt.Description,
-			Visibility:  req.Visibility,
-		}); err != nil {
-			t.Errorf("failed to encode response: %v", err)
+		if err := json.NewEncoder(w).Encode(Server{ID: "srv-1", Status: status}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
 	}))
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	prompt, err := c.CreatePrompt(context.Background(), CreatePromptRequest{
-		Prompt:     PromptCreate{Name: "test-prompt", Description: "Test"},
-		Visibility: "public",
-	})
+	srv, err := c.WaitForServerActive(context.Background(), "srv-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if prompt.ID != "prompt-1" {
-		t.Errorf("expected prompt ID prompt-1, got %s", prompt.ID)
+	if srv.Status != ServerStatusActive {
+		t.Errorf("expected status active, got %s", srv.Status)
 	}
-	if prompt.Name != "test-prompt" {
-		t.Errorf("expected prompt name test-prompt, got %s", prompt.Name)
+	if calls != 3 {
+		t.Errorf("expected 3 polls, got %d", calls)
 	}
 }
 
-func TestGetPrompt(t *testing.T) {
+func TestWaitForServerActive_BypassesCache(t *testing.T) {
+	pollInitialInterval = time.Millisecond
+	defer func() { pollInitialInterval = 500 * time.Millisecond }()
+
+	calls := 0
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/prompts/prompt-1" {
-			t.Errorf("expected path /prompts/prompt-1, got %s", r.URL.Path)
+		calls++
+		status := "initializing"
+		if calls >= 3 {
+			status = ServerStatusActive
 		}
 		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(Prompt{ID: "prompt-1", Name: "test-prompt"}); err != nil {
+		if err := json.NewEncoder(w).Encode(Server{ID: "srv-1", Status: status}); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
@@ -677,80 +798,102 @@ func TestGetPrompt(t *testing.T) {
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	prompt, err := c.GetPrompt(context.Background(), "prompt-1")
-	if err != nil {
+	c.CacheTTL = time.Minute
+	if _, err := c.GetServer(context.Background(), "srv-1"); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if prompt == nil {
-		t.Fatal("expected prompt, got nil")
+
+	srv, err := c.WaitForServerActive(context.Background(), "srv-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
-	if prompt.ID != "prompt-1" {
-		t.Errorf("expected prompt ID prompt-1, got %s", prompt.ID)
+	if srv.Status != ServerStatusActive {
+		t.Errorf("expected status active, got %s", srv.Status)
 	}
 }
 
-func TestGetPrompt_NotFound(t *testing.T) {
+func TestWaitForServerActive_ContextDone(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusNotFound)
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Server{ID: "srv-1", Status: "initializing"}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
 	}))
 	defer server.Close()
 
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
 	c := NewClient(server.URL, "test-token")
-	prompt, err := c.GetPrompt(context.Background(), "nonexistent")
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
-	}
-	if prompt != nil {
-		t.Errorf("expected nil prompt for 404, got %v", prompt)
+	_, err := c.WaitForServerActive(ctx, "srv-1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
 	}
 }
 
-func TestDeletePrompt(t *testing.T) {
+func TestDeleteServer(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodDelete {
 			t.Errorf("expected DELETE, got %s", r.Method)
 		}
-		if r.URL.Path != "/prompts/prompt-1" {
-			t.Errorf("expected path /prompts/prompt-1, got %s", r.URL.Path)
+		if r.URL.Path != "/servers/srv-1" {
+			t.Errorf("expected path /servers/srv-1, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Has("force") {
+			t.Errorf("expected no force query param, got %q", r.URL.RawQuery)
 		}
 		w.WriteHeader(http.StatusNoContent)
 	}))
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	err := c.DeletePrompt(context.Background(), "prompt-1")
+	err := c.DeleteServer(context.Background(), "srv-1", false)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
 
-// --- Root Tests ---
+func TestDeleteServer_Force(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("force"); got != "true" {
+			t.Errorf("expected force=true, got %q", got)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
 
-func TestCreateRoot(t *testing.T) {
+	c := NewClient(server.URL, "test-token")
+	if err := c.DeleteServer(context.Background(), "srv-1", true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestUpdateServer(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			t.Errorf("expected POST, got %s", r.Method)
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
 		}
-		if r.URL.Path != "/roots" {
-			t.Errorf("expected path /roots, got %s", r.URL.Path)
+		if r.URL.Path != "/servers/srv-1" {
+			t.Errorf("expected path /servers/srv-1, got %s", r.URL.Path)
 		}
 		if r.Header.Get("Content-Type") != "application/json" {
 			t.Errorf("expected content-type application/json, got %s", r.Header.Get("Content-Type"))
 		}
 
-		var req Root
+		var req ServerUpdate
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 			t.Fatalf("failed to decode request body: %v", err)
 		}
-		if req.URI != "file:///workspace" {
-			t.Errorf("expected root URI file:///workspace, got %s", req.URI)
+		if req.Name != "updated-server" {
+			t.Errorf("expected server name updated-server, got %s", req.Name)
 		}
 
 		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusCreated)
-		if err := json.NewEncoder(w).Encode(Root{
-			URI:  req.URI,
-			Name: req.Name,
+		if err := json.NewEncoder(w).Encode(Server{
+			ID:          "srv-1",
+			Name:        req.Name,
+			Description: req.Description,
 		}); err != nil {
 			t.Errorf("failed to encode response: %v", err)
 			return
@@ -759,67 +902,3192 @@ func TestCreateRoot(t *testing.T) {
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	root, err := c.CreateRoot(context.Background(), Root{
-		URI:  "file:///workspace",
-		Name: "test-root",
+	srv, err := c.UpdateServer(context.Background(), "srv-1", ServerUpdate{
+		Name:        "updated-server",
+		Description: "Updated description",
 	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if root.URI != "file:///workspace" {
-		t.Errorf("expected root URI file:///workspace, got %s", root.URI)
+	if srv.Name != "updated-server" {
+		t.Errorf("expected server name updated-server, got %s", srv.Name)
 	}
-	if root.Name != "test-root" {
-		t.Errorf("expected root name test-root, got %s", root.Name)
+}
+
+// --- Empty Write Response Tests ---
+
+func TestCreateServer_NoContentFollowsLocation(t *testing.T) {
+	for name, location := range map[string]func(baseURL string) string{
+		"relative":      func(string) string { return "servers/srv-new" },
+		"absolute path": func(string) string { return "/api/servers/srv-new" },
+		"absolute URL":  func(baseURL string) string { return baseURL + "/servers/srv-new" },
+	} {
+		t.Run(name, func(t *testing.T) {
+			var baseURL string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/api/servers":
+					w.Header().Set("Location", location(baseURL))
+					w.WriteHeader(http.StatusNoContent)
+				case r.Method == http.MethodGet && r.URL.Path == "/api/servers/srv-new":
+					w.Header().Set("Content-Type", "application/json")
+					if _, err := w.Write([]byte(`{"id": "srv-new", "name": "my-server"}`)); err != nil {
+						t.Errorf("failed to write response: %v", err)
+					}
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+			baseURL = server.URL + "/api"
+
+			c := NewClient(baseURL, "test-token")
+			srv, err := c.CreateServer(context.Background(), CreateServerRequest{Server: ServerConfig{Name: "my-server"}})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if srv.ID != "srv-new" || srv.Name != "my-server" {
+				t.Errorf("expected the created server to be fetched, got %+v", srv)
+			}
+		})
 	}
 }
 
-func TestListRoots(t *testing.T) {
+func TestCreateTool_NoContentWithoutLocation(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/roots" {
-			t.Errorf("expected path /roots, got %s", r.URL.Path)
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
 		}
-		if r.Header.Get("Authorization") != "Bearer test-token" {
-			t.Errorf("expected auth header, got %s", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	_, err := c.CreateTool(context.Background(), CreateToolRequest{Tool: ToolCreate{Name: "my-tool"}})
+	if !errors.Is(err, ErrEmptyResponse) {
+		t.Fatalf("expected ErrEmptyResponse, got %v", err)
+	}
+}
+
+func TestCreateGateway_LocationOnOtherHost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
 		}
-		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode([]Root{
-			{URI: "file:///workspace", Name: "test-root"},
-		}); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
+		w.Header().Set("Location", "https://elsewhere.example.com/gateways/gw-1")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	_, err := c.CreateGateway(context.Background(), GatewayCreate{Name: "gw", URL: "https://example.com/mcp"})
+	if err == nil || !strings.Contains(err.Error(), "outside the gateway endpoint") {
+		t.Fatalf("expected the foreign Location to be rejected, got %v", err)
+	}
+}
+
+func TestUpdateTool_NoContentFetchesObject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/tools/tool-1":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/tools/tool-1":
+			w.Header().Set("Content-Type", "application/json")
+			if _, err := w.Write([]byte(`{"id": "tool-1", "name": "my-tool", "description": "updated"}`)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
 		}
 	}))
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	roots, err := c.ListRoots(context.Background())
+	tool, err := c.UpdateTool(context.Background(), "tool-1", ToolUpdate{Description: "updated"})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(roots) != 1 {
-		t.Fatalf("expected 1 root, got %d", len(roots))
+	if derefString(tool.Description) != "updated" {
+		t.Errorf("expected the updated tool to be fetched, got %+v", tool)
 	}
-	if roots[0].URI != "file:///workspace" {
-		t.Errorf("expected root URI file:///workspace, got %s", roots[0].URI)
+}
+
+func TestCreateRoot_NoContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	root, err := c.CreateRoot(context.Background(), Root{URI: "file:///data", Name: stringPointer("data")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if root.URI != "file:///data" || derefString(root.Name) != "data" {
+		t.Errorf("expected the requested root, got %+v", root)
 	}
 }
 
-func TestDeleteRoot(t *testing.T) {
+// --- Conditional Read Tests ---
+
+func TestGetTool_NotModifiedReusesObject(t *testing.T) {
+	var requests int
+	var ifNoneMatch []string
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodDelete {
-			t.Errorf("expected DELETE, got %s", r.Method)
+		requests++
+		ifNoneMatch = append(ifNoneMatch, r.Header.Get("If-None-Match"))
+		switch requests {
+		case 1:
+			w.Header().Set("ETag", `"v1"`)
+			w.Header().Set("Content-Type", "application/json")
+			if _, err := w.Write([]byte(`{"id": "tool-1", "name": "my-tool", "tags": ["a"]}`)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+		case 2:
+			w.WriteHeader(http.StatusNotModified)
+		default:
+			w.Header().Set("ETag", `"v2"`)
+			w.Header().Set("Content-Type", "application/json")
+			if _, err := w.Write([]byte(`{"id": "tool-1", "name": "renamed"}`)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
 		}
-		if r.URL.Path != "/roots/file:///workspace" {
-			t.Errorf("expected path /roots/file:///workspace, got %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	ctx := context.Background()
+
+	first, err := c.GetTool(ctx, "tool-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := c.GetTool(ctx, "tool-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if second == first || !reflect.DeepEqual(second, first) {
+		t.Errorf("expected a copy of the first tool after 304, got %+v", second)
+	}
+	third, err := c.GetTool(ctx, "tool-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if third.Name != "renamed" {
+		t.Errorf("expected the changed tool to be decoded, got %+v", third)
+	}
+
+	want := []string{"", `"v1"`, `"v1"`}
+	if !reflect.DeepEqual(ifNoneMatch, want) {
+		t.Errorf("expected If-None-Match headers %q, got %q", want, ifNoneMatch)
+	}
+}
+
+func TestGetServer_WithoutETag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("If-None-Match"); got != "" {
+			t.Errorf("expected no If-None-Match header, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"id": "srv-1", "name": "my-server"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
 		}
-		w.WriteHeader(http.StatusNoContent)
 	}))
 	defer server.Close()
 
 	c := NewClient(server.URL, "test-token")
-	err := c.DeleteRoot(context.Background(), "file:///workspace")
+	for range 2 {
+		if _, err := c.GetServer(context.Background(), "srv-1"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+func TestGetPrompt_NotFoundDropsETag(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch requests {
+		case 1:
+			w.Header().Set("ETag", `"v1"`)
+			w.Header().Set("Content-Type", "application/json")
+			if _, err := w.Write([]byte(`{"id": "prompt-1", "name": "my-prompt"}`)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+		case 2:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			if got := r.Header.Get("If-None-Match"); got != "" {
+				t.Errorf("expected no If-None-Match header after 404, got %q", got)
+			}
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	for range 3 {
+		if _, err := c.GetPrompt(context.Background(), "prompt-1"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+// --- Active Flag Tests ---
+
+func TestUnmarshalActiveFlag(t *testing.T) {
+	cases := map[string]struct {
+		payload string
+		want    bool
+	}{
+		"is_active true":    {payload: `{"id": "x", "is_active": true}`, want: true},
+		"is_active false":   {payload: `{"id": "x", "is_active": false}`},
+		"enabled true":      {payload: `{"id": "x", "enabled": true}`, want: true},
+		"enabled false":     {payload: `{"id": "x", "enabled": false}`},
+		"is_active wins":    {payload: `{"id": "x", "is_active": false, "enabled": true}`},
+		"neither":           {payload: `{"id": "x"}`},
+		"null is_active":    {payload: `{"id": "x", "is_active": null, "enabled": true}`, want: true},
+		"other fields kept": {payload: `{"id": "x", "name": "n", "enabled": true}`, want: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var gateway Gateway
+			var tool Tool
+			var resource Resource
+			var prompt Prompt
+			for _, target := range []interface{}{&gateway, &tool, &resource, &prompt} {
+				if err := json.Unmarshal([]byte(tc.payload), target); err != nil {
+					t.Fatalf("unexpected error decoding %T: %v", target, err)
+				}
+			}
+
+			got := map[string]bool{
+				"gateway":  gateway.IsActive,
+				"tool":     tool.IsActive,
+				"resource": resource.IsActive,
+				"prompt":   prompt.IsActive,
+			}
+			for kind, active := range got {
+				if active != tc.want {
+					t.Errorf("%s: expected is_active %t, got %t", kind, tc.want, active)
+				}
+			}
+			if gateway.ID != "x" || tool.ID != "x" || resource.ID != "x" || prompt.ID != "x" {
+				t.Errorf("expected the other fields to be decoded, got %+v %+v %+v %+v", gateway, tool, resource, prompt)
+			}
+		})
+	}
+}
+
+func TestListTools_EnabledKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`[{"id": "tool-1", "name": "a", "enabled": true}, {"id": "tool-2", "name": "b", "enabled": false}]`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	tools, err := c.ListTools(context.Background(), true)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
+	if len(tools) != 2 || !tools[0].IsActive || tools[1].IsActive {
+		t.Errorf("expected enabled to be read as the active flag, got %+v", tools)
+	}
+}
+
+// --- Gateway Tests ---
+
+func TestCreateGateway(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/gateways" {
+			t.Errorf("expected path /gateways, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("expected content-type application/json, got %s", r.Header.Get("Content-Type"))
+		}
+
+		var req GatewayCreate
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if req.Name != "test-gw" {
+			t.Errorf("expected gateway name test-gw, got %s", req.Name)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(Gateway{
+			ID:          "gw-1",
+			Name:        req.Name,
+			URL:         req.URL,
+			Description: stringPointer(req.Description),
+			Transport:   stringPointer(req.Transport),
+			IsActive:    req.IsActive,
+			Tags:        req.Tags,
+		}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	gw, err := c.CreateGateway(context.Background(), GatewayCreate{
+		Name:        "test-gw",
+		URL:         "https://example.com",
+		Description: "Test",
+		Transport:   "STREAMABLEHTTP",
+		IsActive:    true,
+		Tags:        []string{"test"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gw.ID != "gw-1" {
+		t.Errorf("expected gateway ID gw-1, got %s", gw.ID)
+	}
+	if gw.Name != "test-gw" {
+		t.Errorf("expected gateway name test-gw, got %s", gw.Name)
+	}
+}
+
+func TestCreateGateway_AsyncJob(t *testing.T) {
+	pollInitialInterval = time.Millisecond
+	defer func() { pollInitialInterval = 500 * time.Millisecond }()
+
+	for name, tc := range map[string]struct {
+		accepted func(w http.ResponseWriter)
+		finished Job
+	}{
+		"location and resource ID": {
+			accepted: func(w http.ResponseWriter) {
+				w.Header().Set("Location", "/jobs/job-1")
+				w.WriteHeader(http.StatusAccepted)
+			},
+			finished: Job{ID: "job-1", Status: JobStatusCompleted, ResourceID: "gw-1"},
+		},
+		"job URL and embedded result": {
+			accepted: func(w http.ResponseWriter) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusAccepted)
+				if err := json.NewEncoder(w).Encode(Job{ID: "job-1", Status: JobStatusPending, JobURL: "jobs/job-1"}); err != nil {
+					t.Errorf("failed to write response: %v", err)
+				}
+			},
+			finished: Job{ID: "job-1", Status: JobStatusCompleted, Result: json.RawMessage(`{"id": "gw-1", "name": "async-gw"}`)},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			polls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/gateways":
+					tc.accepted(w)
+				case r.Method == http.MethodGet && r.URL.Path == "/jobs/job-1":
+					polls++
+					job := Job{ID: "job-1", Status: JobStatusRunning}
+					if polls >= 3 {
+						job = tc.finished
+					}
+					w.Header().Set("Content-Type", "application/json")
+					if err := json.NewEncoder(w).Encode(job); err != nil {
+						t.Errorf("failed to write response: %v", err)
+					}
+				case r.Method == http.MethodGet && r.URL.Path == "/gateways/gw-1":
+					w.Header().Set("Content-Type", "application/json")
+					if err := json.NewEncoder(w).Encode(Gateway{ID: "gw-1", Name: "async-gw"}); err != nil {
+						t.Errorf("failed to write response: %v", err)
+					}
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			c := NewClient(server.URL, "test-token")
+			gateway, err := c.CreateGateway(context.Background(), GatewayCreate{Name: "async-gw", URL: "https://example.com/mcp"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gateway.ID != "gw-1" || gateway.Name != "async-gw" {
+				t.Errorf("expected the created gateway, got %+v", gateway)
+			}
+			if polls != 3 {
+				t.Errorf("expected 3 polls, got %d", polls)
+			}
+		})
+	}
+}
+
+func TestCreateGateway_AsyncJobFailed(t *testing.T) {
+	pollInitialInterval = time.Millisecond
+	defer func() { pollInitialInterval = 500 * time.Millisecond }()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.Header().Set("Location", "/jobs/job-1")
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Job{ID: "job-1", Status: JobStatusFailed, Error: "upstream unreachable"}); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	_, err := c.CreateGateway(context.Background(), GatewayCreate{Name: "gw", URL: "https://example.com/mcp"})
+	if err == nil || !strings.Contains(err.Error(), "upstream unreachable") {
+		t.Fatalf("expected the job failure to be reported, got %v", err)
+	}
+}
+
+func TestCreateGateway_AsyncJobTimeout(t *testing.T) {
+	pollInitialInterval = time.Millisecond
+	defer func() { pollInitialInterval = 500 * time.Millisecond }()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.Header().Set("Location", "/jobs/job-1")
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Job{ID: "job-1", Status: JobStatusRunning}); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	c := NewClient(server.URL, "test-token")
+	_, err := c.CreateGateway(ctx, GatewayCreate{Name: "gw", URL: "https://example.com/mcp"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the create to stop at the deadline, got %v", err)
+	}
+}
+
+func TestGetJob_OutsideEndpoint(t *testing.T) {
+	c := NewClient("https://gateway.example.com", "test-token")
+	_, err := c.GetJob(context.Background(), "https://elsewhere.example.com/jobs/job-1")
+	if err == nil || !strings.Contains(err.Error(), "outside the gateway endpoint") {
+		t.Fatalf("expected a job URL on another host to be rejected, got %v", err)
+	}
+}
+
+func TestGetGateway(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/gateways/gw-1" {
+			t.Errorf("expected path /gateways/gw-1, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Gateway{ID: "gw-1", Name: "test-gw"}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	gw, err := c.GetGateway(context.Background(), "gw-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gw == nil {
+		t.Fatal("expected gateway, got nil")
+	}
+	if gw.ID != "gw-1" {
+		t.Errorf("expected gateway ID gw-1, got %s", gw.ID)
+	}
+}
+
+func TestGetGateway_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	gw, err := c.GetGateway(context.Background(), "nonexistent")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gw != nil {
+		t.Errorf("expected nil gateway for 404, got %v", gw)
+	}
+}
+
+func TestUpdateGateway(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/gateways/gw-1" {
+			t.Errorf("expected path /gateways/gw-1, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("expected content-type application/json, got %s", r.Header.Get("Content-Type"))
+		}
+
+		var req GatewayUpdate
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if req.Name != "updated-gw" {
+			t.Errorf("expected gateway name updated-gw, got %s", req.Name)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Gateway{
+			ID:   "gw-1",
+			Name: req.Name,
+			URL:  req.URL,
+		}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	gw, err := c.UpdateGateway(context.Background(), "gw-1", GatewayUpdate{
+		Name: "updated-gw",
+		URL:  "https://updated.example.com",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gw.Name != "updated-gw" {
+		t.Errorf("expected gateway name updated-gw, got %s", gw.Name)
+	}
+}
+
+func TestDeleteGateway(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		if r.URL.Path != "/gateways/gw-1" {
+			t.Errorf("expected path /gateways/gw-1, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	err := c.DeleteGateway(context.Background(), "gw-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// --- Tool Tests ---
+
+func TestCreateTool(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/tools" {
+			t.Errorf("expected path /tools, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("expected content-type application/json, got %s", r.Header.Get("Content-Type"))
+		}
+
+		var req CreateToolRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if req.Tool.Name != "test-tool" {
+			t.Errorf("expected tool name test-tool, got %s", req.Tool.Name)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(Tool{
+			ID:          "tool-1",
+			Name:        req.Tool.Name,
+			Description: stringPointer(req.Tool.Description),
+			Visibility:  stringPointer(req.Visibility),
+		}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	tool, err := c.CreateTool(context.Background(), CreateToolRequest{
+		Tool:       ToolCreate{Name: "test-tool", Description: "Test tool"},
+		Visibility: "private",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tool.ID != "tool-1" {
+		t.Errorf("expected tool ID tool-1, got %s", tool.ID)
+	}
+	if tool.Name != "test-tool" {
+		t.Errorf("expected tool name test-tool, got %s", tool.Name)
+	}
+}
+
+func TestValidateTool(t *testing.T) {
+	cases := map[string]struct {
+		status       int
+		body         string
+		wantValid    bool
+		wantErrors   []string
+		wantWarnings []string
+		wantErr      error
+	}{
+		"accepted": {
+			status:       http.StatusOK,
+			body:         `{"valid": true, "warnings": ["description is empty"]}`,
+			wantValid:    true,
+			wantWarnings: []string{"description is empty"},
+		},
+		"accepted without verdict": {status: http.StatusOK, body: `{}`, wantValid: true},
+		"rejected in body": {
+			status:     http.StatusOK,
+			body:       `{"valid": false, "errors": ["input_schema must be an object"]}`,
+			wantErrors: []string{"input_schema must be an object"},
+		},
+		"rejected with 422": {
+			status:     http.StatusUnprocessableEntity,
+			body:       `{"detail": [{"loc": ["body", "tool", "name"], "msg": "invalid tool name"}]}`,
+			wantErrors: []string{"body.tool.name: invalid tool name"},
+		},
+		"unsupported": {status: http.StatusNotFound, body: `{"detail": "Not Found"}`, wantErr: ErrValidationUnsupported},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/tools/validate" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				var req CreateToolRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Tool.Name != "my-tool" {
+					t.Errorf("unexpected request body %+v (%v)", req, err)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.status)
+				if _, err := w.Write([]byte(tc.body)); err != nil {
+					t.Errorf("failed to write response: %v", err)
+				}
+			}))
+			defer server.Close()
+
+			c := NewClient(server.URL, "test-token")
+			result, err := c.ValidateTool(context.Background(), CreateToolRequest{Tool: ToolCreate{Name: "my-tool"}})
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("expected %v, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Valid != tc.wantValid || !slices.Equal(result.Errors, tc.wantErrors) || !slices.Equal(result.Warnings, tc.wantWarnings) {
+				t.Errorf("unexpected validation result %+v", result)
+			}
+		})
+	}
+}
+
+func TestGetTool(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tools/tool-1" {
+			t.Errorf("expected path /tools/tool-1, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Tool{ID: "tool-1", Name: "test-tool"}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	tool, err := c.GetTool(context.Background(), "tool-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tool == nil {
+		t.Fatal("expected tool, got nil")
+	}
+	if tool.ID != "tool-1" {
+		t.Errorf("expected tool ID tool-1, got %s", tool.ID)
+	}
+}
+
+func TestGetTool_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	tool, err := c.GetTool(context.Background(), "nonexistent")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tool != nil {
+		t.Errorf("expected nil tool for 404, got %v", tool)
+	}
+}
+
+func TestDeleteTool(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		if r.URL.Path != "/tools/tool-1" {
+			t.Errorf("expected path /tools/tool-1, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	err := c.DeleteTool(context.Background(), "tool-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// --- Resource Tests ---
+
+func TestCreateResource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/resources" {
+			t.Errorf("expected path /resources, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("expected content-type application/json, got %s", r.Header.Get("Content-Type"))
+		}
+
+		var req CreateResourceRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if req.Resource.Name != "test-res" {
+			t.Errorf("expected resource name test-res, got %s", req.Resource.Name)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(Resource{
+			ID:         "res-1",
+			URI:        req.Resource.URI,
+			Name:       req.Resource.Name,
+			Visibility: stringPointer(req.Visibility),
+		}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	res, err := c.CreateResource(context.Background(), CreateResourceRequest{
+		Resource:   ResourceCreate{URI: "file:///test", Name: "test-res"},
+		Visibility: "private",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.ID != "res-1" {
+		t.Errorf("expected resource ID res-1, got %s", res.ID)
+	}
+	if res.Name != "test-res" {
+		t.Errorf("expected resource name test-res, got %s", res.Name)
+	}
+}
+
+func TestGetResource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/resources/res-1/info" {
+			t.Errorf("expected path /resources/res-1/info, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Resource{ID: "res-1", Name: "test-res", URI: "file:///test"}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	res, err := c.GetResource(context.Background(), "res-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res == nil {
+		t.Fatal("expected resource, got nil")
+	}
+	if res.ID != "res-1" {
+		t.Errorf("expected resource ID res-1, got %s", res.ID)
+	}
+}
+
+func TestGetResource_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	res, err := c.GetResource(context.Background(), "nonexistent")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res != nil {
+		t.Errorf("expected nil resource for 404, got %v", res)
+	}
+}
+
+func TestReadResourceContent_Text(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/resources/res-1" {
+			t.Errorf("expected path /resources/res-1, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"uri": "file:///readme.md", "mimeType": "text/markdown", "text": "# Hello"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	content, err := c.ReadResourceContent(context.Background(), "res-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if content.Text == nil || *content.Text != "# Hello" {
+		t.Errorf("expected text \"# Hello\", got %v", content.Text)
+	}
+	if content.MimeType != "text/markdown" {
+		t.Errorf("expected mime type text/markdown, got %s", content.MimeType)
+	}
+	if content.Blob != nil {
+		t.Errorf("expected no blob, got %v", content.Blob)
+	}
+}
+
+func TestReadResourceContent_Binary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"uri": "file:///logo.png", "mimeType": "image/png", "blob": "iVBORw0KGgo="}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	content, err := c.ReadResourceContent(context.Background(), "res-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if content.Text != nil {
+		t.Errorf("expected no text, got %q", *content.Text)
+	}
+	if want := "\x89PNG\r\n\x1a\n"; string(content.Blob) != want {
+		t.Errorf("expected blob %q, got %q", want, content.Blob)
+	}
+}
+
+func TestUploadResourceContent(t *testing.T) {
+	text := "# Hello"
+	cases := map[string]struct {
+		content ResourceContentUpload
+		want    string
+	}{
+		"text":   {content: ResourceContentUpload{Content: &text}, want: `{"content":"# Hello"}`},
+		"binary": {content: ResourceContentUpload{Blob: []byte("\x89PNG\r\n\x1a\n")}, want: `{"blob":"iVBORw0KGgo="}`},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut || r.URL.Path != "/resources/res-1" {
+					t.Errorf("expected PUT /resources/res-1, got %s %s", r.Method, r.URL.Path)
+				}
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("failed to read body: %v", err)
+				}
+				if string(body) != tc.want {
+					t.Errorf("expected body %s, got %s", tc.want, body)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id": "res-1", "uri": "file:///readme.md", "name": "readme", "updated_at": "2025-01-02T00:00:00Z"}`))
+			}))
+			defer server.Close()
+
+			c := NewClient(server.URL, "test-token")
+			res, err := c.UploadResourceContent(context.Background(), "res-1", tc.content)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res.ID != "res-1" || res.UpdatedAt != "2025-01-02T00:00:00Z" {
+				t.Errorf("unexpected resource %+v", res)
+			}
+		})
+	}
+}
+
+func TestDeleteResource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		if r.URL.Path != "/resources/res-1" {
+			t.Errorf("expected path /resources/res-1, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	err := c.DeleteResource(context.Background(), "res-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// --- Prompt Tests ---
+
+func TestCreatePrompt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/prompts" {
+			t.Errorf("expected path /prompts, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("expected content-type application/json, got %s", r.Header.Get("Content-Type"))
+		}
+
+		var req CreatePromptRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if req.Prompt.Name != "test-prompt" {
+			t.Errorf("expected prompt name test-prompt, got %s", req.Prompt.Name)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(Prompt{
+			ID:          "prompt-1",
+			Name:        req.Prompt.Name,
+			Description: stringPointer(req.Prompt.Description),
+			Visibility:  stringPointer(req.Visibility),
+		}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	prompt, err := c.CreatePrompt(context.Background(), CreatePromptRequest{
+		Prompt:     PromptCreate{Name: "test-prompt", Description: "Test"},
+		Visibility: "public",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if prompt.ID != "prompt-1" {
+		t.Errorf("expected prompt ID prompt-1, got %s", prompt.ID)
+	}
+	if prompt.Name != "test-prompt" {
+		t.Errorf("expected prompt name test-prompt, got %s", prompt.Name)
+	}
+}
+
+func TestGetPrompt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/prompts/prompt-1" {
+			t.Errorf("expected path /prompts/prompt-1, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Prompt{ID: "prompt-1", Name: "test-prompt"}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	prompt, err := c.GetPrompt(context.Background(), "prompt-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if prompt == nil {
+		t.Fatal("expected prompt, got nil")
+	}
+	if prompt.ID != "prompt-1" {
+		t.Errorf("expected prompt ID prompt-1, got %s", prompt.ID)
+	}
+}
+
+func TestGetPrompt_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	prompt, err := c.GetPrompt(context.Background(), "nonexistent")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if prompt != nil {
+		t.Errorf("expected nil prompt for 404, got %v", prompt)
+	}
+}
+
+func TestDeletePrompt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		if r.URL.Path != "/prompts/prompt-1" {
+			t.Errorf("expected path /prompts/prompt-1, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	err := c.DeletePrompt(context.Background(), "prompt-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// --- User Tests ---
+
+func TestListUsers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users" {
+			t.Errorf("expected path /users, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`[{"id": "user-1", "email": "a@example.com", "role": "admin", "team_ids": ["team-1"]}]`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	users, err := c.ListUsers(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(users) != 1 {
+		t.Fatalf("expected 1 user, got %d", len(users))
+	}
+	if users[0].Email != "a@example.com" || users[0].Role != "admin" {
+		t.Errorf("unexpected user: %+v", users[0])
+	}
+	if len(users[0].TeamIDs) != 1 || users[0].TeamIDs[0] != "team-1" {
+		t.Errorf("expected team_ids [team-1], got %v", users[0].TeamIDs)
+	}
+}
+
+func TestCreateUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/users" {
+			t.Errorf("expected path /users, got %s", r.URL.Path)
+		}
+
+		var req UserCreate
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if req.Email != "a@example.com" {
+			t.Errorf("expected email a@example.com, got %s", req.Email)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(User{
+			ID:      "user-1",
+			Email:   req.Email,
+			Role:    req.Role,
+			TeamIDs: req.TeamIDs,
+		}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	user, err := c.CreateUser(context.Background(), UserCreate{
+		Email:   "a@example.com",
+		Role:    "member",
+		TeamIDs: []string{"team-1"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.ID != "user-1" {
+		t.Errorf("expected user ID user-1, got %s", user.ID)
+	}
+	if user.Role != "member" {
+		t.Errorf("expected role member, got %s", user.Role)
+	}
+}
+
+func TestGetUser_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	user, err := c.GetUser(context.Background(), "nonexistent")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user != nil {
+		t.Errorf("expected nil user for 404, got %v", user)
+	}
+}
+
+func TestUpdateUser_SendsEmptyTeamIDs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/users/user-1" {
+			t.Errorf("expected path /users/user-1, got %s", r.URL.Path)
+		}
+
+		var req map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if teamIDs, ok := req["team_ids"].([]any); !ok || len(teamIDs) != 0 {
+			t.Errorf("expected empty team_ids to be sent, got %v", req["team_ids"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(User{ID: "user-1", Email: "a@example.com", Role: "admin"}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	user, err := c.UpdateUser(context.Background(), "user-1", UserUpdate{Role: "admin", TeamIDs: []string{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.Role != "admin" {
+		t.Errorf("expected role admin, got %s", user.Role)
+	}
+}
+
+func TestUpdateServer_TeamID(t *testing.T) {
+	var teamIDs []any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		teamID, ok := req["team_id"]
+		if !ok {
+			teamID = "<omitted>"
+		}
+		teamIDs = append(teamIDs, teamID)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Server{ID: "srv-1", Name: "srv"}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	for _, teamID := range []*string{nil, new(string)} {
+		if _, err := c.UpdateServer(context.Background(), "srv-1", ServerUpdate{Name: "srv", TeamID: teamID}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(teamIDs) != 2 || teamIDs[0] != "<omitted>" || teamIDs[1] != "" {
+		t.Errorf("expected team_id to be omitted and then sent empty, got %v", teamIDs)
+	}
+}
+
+func TestUpdateServer_AssociationIDs(t *testing.T) {
+	var bodies []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		bodies = append(bodies, req)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Server{ID: "srv-1", Name: "srv"}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	for _, ids := range []*[]string{nil, {}} {
+		if _, err := c.UpdateServer(context.Background(), "srv-1", ServerUpdate{Name: "srv", ToolIDs: ids, ResourceIDs: ids, PromptIDs: ids}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	for _, key := range []string{"tool_ids", "resource_ids", "prompt_ids"} {
+		if v, ok := bodies[0][key]; ok {
+			t.Errorf("expected %s to be omitted, got %v", key, v)
+		}
+		if v, ok := bodies[1][key].([]any); !ok || len(v) != 0 {
+			t.Errorf("expected %s to be sent as [], got %v", key, bodies[1][key])
+		}
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		if r.URL.Path != "/users/user-1" {
+			t.Errorf("expected path /users/user-1, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	if err := c.DeleteUser(context.Background(), "user-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// --- Root Tests ---
+
+func TestCreateRoot(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/roots" {
+			t.Errorf("expected path /roots, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("expected content-type application/json, got %s", r.Header.Get("Content-Type"))
+		}
+
+		var req Root
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if req.URI != "file:///workspace" {
+			t.Errorf("expected root URI file:///workspace, got %s", req.URI)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(Root{
+			URI:  req.URI,
+			Name: req.Name,
+		}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	root, err := c.CreateRoot(context.Background(), Root{
+		URI:  "file:///workspace",
+		Name: stringPointer("test-root"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if root.URI != "file:///workspace" {
+		t.Errorf("expected root URI file:///workspace, got %s", root.URI)
+	}
+	if derefString(root.Name) != "test-root" {
+		t.Errorf("expected root name test-root, got %s", derefString(root.Name))
+	}
+}
+
+func TestListRoots(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/roots" {
+			t.Errorf("expected path /roots, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			t.Errorf("expected auth header, got %s", r.Header.Get("Authorization"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode([]Root{
+			{URI: "file:///workspace", Name: stringPointer("test-root")},
+		}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	roots, err := c.ListRoots(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(roots) != 1 {
+		t.Fatalf("expected 1 root, got %d", len(roots))
+	}
+	if roots[0].URI != "file:///workspace" {
+		t.Errorf("expected root URI file:///workspace, got %s", roots[0].URI)
+	}
+}
+
+func TestDeleteRoot(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		if r.URL.Path != "/roots/file:///workspace" {
+			t.Errorf("expected path /roots/file:///workspace, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	err := c.DeleteRoot(context.Background(), "file:///workspace")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCreateServer_IdempotencyKeyReusedAcrossRetries(t *testing.T) {
+	retryInitialInterval = time.Millisecond
+	defer func() { retryInitialInterval = 500 * time.Millisecond }()
+
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if len(keys) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(Server{ID: "srv-1", Name: "test"}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	c.MaxRetries = 3
+	c.IdempotencyKeys = true
+	srv, err := c.CreateServer(context.Background(), CreateServerRequest{Server: ServerConfig{Name: "test"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if srv.ID != "srv-1" {
+		t.Errorf("expected ID srv-1, got %s", srv.ID)
+	}
+	if len(keys) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(keys))
+	}
+	if keys[0] == "" {
+		t.Fatal("expected Idempotency-Key header to be set")
+	}
+	for i, key := range keys[1:] {
+		if key != keys[0] {
+			t.Errorf("attempt %d sent key %q, want %q", i+2, key, keys[0])
+		}
+	}
+}
+
+func TestCreateServer_NoRetryWithoutIdempotencyKeys(t *testing.T) {
+	retryInitialInterval = time.Millisecond
+	defer func() { retryInitialInterval = 500 * time.Millisecond }()
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if key := r.Header.Get("Idempotency-Key"); key != "" {
+			t.Errorf("expected no Idempotency-Key header, got %q", key)
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	if _, err := c.CreateServer(context.Background(), CreateServerRequest{Server: ServerConfig{Name: "test"}}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if calls != 1 {
+		t.Errorf("expected POST to be sent once, got %d attempts", calls)
+	}
+}
+
+func TestGetServer_RetriesTransientErrors(t *testing.T) {
+	retryInitialInterval = time.Millisecond
+	defer func() { retryInitialInterval = 500 * time.Millisecond }()
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Server{ID: "srv-1"}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	c.MaxRetries = 3
+	if _, err := c.GetServer(context.Background(), "srv-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 attempts, got %d", calls)
+	}
+}
+
+func TestNewClient_NoRetriesByDefault(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	if _, err := c.GetServer(context.Background(), "srv-1"); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if calls != 1 {
+		t.Errorf("expected GET to be sent once without MaxRetries, got %d attempts", calls)
+	}
+}
+
+func TestIdempotencyKeys_OnlyOnCreates(t *testing.T) {
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/tools":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": "tool-1", "name": "search"}`)
+		case "/tools/tool-1/invoke":
+			fmt.Fprint(w, `{"content": []}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	c.IdempotencyKeys = true
+	if _, err := c.CreateTool(context.Background(), CreateToolRequest{Tool: ToolCreate{Name: "search"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.InvokeTool(context.Background(), "tool-1", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(keys) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(keys))
+	}
+	if keys[0] == "" {
+		t.Error("expected the create to carry an Idempotency-Key header")
+	}
+	if keys[1] != "" {
+		t.Errorf("expected the invocation to carry no Idempotency-Key header, got %q", keys[1])
+	}
+}
+
+func TestUpdateTool_StreamsLargeBody(t *testing.T) {
+	retryInitialInterval = time.Millisecond
+	defer func() { retryInitialInterval = 500 * time.Millisecond }()
+
+	properties := make(map[string]interface{})
+	for i := range 20000 {
+		properties[fmt.Sprintf("field_%05d", i)] = map[string]interface{}{
+			"type":        "string",
+			"description": strings.Repeat("x", 64),
+		}
+	}
+	inputSchema := map[string]interface{}{"type": "object", "properties": properties}
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.ContentLength != -1 {
+			t.Errorf("attempt %d: expected a streamed body of unknown length, got Content-Length %d", calls, r.ContentLength)
+		}
+		var req ToolUpdate
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("attempt %d: failed to decode request body: %v", calls, err)
+		}
+		if got := len(req.InputSchema["properties"].(map[string]interface{})); got != len(properties) {
+			t.Errorf("attempt %d: expected %d properties, got %d", calls, len(properties), got)
+		}
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Tool{ID: "tool-1", Name: req.Name}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	c.MaxRetries = 3
+	tool, err := c.UpdateTool(context.Background(), "tool-1", ToolUpdate{Name: "big", InputSchema: inputSchema})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tool.Name != "big" {
+		t.Errorf("expected tool name big, got %s", tool.Name)
+	}
+	if calls != 2 {
+		t.Errorf("expected the streamed body to be sent again on retry, got %d attempts", calls)
+	}
+}
+
+func TestNewRequestBody(t *testing.T) {
+	small, err := newRequestBody(map[string]string{"name": "<small>"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want, _ := json.Marshal(map[string]string{"name": "<small>"})
+	if small.value != nil || !bytes.Equal(small.encoded, want) {
+		t.Errorf("expected small body to be buffered as %s, got %+v", want, small)
+	}
+
+	large, err := newRequestBody(map[string]string{"blob": strings.Repeat("x", streamBodyThreshold)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if large.value == nil || large.encoded != nil {
+		t.Errorf("expected large body to be streamed, got %d buffered bytes", len(large.encoded))
+	}
+
+	if _, err := newRequestBody(map[string]interface{}{"bad": make(chan int)}); err == nil {
+		t.Error("expected an error for a body that cannot be encoded")
+	}
+}
+
+func TestGetFederation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/federation" {
+			t.Errorf("expected path /federation, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Federation{Peers: []FederationPeer{{ID: "peer-1", Name: "east", URL: "https://east.example.com"}}}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	federation, err := c.GetFederation(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(federation.Peers) != 1 || federation.Peers[0].ID != "peer-1" {
+		t.Errorf("expected one peer peer-1, got %+v", federation.Peers)
+	}
+}
+
+func TestAddTags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/tools/tool-1/tags" {
+			t.Errorf("expected path /tools/tool-1/tags, got %s", r.URL.Path)
+		}
+		var req TagsRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(TagsResponse{Tags: append([]string{"existing"}, req.Tags...)}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	tags, err := c.AddTags(context.Background(), TaggableTools, "tool-1", []string{"new"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tags) != 2 || tags[1] != "new" {
+		t.Errorf("expected [existing new], got %v", tags)
+	}
+}
+
+func TestRemoveTags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		if r.URL.Path != "/servers/srv-1/tags" {
+			t.Errorf("expected path /servers/srv-1/tags, got %s", r.URL.Path)
+		}
+		var req TagsRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if len(req.Tags) != 1 || req.Tags[0] != "old" {
+			t.Errorf("expected tags [old], got %v", req.Tags)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(TagsResponse{Tags: []string{"kept"}}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	tags, err := c.RemoveTags(context.Background(), TaggableServers, "srv-1", []string{"old"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tags) != 1 || tags[0] != "kept" {
+		t.Errorf("expected [kept], got %v", tags)
+	}
+}
+
+func TestGetHealth_CustomPath(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/health" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"status":"ok"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	c.HealthPath = "/api/health"
+	health, err := c.GetHealth(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if health.Status != "ok" {
+		t.Errorf("expected status ok, got %q", health.Status)
+	}
+}
+
+func TestRenderPrompt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/prompts/prompt-1" {
+			t.Errorf("expected path /prompts/prompt-1, got %s", r.URL.Path)
+		}
+		var args map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&args); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if args["name"] != "Ada" {
+			t.Errorf("expected argument name=Ada, got %v", args)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"messages":[{"role":"user","content":{"type":"text","text":"Hello Ada"}}]}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	result, err := c.RenderPrompt(context.Background(), "prompt-1", map[string]string{"name": "Ada"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(result.Messages), "Hello Ada") {
+		t.Errorf("expected rendered messages, got %s", result.Messages)
+	}
+}
+
+func TestInvokeTool(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/tools/tool-1/invoke" {
+			t.Errorf("expected path /tools/tool-1/invoke, got %s", r.URL.Path)
+		}
+		var req ToolInvokeRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.Arguments["message"] != "ping" {
+			t.Errorf("expected argument message=ping, got %v", req.Arguments)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"content":[{"type":"text","text":"ping"}],"isError":false}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	result, err := c.InvokeTool(context.Background(), "tool-1", map[string]interface{}{"message": "ping"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Error("expected isError false")
+	}
+	if !strings.Contains(string(result.Content), `"text":"ping"`) {
+		t.Errorf("expected echoed content, got %s", result.Content)
+	}
+}
+
+func TestListGateways_TagsFilteredServerSide(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("tags"); got != "prod,edge" {
+			t.Errorf("expected tags=prod,edge, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`[{"id": "gw-1", "name": "prod", "url": "http://a", "tags": ["prod"]}]`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	gateways, err := c.ListGateways(context.Background(), false, []string{"prod", "edge"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(gateways) != 1 || gateways[0].ID != "gw-1" {
+		t.Errorf("expected [gw-1], got %+v", gateways)
+	}
+}
+
+func TestListGateways_TagsIgnoredByServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`[
+			{"id": "gw-1", "name": "prod", "url": "http://a", "tags": ["prod"]},
+			{"id": "gw-2", "name": "dev", "url": "http://b", "tags": ["dev"]},
+			{"id": "gw-3", "name": "untagged", "url": "http://c"}
+		]`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	gateways, err := c.ListGateways(context.Background(), false, []string{"prod"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(gateways) != 1 || gateways[0].ID != "gw-1" {
+		t.Errorf("expected [gw-1], got %+v", gateways)
+	}
+
+	all, err := c.ListGateways(context.Background(), false, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(all) != 3 {
+		t.Errorf("expected 3 gateways without a tag filter, got %d", len(all))
+	}
+}
+
+func TestDoRequest_ResponseTooLarge(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"status": "` + strings.Repeat("x", 100) + `"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	c.MaxResponseBytes = 64
+	_, err := c.GetHealth(context.Background())
+	if !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("expected ErrResponseTooLarge, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected oversized response not to be retried, got %d requests", requests)
+	}
+
+	c.MaxResponseBytes = 1024
+	if _, err := c.GetHealth(context.Background()); err != nil {
+		t.Fatalf("unexpected error under the limit: %v", err)
+	}
+}
+
+func TestListPrompts_SearchFilteredServerSide(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("search"); got != "Greet" {
+			t.Errorf("expected search=Greet, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`[{"id": "p-1", "name": "greeting"}]`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	prompts, err := c.ListPrompts(context.Background(), false, "Greet")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(prompts) != 1 || prompts[0].ID != "p-1" {
+		t.Errorf("expected [p-1], got %+v", prompts)
+	}
+}
+
+func TestListPrompts_SearchIgnoredByServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`[
+			{"id": "p-1", "name": "greeting"},
+			{"id": "p-2", "name": "Formal-GREETING"},
+			{"id": "p-3", "name": "summary"}
+		]`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	prompts, err := c.ListPrompts(context.Background(), false, "greet")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(prompts) != 2 || prompts[0].ID != "p-1" || prompts[1].ID != "p-2" {
+		t.Errorf("expected [p-1 p-2], got %+v", prompts)
+	}
+}
+
+func TestListResources_MimeTypeFilter(t *testing.T) {
+	for name, serverSide := range map[string]bool{"server-side": true, "fallback": false} {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.URL.Query().Get("mime_type"); got != "application/json" {
+					t.Errorf("expected mime_type=application/json, got %q", got)
+				}
+				body := `[
+					{"id": "r-1", "uri": "file:///a.json", "mimeType": "application/json"},
+					{"id": "r-2", "uri": "file:///b.txt", "mimeType": "text/plain"},
+					{"id": "r-3", "uri": "file:///c.json", "mimeType": "Application/JSON"}
+				]`
+				if serverSide {
+					body = `[
+						{"id": "r-1", "uri": "file:///a.json", "mimeType": "application/json"},
+						{"id": "r-3", "uri": "file:///c.json", "mimeType": "Application/JSON"}
+					]`
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if _, err := w.Write([]byte(body)); err != nil {
+					t.Errorf("failed to write response: %v", err)
+				}
+			}))
+			defer server.Close()
+
+			c := NewClient(server.URL, "test-token")
+			resources, err := c.ListResources(context.Background(), false, "application/json")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(resources) != 2 || resources[0].ID != "r-1" || resources[1].ID != "r-3" {
+				t.Errorf("expected [r-1 r-3], got %+v", resources)
+			}
+		})
+	}
+}
+
+func TestListResources_MimeTypeNoMatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`[{"id": "r-2", "uri": "file:///b.txt", "mimeType": "text/plain"}]`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	resources, err := c.ListResources(context.Background(), false, "application/json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resources) != 0 {
+		t.Errorf("expected no resources, got %+v", resources)
+	}
+}
+
+func TestCreate_DefaultVisibility(t *testing.T) {
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Visibility string `json:"visibility"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		got = append(got, r.URL.Path+"="+req.Visibility)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if _, err := w.Write([]byte(`{"id": "created"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	c.DefaultVisibility = "private"
+	ctx := context.Background()
+
+	if _, err := c.CreateServer(ctx, CreateServerRequest{Server: ServerConfig{Name: "s"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.CreateTool(ctx, CreateToolRequest{Tool: ToolCreate{Name: "t"}, Visibility: "public"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.CreateResource(ctx, CreateResourceRequest{Resource: ResourceCreate{Name: "r"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.CreatePrompt(ctx, CreatePromptRequest{Prompt: PromptCreate{Name: "p"}, Visibility: "team"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"/servers=private", "/tools=public", "/resources=private", "/prompts=team"}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestUnexpectedStatusError_APIError(t *testing.T) {
+	cases := map[string]struct {
+		body       string
+		wantDetail string
+	}{
+		"string detail":     {body: `{"detail": "Tool name must be unique"}`, wantDetail: "Tool name must be unique"},
+		"validation detail": {body: `{"detail": [{"loc": ["body", "tool", "url"], "msg": "invalid URL"}, {"msg": "bad"}]}`, wantDetail: "body.tool.url: invalid URL; bad"},
+		"message":           {body: `{"message": "boom"}`, wantDetail: "boom"},
+		"not json":          {body: `Internal Server Error`, wantDetail: ""},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := unexpectedStatusError(http.StatusBadRequest, []byte(tc.body))
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected *APIError, got %T", err)
+			}
+			if apiErr.StatusCode != http.StatusBadRequest {
+				t.Errorf("expected status 400, got %d", apiErr.StatusCode)
+			}
+			if apiErr.Detail != tc.wantDetail {
+				t.Errorf("expected detail %q, got %q", tc.wantDetail, apiErr.Detail)
+			}
+			if want := "unexpected status code 400: " + tc.body; err.Error() != want {
+				t.Errorf("expected error %q, got %q", want, err.Error())
+			}
+		})
+	}
+}
+
+func TestGetMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metrics" {
+			t.Errorf("expected path /metrics, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"total_tools": 12, "total_servers": 3, "total_gateways": 2, "requests_last_hour": 480}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	metrics, err := c.GetMetrics(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := Metrics{TotalTools: 12, TotalServers: 3, TotalGateways: 2, RequestsLastHour: 480}
+	if *metrics != want {
+		t.Errorf("expected %+v, got %+v", want, *metrics)
+	}
+}
+
+func TestDoRequest_LogsRetrySummary(t *testing.T) {
+	retryInitialInterval = time.Millisecond
+	defer func() { retryInitialInterval = 500 * time.Millisecond }()
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"status": "ok"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	retriesBefore := totalRetries.Load()
+
+	c := NewClient(server.URL, "")
+	c.MaxRetries = 3
+	if _, err := c.GetHealth(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := totalRetries.Load() - retriesBefore; got != 2 {
+		t.Errorf("expected the retry counter to grow by 2, got %d", got)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("failed to decode log output: %v", err)
+	}
+	var summary map[string]interface{}
+	for _, entry := range entries {
+		if entry["@message"] == "gateway request finished" {
+			summary = entry
+		}
+	}
+	if summary == nil {
+		t.Fatalf("expected a request summary log entry, got %v", entries)
+	}
+	if summary["attempts"] != float64(3) || summary["retries"] != float64(2) {
+		t.Errorf("expected 3 attempts and 2 retries, got %v", summary)
+	}
+	if summary["status_code"] != float64(http.StatusOK) {
+		t.Errorf("expected status_code 200, got %v", summary["status_code"])
+	}
+	if _, ok := summary["elapsed_ms"]; !ok {
+		t.Errorf("expected elapsed_ms in %v", summary)
+	}
+}
+
+func TestDoRequest_DefaultHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Tenant-ID"); got != "tenant-a" {
+			t.Errorf("expected X-Tenant-ID tenant-a, got %q", got)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("expected Authorization to be left untouched, got %q", got)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/json" {
+			t.Errorf("expected Content-Type to be left untouched, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if _, err := w.Write([]byte(`{"id": "srv-1"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	c.DefaultHeaders = map[string]string{
+		"X-Tenant-ID":   "tenant-a",
+		"authorization": "Bearer other",
+		"Content-Type":  "text/plain",
+	}
+	if _, err := c.CreateServer(context.Background(), CreateServerRequest{Server: ServerConfig{Name: "test"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDoRequest_AuthScheme(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"status": "ok"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "secret")
+	if _, err := c.GetHealth(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "Bearer secret" {
+		t.Errorf("expected default scheme, got %q", got)
+	}
+
+	c.AuthScheme = "Token"
+	if _, err := c.GetHealth(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "Token secret" {
+		t.Errorf("expected Token scheme, got %q", got)
+	}
+}
+
+func TestDoRequest_APIKey(t *testing.T) {
+	var gotKey, gotCustom, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotKey = r.Header.Get("X-API-Key")
+		gotCustom = r.Header.Get("X-Gateway-Key")
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"status": "ok"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	c.APIKey = "key-123"
+	if _, err := c.GetHealth(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotKey != "key-123" {
+		t.Errorf("expected X-API-Key key-123, got %q", gotKey)
+	}
+	if gotAuth != "" {
+		t.Errorf("expected no Authorization header, got %q", gotAuth)
+	}
+
+	c.APIKeyHeader = "X-Gateway-Key"
+	if _, err := c.GetHealth(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotCustom != "key-123" {
+		t.Errorf("expected X-Gateway-Key key-123, got %q", gotCustom)
+	}
+}
+
+func TestRefreshGatewayTools(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/gateways/gw-1/refresh" {
+			t.Errorf("expected path /gateways/gw-1/refresh, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"tools_discovered": 7}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	result, err := c.RefreshGatewayTools(context.Background(), "gw-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ToolsDiscovered != 7 {
+		t.Errorf("expected 7 tools discovered, got %d", result.ToolsDiscovered)
+	}
+}
+
+func TestRefreshGatewayTools_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	result, err := c.RefreshGatewayTools(context.Background(), "missing")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != nil {
+		t.Errorf("expected nil result for 404, got %+v", result)
+	}
+}
+
+func TestMergePatch(t *testing.T) {
+	prior := ToolUpdate{Name: "search", Description: "Old", Tags: []string{"a"}, Annotations: &map[string]interface{}{"title": "Search"}}
+	planned := ToolUpdate{Name: "search", Description: "New", Tags: []string{"a", "b"}}
+
+	patch, err := MergePatch(prior, planned)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := map[string]string{}
+	for name, value := range patch {
+		got[name] = string(value)
+	}
+	// Annotations is omitted from planned, so it is left unchanged rather
+	// than cleared.
+	want := map[string]string{"description": `"New"`, "tags": `["a","b"]`}
+	if len(got) != len(want) || got["description"] != want["description"] || got["tags"] != want["tags"] {
+		t.Errorf("expected patch %v, got %v", want, got)
+	}
+}
+
+func TestPatchTool(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH, got %s", r.Method)
+		}
+		if r.URL.Path != "/tools/tool-1" {
+			t.Errorf("expected path /tools/tool-1, got %s", r.URL.Path)
+		}
+		var req map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if len(req) != 1 || req["description"] != "New" {
+			t.Errorf("expected only the description to be sent, got %v", req)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"id": "tool-1", "name": "search", "description": "New"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	tool, err := c.PatchTool(context.Background(), "tool-1", map[string]json.RawMessage{"description": json.RawMessage(`"New"`)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if derefString(tool.Description) != "New" {
+		t.Errorf("expected description New, got %s", derefString(tool.Description))
+	}
+}
+
+func TestPatchTool_NotSupported(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	for i := 0; i < 2; i++ {
+		_, err := c.PatchTool(context.Background(), "tool-1", map[string]json.RawMessage{})
+		if !errors.Is(err, ErrPatchNotSupported) {
+			t.Fatalf("expected ErrPatchNotSupported, got %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected PATCH support to be probed once, got %d requests", calls)
+	}
+}
+
+func TestListServerTools(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/servers/srv-1/tools" {
+			t.Errorf("expected path /servers/srv-1/tools, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`[{"id": "tool-1", "name": "search"}, {"id": "tool-2", "name": "fetch"}]`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	tools, err := c.ListServerTools(context.Background(), "srv-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tools) != 2 || tools[0].ID != "tool-1" || tools[1].ID != "tool-2" {
+		t.Errorf("unexpected tools %+v", tools)
+	}
+}
+
+func TestListServerResourcesAndPrompts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/servers/srv-1/resources":
+			_, _ = w.Write([]byte(`[{"id": "res-1", "uri": "file:///a"}]`))
+		case "/servers/srv-1/prompts":
+			_, _ = w.Write([]byte(`{"data": [{"id": "prompt-1", "name": "greet"}, {"id": "prompt-2", "name": "summarize"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	resources, err := c.ListServerResources(context.Background(), "srv-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resources) != 1 || resources[0].ID != "res-1" {
+		t.Errorf("unexpected resources %+v", resources)
+	}
+
+	prompts, err := c.ListServerPrompts(context.Background(), "srv-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(prompts) != 2 || prompts[0].ID != "prompt-1" || prompts[1].ID != "prompt-2" {
+		t.Errorf("unexpected prompts %+v", prompts)
+	}
+
+	missing, err := c.ListServerPrompts(context.Background(), "srv-missing")
+	if err != nil || missing != nil {
+		t.Errorf("expected nil for a missing server, got %+v (%v)", missing, err)
+	}
+}
+
+func TestCache_ServesRepeatedReads(t *testing.T) {
+	var gets atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			gets.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			if _, err := w.Write([]byte(`[{"id": "tool-1", "name": "search"}]`)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+		case http.MethodPost:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			if _, err := w.Write([]byte(`{"id": "tool-2", "name": "fetch"}`)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	c.CacheTTL = time.Minute
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.ListTools(context.Background(), false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := gets.Load(); got != 1 {
+		t.Errorf("expected 1 GET with caching enabled, got %d", got)
+	}
+
+	if _, err := c.CreateTool(context.Background(), CreateToolRequest{Tool: ToolCreate{Name: "fetch"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.ListTools(context.Background(), false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := gets.Load(); got != 2 {
+		t.Errorf("expected create to invalidate the cache, got %d GETs", got)
+	}
+}
+
+func TestCache_KeptByValidateTool(t *testing.T) {
+	var gets atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		body := `{"valid": true}`
+		if r.Method == http.MethodGet {
+			gets.Add(1)
+			body = `[{"id": "tool-1", "name": "search"}]`
+		}
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	c.CacheTTL = time.Minute
+
+	if _, err := c.ListTools(context.Background(), false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.ValidateTool(context.Background(), CreateToolRequest{Tool: ToolCreate{Name: "fetch"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.ListTools(context.Background(), false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := gets.Load(); got != 1 {
+		t.Errorf("expected validation to keep the cached list, got %d GETs", got)
+	}
+}
+
+func TestCache_DisabledByDefault(t *testing.T) {
+	var gets atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gets.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`[]`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	for i := 0; i < 2; i++ {
+		if _, err := c.ListTools(context.Background(), false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := gets.Load(); got != 2 {
+		t.Errorf("expected every read to reach the gateway, got %d GETs", got)
+	}
+}
+
+func TestCache_ExpiresAndSkipsErrors(t *testing.T) {
+	var gets atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if gets.Add(1) == 1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"id": "tool-1", "name": "search"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	c.CacheTTL = 20 * time.Millisecond
+
+	// The 404 is not cached, so the second read reaches the gateway.
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetTool(context.Background(), "tool-1"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := c.GetTool(context.Background(), "tool-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := gets.Load(); got != 2 {
+		t.Fatalf("expected the 200 response to be cached, got %d GETs", got)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if _, err := c.GetTool(context.Background(), "tool-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := gets.Load(); got != 3 {
+		t.Errorf("expected an expired entry to be refetched, got %d GETs", got)
+	}
+}
+
+func TestCache_ConcurrentAccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"id": "tool-1", "name": "search"}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	c.CacheTTL = time.Minute
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%5 == 0 {
+				if err := c.DeleteTool(context.Background(), "tool-1"); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if _, err := c.GetTool(context.Background(), "tool-1"); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestCache_PutAfterInvalidateIsDropped(t *testing.T) {
+	var rc responseCache
+	_, generation, _ := rc.get("k")
+	rc.invalidate()
+	rc.put("k", []byte("stale"), generation, time.Now().Add(time.Minute))
+
+	if _, _, ok := rc.get("k"); ok {
+		t.Error("expected a response read before an invalidation not to be cached")
+	}
+}
+
+func TestNonJSONErrorBody(t *testing.T) {
+	page := "<html><head><title>502 Bad Gateway</title></head><body>" + strings.Repeat("<p>upstream unavailable</p>", 100) + "</body></html>"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusBadGateway)
+		if _, err := w.Write([]byte(page)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	c.MaxRetries = 0
+	_, err := c.ListTools(context.Background(), false)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("expected status 502, got %d", apiErr.StatusCode)
+	}
+	if !strings.HasPrefix(apiErr.Detail, "non-JSON response (text/html): <html><head><title>502 Bad Gateway</title>") {
+		t.Errorf("expected content type and body excerpt in detail, got %q", apiErr.Detail)
+	}
+	if !strings.HasSuffix(apiErr.Body, fmt.Sprintf("(truncated, %d bytes total)", len(page))) {
+		t.Errorf("expected truncated body, got %q", apiErr.Body)
+	}
+	if len(apiErr.Body) > maxErrorBodyBytes+64 {
+		t.Errorf("expected body to be truncated to about %d bytes, got %d", maxErrorBodyBytes, len(apiErr.Body))
+	}
+}
+
+func TestNonJSONSuccessBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		if _, err := w.Write([]byte("<html>Please sign in</html>")); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	_, err := c.ListTools(context.Background(), false)
+
+	if !errors.Is(err, ErrUnexpectedContentType) {
+		t.Fatalf("expected ErrUnexpectedContentType instead of a JSON decode error, got %T: %v", err, err)
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		t.Errorf("expected a 2xx response not to be reported as an *APIError, got %v", apiErr)
+	}
+	if !strings.HasSuffix(err.Error(), "unexpected content type (text/html): <html>Please sign in</html>") {
+		t.Errorf("unexpected error %q", err)
+	}
+}
+
+func TestNonJSONResponseError_AcceptsJSONWithoutContentType(t *testing.T) {
+	for _, body := range []string{`{"id": "x"}`, ` [1, 2]`, ``} {
+		if err := nonJSONResponseError(http.StatusOK, "text/plain; charset=utf-8", []byte(body)); err != nil {
+			t.Errorf("expected %q to be accepted, got %v", body, err)
+		}
+	}
+	if err := nonJSONResponseError(http.StatusNotFound, "text/html", []byte("<html>missing</html>")); err != nil {
+		t.Errorf("expected 404 responses to be left to the caller, got %v", err)
+	}
+}
+
+// --- Passthrough Config Tests ---
+
+func TestPassthroughConfig(t *testing.T) {
+	headers := []string{"X-Tenant-ID"}
+	var puts []PassthroughConfig
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/config/passthrough" {
+			t.Errorf("expected path /config/passthrough, got %s", r.URL.Path)
+		}
+		if r.Method == http.MethodPut {
+			var req PassthroughConfig
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			puts = append(puts, req)
+			headers = req.PassthroughHeaders
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(PassthroughConfig{PassthroughHeaders: headers}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	ctx := context.Background()
+
+	config, err := c.GetPassthroughConfig(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(config.PassthroughHeaders, []string{"X-Tenant-ID"}) {
+		t.Errorf("unexpected headers %v", config.PassthroughHeaders)
+	}
+
+	config, err = c.UpdatePassthroughConfig(ctx, PassthroughConfig{PassthroughHeaders: []string{"X-Tenant-ID", "X-Trace-ID"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(config.PassthroughHeaders, []string{"X-Tenant-ID", "X-Trace-ID"}) {
+		t.Errorf("unexpected headers after update %v", config.PassthroughHeaders)
+	}
+
+	// Clearing the allowlist must send an empty list rather than null.
+	if _, err := c.UpdatePassthroughConfig(ctx, PassthroughConfig{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(puts) != 2 || puts[1].PassthroughHeaders == nil || len(puts[1].PassthroughHeaders) != 0 {
+		t.Errorf("expected the second PUT to carry an empty list, got %+v", puts)
+	}
+}
+
+// --- Failover Tests ---
+
+// closedServerURL returns the URL of a server that is no longer listening, so
+// connecting to it fails.
+func closedServerURL() string {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	return server.URL
+}
+
+func TestFailover_FirstEndpointDown(t *testing.T) {
+	var calls atomic.Int64
+	standby := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/health" {
+			if err := json.NewEncoder(w).Encode(HealthResponse{Status: "ok"}); err != nil {
+				t.Errorf("failed to encode response: %v", err)
+			}
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(Server{ID: "srv-1", Name: "my-server"}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer standby.Close()
+
+	c := NewClient(closedServerURL(), "test-token")
+	c.FallbackURLs = []string{standby.URL}
+
+	// A create without idempotency keys is not retried, but a request that
+	// never connected is still sent to the next endpoint.
+	srv, err := c.CreateServer(context.Background(), CreateServerRequest{Server: ServerConfig{Name: "my-server"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if srv.ID != "srv-1" {
+		t.Errorf("expected server srv-1, got %s", srv.ID)
+	}
+	if got := c.activeBaseIndex(); got != 1 {
+		t.Errorf("expected the standby to be remembered, got index %d", got)
+	}
+
+	if _, err := c.Ping(context.Background()); err != nil {
+		t.Errorf("expected Ping to use the remembered endpoint, got %v", err)
+	}
+	if n := calls.Load(); n != 2 {
+		t.Errorf("expected 2 requests to the standby, got %d", n)
+	}
+}
+
+func TestFailover_ComposesWithRetries(t *testing.T) {
+	retryInitialInterval = time.Millisecond
+	defer func() { retryInitialInterval = 500 * time.Millisecond }()
+
+	var primaryCalls, standbyCalls atomic.Int64
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		primaryCalls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer primary.Close()
+	standby := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if standbyCalls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Server{ID: "srv-1"}); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}))
+	defer standby.Close()
+
+	t.Run("http errors are retried on the same endpoint", func(t *testing.T) {
+		c := NewClient(primary.URL, "test-token")
+		c.FallbackURLs = []string{standby.URL}
+		c.MaxRetries = 2
+
+		if _, err := c.GetServer(context.Background(), "srv-1"); err == nil {
+			t.Fatal("expected an error from the unavailable primary")
+		}
+		if n := primaryCalls.Load(); n != 3 {
+			t.Errorf("expected 3 attempts on the primary, got %d", n)
+		}
+		if n := standbyCalls.Load(); n != 0 {
+			t.Errorf("expected the standby to be left alone, got %d requests", n)
+		}
+	})
+
+	t.Run("retries continue on the endpoint that answered", func(t *testing.T) {
+		c := NewClient(closedServerURL(), "test-token")
+		c.MaxRetries = 3
+		c.FallbackURLs = []string{standby.URL}
+
+		srv, err := c.GetServer(context.Background(), "srv-1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if srv.ID != "srv-1" {
+			t.Errorf("expected server srv-1, got %s", srv.ID)
+		}
+		if n := standbyCalls.Load(); n != 2 {
+			t.Errorf("expected the retry to reach the standby, got %d requests", n)
+		}
+	})
+}
+
+func TestFailover_AllEndpointsDown(t *testing.T) {
+	retryInitialInterval = time.Millisecond
+	defer func() { retryInitialInterval = 500 * time.Millisecond }()
+
+	c := NewClient(closedServerURL(), "test-token")
+	c.FallbackURLs = []string{closedServerURL()}
+	c.MaxRetries = 1
+
+	_, err := c.GetServer(context.Background(), "srv-1")
+	if err == nil || !isConnectError(err) {
+		t.Fatalf("expected a connection error, got %v", err)
+	}
+	if got := c.activeBaseIndex(); got != 0 {
+		t.Errorf("expected the primary to stay selected, got index %d", got)
+	}
+}
+
+func TestLocationPath_Fallback(t *testing.T) {
+	c := NewClient("https://primary.example.com/api", "test-token")
+	c.FallbackURLs = []string{"https://standby.example.com/api"}
+	c.activeBase.Store(1)
+
+	cases := map[string]string{
+		"/api/servers/srv-1":                            "/servers/srv-1",
+		"https://standby.example.com/api/servers/srv-1": "/servers/srv-1",
+		"https://primary.example.com/api/servers/srv-1": "/servers/srv-1",
+	}
+	for location, want := range cases {
+		got, err := c.locationPath(location)
+		if err != nil || got != want {
+			t.Errorf("locationPath(%q) = %q, %v; want %q", location, got, err, want)
+		}
+	}
+
+	if _, err := c.locationPath("https://other.example.com/api/servers/srv-1"); err == nil {
+		t.Error("expected a location on another host to be rejected")
+	}
+}
+
+func TestDoRequest_TraceID(t *testing.T) {
+	retryInitialInterval = time.Millisecond
+	defer func() { retryInitialInterval = 500 * time.Millisecond }()
+
+	var (
+		mu       sync.Mutex
+		traceIDs []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		traceIDs = append(traceIDs, r.Header.Get("X-Request-ID"))
+		first := len(traceIDs) == 1
+		mu.Unlock()
+		if first {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status": "ok"}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	c := NewClient(server.URL, "")
+	c.MaxRetries = 3
+	for range 3 {
+		if _, err := c.GetHealth(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// The first request is retried once, so four attempts carry three IDs.
+	if len(traceIDs) != 4 {
+		t.Fatalf("expected 4 attempts, got %d", len(traceIDs))
+	}
+	if traceIDs[0] == "" || traceIDs[0] != traceIDs[1] {
+		t.Errorf("expected a retry to resend the same trace ID, got %q and %q", traceIDs[0], traceIDs[1])
+	}
+	seen := map[string]bool{}
+	for _, id := range traceIDs[1:] {
+		if seen[id] {
+			t.Errorf("expected a unique trace ID per request, got %q twice in %v", id, traceIDs)
+		}
+		seen[id] = true
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("failed to decode log output: %v", err)
+	}
+	var logged []string
+	for _, entry := range entries {
+		if entry["@message"] == "gateway request finished" {
+			id, _ := entry["trace_id"].(string)
+			logged = append(logged, id)
+		}
+	}
+	if want := traceIDs[1:]; !slices.Equal(logged, want) {
+		t.Errorf("expected the request summaries to log trace IDs %v, got %v", want, logged)
+	}
+}
+
+func TestDoRequest_TraceIDOverride(t *testing.T) {
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("X-Correlation-ID"), r.Header.Get(DefaultTraceHeader))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status": "ok"}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	c.TraceHeader = "X-Correlation-ID"
+	c.TraceID = "run-1234"
+	for range 2 {
+		if _, err := c.GetHealth(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if want := []string{"run-1234", "", "run-1234", ""}; !slices.Equal(got, want) {
+		t.Errorf("expected the configured trace ID on every request, got %v", got)
+	}
+
+	got = nil
+	c.TraceHeader = ""
+	if _, err := c.Ping(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"", ""}; !slices.Equal(got, want) {
+		t.Errorf("expected no trace header when TraceHeader is empty, got %v", got)
+	}
+}
+
+func TestDoRequest_CustomRetryPolicy(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		n := calls
+		mu.Unlock()
+		if n < 3 {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "srv-1"}`))
+	}))
+	defer server.Close()
+
+	type decision struct{ status, attempt int }
+	var seen []decision
+	c := NewClient(server.URL, "test-token")
+	c.MaxRetries = 3
+	c.RetryPolicy = func(statusCode int, attempt int) (bool, time.Duration) {
+		seen = append(seen, decision{statusCode, attempt})
+		return statusCode == http.StatusConflict, time.Millisecond
+	}
+
+	if _, err := c.GetServer(context.Background(), "srv-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls)
+	}
+	want := []decision{{http.StatusConflict, 0}, {http.StatusConflict, 1}, {http.StatusOK, 2}}
+	if !slices.Equal(seen, want) {
+		t.Errorf("expected the policy to be consulted with %v, got %v", want, seen)
+	}
+}
+
+func TestDoRequest_RetryPolicyLimits(t *testing.T) {
+	always := func(int, int) (bool, time.Duration) { return true, 0 }
+	never := func(int, int) (bool, time.Duration) { return false, 0 }
+
+	cases := map[string]struct {
+		method     string
+		policy     RetryPolicy
+		status     int
+		wantCalls  int
+		maxRetries int
+	}{
+		"capped by MaxRetries":          {method: http.MethodGet, policy: always, status: http.StatusConflict, maxRetries: 2, wantCalls: 3},
+		"policy declines default codes": {method: http.MethodGet, policy: never, status: http.StatusServiceUnavailable, maxRetries: 3, wantCalls: 1},
+		"POST without idempotency keys": {method: http.MethodPost, policy: always, status: http.StatusConflict, maxRetries: 3, wantCalls: 1},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls atomic.Int64
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			c := NewClient(server.URL, "test-token")
+			c.MaxRetries = tc.maxRetries
+			c.RetryPolicy = tc.policy
+			if _, _, _, err := c.do(context.Background(), tc.method, "/servers", nil, nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := calls.Load(); got != int64(tc.wantCalls) {
+				t.Errorf("expected %d attempts, got %d", tc.wantCalls, got)
+			}
+		})
+	}
+}
+
+func TestRetryOnStatus(t *testing.T) {
+	policy := RetryOnStatus(http.StatusConflict, http.StatusUnprocessableEntity)
+
+	for _, tc := range []struct {
+		status  int
+		attempt int
+		retry   bool
+		wait    time.Duration
+	}{
+		{status: http.StatusConflict, attempt: 0, retry: true, wait: 500 * time.Millisecond},
+		{status: http.StatusUnprocessableEntity, attempt: 1, retry: true, wait: time.Second},
+		{status: http.StatusConflict, attempt: 3, retry: true, wait: 4 * time.Second},
+		{status: http.StatusConflict, attempt: 10, retry: true, wait: 5 * time.Second},
+		{status: http.StatusServiceUnavailable, attempt: 0},
+		{status: http.StatusOK, attempt: 0},
+	} {
+		retry, wait := policy(tc.status, tc.attempt)
+		if retry != tc.retry || wait != tc.wait {
+			t.Errorf("policy(%d, %d) = (%t, %s), want (%t, %s)", tc.status, tc.attempt, retry, wait, tc.retry, tc.wait)
+		}
+	}
+
+	for _, status := range DefaultRetryStatusCodes {
+		if retry, _ := DefaultRetryPolicy(status, 0); !retry {
+			t.Errorf("expected DefaultRetryPolicy to retry %d", status)
+		}
+	}
+	if retry, _ := DefaultRetryPolicy(http.StatusConflict, 0); retry {
+		t.Error("expected DefaultRetryPolicy not to retry 409")
+	}
+}
+
+// testSleeper records the delays it is asked to wait and returns at once,
+// unless ctx is done or err is set.
+type testSleeper struct {
+	mu     sync.Mutex
+	delays []time.Duration
+	err    error
+}
+
+func (s *testSleeper) Sleep(ctx context.Context, d time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.delays = append(s.delays, d)
+	if s.err != nil {
+		return s.err
+	}
+	return ctx.Err()
+}
+
+func TestDoRequest_BackoffUsesSleeper(t *testing.T) {
+	var calls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	sleeper := &testSleeper{}
+	c := NewClient(server.URL, "test-token")
+	c.MaxRetries = 5
+	c.Sleeper = sleeper
+
+	start := time.Now()
+	if _, err := c.GetServer(context.Background(), "srv-1"); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the backoff to be skipped, took %s", elapsed)
+	}
+
+	if got := calls.Load(); got != 6 {
+		t.Errorf("expected 6 attempts, got %d", got)
+	}
+	want := []time.Duration{500 * time.Millisecond, time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}
+	if !slices.Equal(sleeper.delays, want) {
+		t.Errorf("expected delays %v, got %v", want, sleeper.delays)
+	}
+}
+
+func TestDoRequest_SleeperWaitsRetryPolicyDelay(t *testing.T) {
+	var calls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "srv-1"}`))
+	}))
+	defer server.Close()
+
+	sleeper := &testSleeper{}
+	c := NewClient(server.URL, "test-token")
+	c.MaxRetries = 3
+	c.Sleeper = sleeper
+	c.RetryPolicy = func(statusCode int, attempt int) (bool, time.Duration) {
+		return statusCode == http.StatusConflict, time.Duration(attempt+1) * time.Minute
+	}
+
+	if _, err := c.GetServer(context.Background(), "srv-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []time.Duration{time.Minute, 2 * time.Minute}; !slices.Equal(sleeper.delays, want) {
+		t.Errorf("expected delays %v, got %v", want, sleeper.delays)
+	}
+}
+
+func TestDoRequest_SleeperInterrupted(t *testing.T) {
+	var calls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test-token")
+	c.MaxRetries = 3
+	c.Sleeper = &testSleeper{err: context.Canceled}
+
+	_, err := c.GetServer(context.Background(), "srv-1")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the sleeper's error to be returned, got %v", err)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected no retry after the wait was interrupted, got %d attempts", got)
+	}
+}
+
+func TestWaitForServerActive_BackoffUsesSleeper(t *testing.T) {
+	var calls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := "initializing"
+		if calls.Add(1) >= 7 {
+			status = ServerStatusActive
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Server{ID: "srv-1", Status: status}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer server.Close()
+
+	sleeper := &testSleeper{}
+	c := NewClient(server.URL, "test-token")
+	c.Sleeper = sleeper
+
+	if _, err := c.WaitForServerActive(context.Background(), "srv-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []time.Duration{500 * time.Millisecond, time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second}
+	if !slices.Equal(sleeper.delays, want) {
+		t.Errorf("expected delays %v, got %v", want, sleeper.delays)
+	}
+}
+
+func TestSystemSleeper(t *testing.T) {
+	if err := (systemSleeper{}).Sleep(context.Background(), time.Millisecond); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	if err := (systemSleeper{}).Sleep(ctx, time.Hour); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected the sleep to end when ctx is done, took %s", elapsed)
+	}
 }
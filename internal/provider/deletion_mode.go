@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+// Values of the deletion_mode attributes.
+const (
+	deletionModeDelete     = "delete"
+	deletionModeDeactivate = "deactivate"
+)
+
+var deletionModes = []string{deletionModeDelete, deletionModeDeactivate}
+
+// deletionModeAttribute returns the deletion_mode attribute of the resource
+// managing objects of the given kind, e.g. "tool".
+func deletionModeAttribute(kind string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("What destroying the %s does on the gateway: `delete` removes it, and `deactivate` only marks it inactive, "+
+			"so it is kept along with its history and can be reactivated or imported again. Defaults to the provider's `deletion_mode`, which defaults to `delete`.", kind),
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(deletionModes...),
+		},
+	}
+}
+
+// deactivateOnDelete reports whether destroying a resource whose
+// deletion_mode is mode deactivates the object instead of deleting it. An
+// unset mode falls back to the provider's deletion_mode.
+func deactivateOnDelete(settings providerSettings, mode types.String) bool {
+	if !mode.IsNull() && !mode.IsUnknown() {
+		return mode.ValueString() == deletionModeDeactivate
+	}
+	return settings.deletionMode == deletionModeDeactivate
+}
+
+// addDeactivateError adds err from deactivating an object to diagnostics,
+// unless the gateway answered 404: like a delete, deactivating an object that
+// is already gone succeeds.
+func addDeactivateError(diagnostics *diag.Diagnostics, action string, err error) {
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		return
+	}
+	addClientError(diagnostics, action, err)
+}
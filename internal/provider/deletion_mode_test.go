@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+func TestDeactivateOnDelete(t *testing.T) {
+	cases := map[string]struct {
+		provider string
+		mode     types.String
+		want     bool
+	}{
+		"default":                     {mode: types.StringNull()},
+		"resource delete":             {mode: types.StringValue(deletionModeDelete)},
+		"resource deactivate":         {mode: types.StringValue(deletionModeDeactivate), want: true},
+		"provider deactivate":         {provider: deletionModeDeactivate, mode: types.StringNull(), want: true},
+		"resource overrides provider": {provider: deletionModeDeactivate, mode: types.StringValue(deletionModeDelete)},
+		"provider delete":             {provider: deletionModeDelete, mode: types.StringNull()},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			settings := providerSettings{deletionMode: tc.provider}
+			if got := deactivateOnDelete(settings, tc.mode); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestResourceDelete_DeletionMode(t *testing.T) {
+	kinds := map[string]struct {
+		collection string
+		id         string
+		update     string
+		resource   func(*client.Client, providerSettings) fwresource.Resource
+	}{
+		"tool": {collection: "tools", id: "tool-1", update: http.MethodPatch, resource: func(c *client.Client, s providerSettings) fwresource.Resource {
+			return &ToolResource{client: c, settings: s}
+		}},
+		"prompt": {collection: "prompts", id: "prompt-1", update: http.MethodPatch, resource: func(c *client.Client, s providerSettings) fwresource.Resource {
+			return &PromptResource{client: c, settings: s}
+		}},
+		"mcp_resource": {collection: "resources", id: "res-1", update: http.MethodPatch, resource: func(c *client.Client, s providerSettings) fwresource.Resource {
+			return &MCPResourceResource{client: c, settings: s}
+		}},
+		"server": {collection: "servers", id: "srv-1", update: http.MethodPut, resource: func(c *client.Client, s providerSettings) fwresource.Resource {
+			return &ServerResource{client: c, settings: s}
+		}},
+	}
+	modes := map[string]struct {
+		provider       string
+		mode           types.String
+		wantDeactivate bool
+	}{
+		"default":                     {mode: types.StringNull()},
+		"resource deactivate":         {mode: types.StringValue(deletionModeDeactivate), wantDeactivate: true},
+		"provider deactivate":         {provider: deletionModeDeactivate, mode: types.StringNull(), wantDeactivate: true},
+		"resource overrides provider": {provider: deletionModeDeactivate, mode: types.StringValue(deletionModeDelete)},
+	}
+
+	for kindName, kind := range kinds {
+		for modeName, mode := range modes {
+			t.Run(kindName+"/"+modeName, func(t *testing.T) {
+				ctx := context.Background()
+				api := newTestAccMockAPI()
+				api.Collection(kind.collection, "unused")
+				api.collections[kind.collection].objects[kind.id] = map[string]interface{}{"id": kind.id, "name": "test", "is_active": true}
+				server := api.Server(t)
+
+				r := kind.resource(client.NewClient(server.URL, "test"), providerSettings{deletionMode: mode.provider})
+
+				resp := &fwresource.DeleteResponse{}
+				r.Delete(ctx, fwresource.DeleteRequest{State: testDeletionModeState(t, r, kind.id, mode.mode)}, resp)
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", resp.Diagnostics)
+				}
+
+				objPath := "/" + kind.collection + "/" + kind.id
+				deletes := api.Requests(http.MethodDelete, objPath)
+				updates := api.Requests(kind.update, objPath)
+				obj, kept := api.collections[kind.collection].objects[kind.id]
+
+				if !mode.wantDeactivate {
+					if len(deletes) != 1 || len(updates) != 0 || kept {
+						t.Fatalf("expected the object to be deleted, got %d deletes and %d updates", len(deletes), len(updates))
+					}
+					return
+				}
+				if len(deletes) != 0 || len(updates) != 1 {
+					t.Fatalf("expected one %s and no delete, got %d deletes and %d updates", kind.update, len(deletes), len(updates))
+				}
+				if active, ok := updates[0].Body["is_active"].(bool); !ok || active {
+					t.Errorf("expected is_active=false to be sent, got %v", updates[0].Body)
+				}
+				if kind.update == http.MethodPatch && len(updates[0].Body) != 1 {
+					t.Errorf("expected the patch to change only is_active, got %v", updates[0].Body)
+				}
+				if !kept || obj["is_active"] != false || obj["name"] != "test" {
+					t.Errorf("expected the object to be kept and inactive, got %v", obj)
+				}
+			})
+		}
+
+		t.Run(kindName+"/deactivate missing", func(t *testing.T) {
+			api := newTestAccMockAPI()
+			api.Collection(kind.collection, "unused")
+			server := api.Server(t)
+
+			r := kind.resource(client.NewClient(server.URL, "test"), providerSettings{})
+			resp := &fwresource.DeleteResponse{}
+			r.Delete(context.Background(), fwresource.DeleteRequest{
+				State: testDeletionModeState(t, r, kind.id, types.StringValue(deletionModeDeactivate)),
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("expected deactivating a missing object to succeed, got %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// testDeletionModeState builds the state of r for an object named "test" with
+// the given ID and deletion_mode.
+func testDeletionModeState(t *testing.T, r fwresource.Resource, id string, mode types.String) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	diags := state.SetAttribute(ctx, path.Root("id"), id)
+	diags.Append(state.SetAttribute(ctx, path.Root("name"), "test")...)
+	diags.Append(state.SetAttribute(ctx, path.Root("is_active"), true)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("deletion_mode"), mode)...)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics building state: %v", diags)
+	}
+	return state
+}
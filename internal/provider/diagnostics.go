@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+// addClientError appends a diagnostic for an error returned by the API client.
+// The action describes the failed operation, e.g. "create gateway".
+func addClientError(diagnostics *diag.Diagnostics, action string, err error) {
+	var forbidden *client.ForbiddenError
+	if errors.As(err, &forbidden) {
+		diagnostics.AddError(
+			"Insufficient Permissions",
+			fmt.Sprintf("Unable to %s: insufficient permissions; check bearer token scopes. Gateway response: %s", action, forbidden.Body),
+		)
+		return
+	}
+
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) {
+		summary, hint := apiErrorSummary(apiErr.StatusCode)
+		detail := apiErr.Detail
+		if detail == "" {
+			detail = apiErr.Body
+		}
+		diagnostics.AddError(
+			summary,
+			fmt.Sprintf("Unable to %s: the gateway responded with HTTP %d %s: %s\n\n%s",
+				action, apiErr.StatusCode, http.StatusText(apiErr.StatusCode), detail, hint),
+		)
+		return
+	}
+
+	diagnostics.AddError("Client Error", fmt.Sprintf("Unable to %s, got error: %s", action, err))
+}
+
+// addCreateError is addClientError for create paths. When the gateway reports
+// that the object already exists, the diagnostic names the conflicting object
+// and points at terraform import; what describes it, e.g. `a tool named "x"`.
+func addCreateError(diagnostics *diag.Diagnostics, action, what string, err error) {
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) && isDuplicateError(apiErr) {
+		detail := apiErr.Detail
+		if detail == "" {
+			detail = apiErr.Body
+		}
+		diagnostics.AddError(
+			"Already Exists",
+			fmt.Sprintf("Unable to %s: %s already exists on the gateway; import it instead with `terraform import`, or change the configuration so it does not collide.\n\nGateway response: %s",
+				action, what, detail),
+		)
+		return
+	}
+
+	addClientError(diagnostics, action, err)
+}
+
+// isDuplicateError reports whether the gateway rejected a create because the
+// object already exists. Besides 409, some gateway versions answer duplicates
+// with a 400 or 422 whose detail says so.
+func isDuplicateError(apiErr *client.APIError) bool {
+	if apiErr.StatusCode == http.StatusConflict {
+		return true
+	}
+	if apiErr.StatusCode != http.StatusBadRequest && apiErr.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+	detail := strings.ToLower(apiErr.Detail)
+	return strings.Contains(detail, "already exists") || strings.Contains(detail, "must be unique")
+}
+
+// apiErrorSummary returns the diagnostic summary for a failed request with the
+// given status code, and a hint telling the operator where the problem lies.
+func apiErrorSummary(statusCode int) (string, string) {
+	switch {
+	case statusCode == http.StatusUnauthorized:
+		return "Unauthorized (HTTP 401)", "Check that bearer_token is set to a valid, unexpired token."
+	case statusCode == http.StatusNotFound:
+		return "Not Found (HTTP 404)", "The object may have been deleted outside of Terraform, or the endpoint may point at the wrong gateway."
+	case statusCode == http.StatusConflict:
+		return "Conflict (HTTP 409)", "An object with the same name or identifier may already exist on the gateway."
+	case statusCode >= 400 && statusCode < 500:
+		return fmt.Sprintf("Invalid Request (HTTP %d)", statusCode), "The gateway rejected the request. Check the configured values against the message above."
+	case statusCode >= 500:
+		return fmt.Sprintf("Gateway Error (HTTP %d)", statusCode), "The gateway failed to handle the request. This is not caused by the configuration; retry later or check the gateway logs."
+	}
+	return fmt.Sprintf("Unexpected Response (HTTP %d)", statusCode), "The gateway returned a status the provider does not expect."
+}
+
+// addSkippedItemWarning records that a list element of the given kind could not
+// be mapped and was left out of a data source's results.
+func addSkippedItemWarning(diagnostics *diag.Diagnostics, kind, id string, itemDiags diag.Diagnostics) {
+	details := make([]string, 0, len(itemDiags.Errors()))
+	for _, d := range itemDiags.Errors() {
+		details = append(details, fmt.Sprintf("%s: %s", d.Summary(), d.Detail()))
+	}
+
+	diagnostics.AddWarning(
+		fmt.Sprintf("Skipped %s %s", kind, id),
+		fmt.Sprintf("The %s with ID %q could not be mapped and was omitted from the results: %s", kind, id, strings.Join(details, "; ")),
+	)
+}
+
+// readOnlyBlocked reports whether the provider sets read_only, adding an error
+// for the refused action, e.g. "create gateway". Resources call it at the
+// start of Create, Update and Delete, and actions that change the gateway at
+// the start of Invoke, so no write reaches the gateway.
+func readOnlyBlocked(settings providerSettings, action string, diagnostics *diag.Diagnostics) bool {
+	if !settings.readOnly {
+		return false
+	}
+	diagnostics.AddError(
+		"Provider Is Read-Only",
+		fmt.Sprintf("Unable to %s: the provider is configured with read_only = true, which blocks every change to the gateway. Plans, refreshes and data sources still work; unset read_only to apply changes.", action),
+	)
+	return true
+}
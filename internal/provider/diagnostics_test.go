@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+func TestAddClientError_Forbidden(t *testing.T) {
+	var diags diag.Diagnostics
+	addClientError(&diags, "create gateway", &client.ForbiddenError{Body: `{"detail":"missing scope"}`})
+
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %d", diags.ErrorsCount())
+	}
+	d := diags.Errors()[0]
+	if d.Summary() != "Insufficient Permissions" {
+		t.Errorf("expected summary Insufficient Permissions, got %q", d.Summary())
+	}
+	if !strings.Contains(d.Detail(), "insufficient permissions; check bearer token scopes") {
+		t.Errorf("expected permissions hint in detail, got %q", d.Detail())
+	}
+	if !strings.Contains(d.Detail(), "Unable to create gateway") {
+		t.Errorf("expected action in detail, got %q", d.Detail())
+	}
+}
+
+func TestAddClientError_Generic(t *testing.T) {
+	var diags diag.Diagnostics
+	addClientError(&diags, "read tool", errors.New("unexpected status code 500: boom"))
+
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %d", diags.ErrorsCount())
+	}
+	d := diags.Errors()[0]
+	if d.Summary() != "Client Error" {
+		t.Errorf("expected summary Client Error, got %q", d.Summary())
+	}
+	if d.Detail() != "Unable to read tool, got error: unexpected status code 500: boom" {
+		t.Errorf("unexpected detail %q", d.Detail())
+	}
+}
+
+func TestAddClientError_BadRequest(t *testing.T) {
+	var diags diag.Diagnostics
+	addClientError(&diags, "create tool", &client.APIError{
+		StatusCode: 400,
+		Body:       `{"detail":"Tool name must be unique"}`,
+		Detail:     "Tool name must be unique",
+	})
+
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %d", diags.ErrorsCount())
+	}
+	d := diags.Errors()[0]
+	if d.Summary() != "Invalid Request (HTTP 400)" {
+		t.Errorf("expected summary Invalid Request (HTTP 400), got %q", d.Summary())
+	}
+	if !strings.Contains(d.Detail(), "Unable to create tool: the gateway responded with HTTP 400 Bad Request: Tool name must be unique") {
+		t.Errorf("expected status and parsed detail, got %q", d.Detail())
+	}
+	if !strings.Contains(d.Detail(), "Check the configured values") {
+		t.Errorf("expected user-actionable hint, got %q", d.Detail())
+	}
+}
+
+func TestAddClientError_ServerError(t *testing.T) {
+	var diags diag.Diagnostics
+	addClientError(&diags, "read tool", fmt.Errorf("wrapped: %w", &client.APIError{StatusCode: 502, Body: "bad gateway"}))
+
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %d", diags.ErrorsCount())
+	}
+	d := diags.Errors()[0]
+	if d.Summary() != "Gateway Error (HTTP 502)" {
+		t.Errorf("expected summary Gateway Error (HTTP 502), got %q", d.Summary())
+	}
+	if !strings.Contains(d.Detail(), "HTTP 502 Bad Gateway: bad gateway") {
+		t.Errorf("expected raw body when no detail is parsed, got %q", d.Detail())
+	}
+	if !strings.Contains(d.Detail(), "not caused by the configuration") {
+		t.Errorf("expected gateway-side hint, got %q", d.Detail())
+	}
+}
+
+func TestAddCreateError_Conflict(t *testing.T) {
+	var diags diag.Diagnostics
+	addCreateError(&diags, "create tool", `a tool named "search"`, &client.APIError{
+		StatusCode: 409,
+		Body:       `{"detail":"Tool already exists with name: search"}`,
+		Detail:     "Tool already exists with name: search",
+	})
+
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %d", diags.ErrorsCount())
+	}
+	d := diags.Errors()[0]
+	if d.Summary() != "Already Exists" {
+		t.Errorf("expected summary Already Exists, got %q", d.Summary())
+	}
+	if !strings.Contains(d.Detail(), `a tool named "search" already exists on the gateway; import it instead`) {
+		t.Errorf("expected duplicate explanation, got %q", d.Detail())
+	}
+	if !strings.Contains(d.Detail(), "Tool already exists with name: search") {
+		t.Errorf("expected gateway detail, got %q", d.Detail())
+	}
+}
+
+func TestAddCreateError_DuplicateBadRequest(t *testing.T) {
+	var diags diag.Diagnostics
+	addCreateError(&diags, "create tool", `a tool named "search"`, &client.APIError{
+		StatusCode: 400,
+		Detail:     "Tool name must be unique",
+	})
+
+	if got := diags.Errors()[0].Summary(); got != "Already Exists" {
+		t.Errorf("expected summary Already Exists, got %q", got)
+	}
+}
+
+func TestAddCreateError_OtherErrors(t *testing.T) {
+	var diags diag.Diagnostics
+	addCreateError(&diags, "create tool", `a tool named "search"`, &client.APIError{
+		StatusCode: 422,
+		Detail:     "body.tool.url: field required",
+	})
+
+	if got := diags.Errors()[0].Summary(); got != "Invalid Request (HTTP 422)" {
+		t.Errorf("expected summary Invalid Request (HTTP 422), got %q", got)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+var _ datasource.DataSource = &ExportDataSource{}
+
+func NewExportDataSource() datasource.DataSource {
+	return &ExportDataSource{}
+}
+
+// ExportDataSource snapshots every object on the MCP Gateway that the provider
+// can manage, keyed by Terraform resource type, to help script import blocks.
+type ExportDataSource struct {
+	client *client.Client
+}
+
+// ExportDataSourceModel describes the data source data model.
+type ExportDataSourceModel struct {
+	IncludeInactive types.Bool   `tfsdk:"include_inactive"`
+	Document        types.String `tfsdk:"document"`
+	ID              types.String `tfsdk:"id"`
+}
+
+// exportEntry is a single importable object in the export document. ID is the
+// value accepted by the resource's import.
+type exportEntry struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// exportDocument is the JSON document produced by the export data source.
+type exportDocument struct {
+	Gateways     []exportEntry `json:"contextforge_gateway"`
+	MCPResources []exportEntry `json:"contextforge_mcp_resource"`
+	Prompts      []exportEntry `json:"contextforge_prompt"`
+	Roots        []exportEntry `json:"contextforge_root"`
+	Servers      []exportEntry `json:"contextforge_server"`
+	Tools        []exportEntry `json:"contextforge_tool"`
+}
+
+func (d *ExportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_export"
+}
+
+func (d *ExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exports the servers, tools, prompts, resources, gateways and roots on the ContextForge MCP Gateway as a JSON document, " +
+			"keyed by Terraform resource type, for scripting `import` blocks when adopting an existing gateway.",
+		Attributes: map[string]schema.Attribute{
+			"include_inactive": schema.BoolAttribute{
+				MarkdownDescription: "Whether to include inactive objects in the export. Defaults to `false`.",
+				Optional:            true,
+			},
+			"document": schema.StringAttribute{
+				MarkdownDescription: "JSON document mapping each resource type (e.g. `contextforge_server`) to a list of objects with their import `id` and `name`.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Placeholder identifier.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *ExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ExportDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	includeInactive := false
+	if !data.IncludeInactive.IsNull() && !data.IncludeInactive.IsUnknown() {
+		includeInactive = data.IncludeInactive.ValueBool()
+	}
+
+	var doc exportDocument
+
+	servers, err := d.client.ListServers(ctx, includeInactive)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list servers", err)
+		return
+	}
+	doc.Servers = make([]exportEntry, len(servers))
+	for i, s := range servers {
+		doc.Servers[i] = exportEntry{ID: s.ID, Name: s.Name}
+	}
+
+	tools, err := d.client.ListTools(ctx, includeInactive)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list tools", err)
+		return
+	}
+	doc.Tools = make([]exportEntry, len(tools))
+	for i, t := range tools {
+		doc.Tools[i] = exportEntry{ID: t.ID, Name: t.Name}
+	}
+
+	prompts, err := d.client.ListPrompts(ctx, includeInactive, "")
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list prompts", err)
+		return
+	}
+	doc.Prompts = make([]exportEntry, len(prompts))
+	for i, p := range prompts {
+		doc.Prompts[i] = exportEntry{ID: p.ID, Name: p.Name}
+	}
+
+	resources, err := d.client.ListResources(ctx, includeInactive, "")
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list resources", err)
+		return
+	}
+	doc.MCPResources = make([]exportEntry, len(resources))
+	for i, r := range resources {
+		doc.MCPResources[i] = exportEntry{ID: r.ID, Name: r.Name}
+	}
+
+	gateways, err := d.client.ListGateways(ctx, includeInactive, nil)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list gateways", err)
+		return
+	}
+	doc.Gateways = make([]exportEntry, len(gateways))
+	for i, g := range gateways {
+		doc.Gateways[i] = exportEntry{ID: g.ID, Name: g.Name}
+	}
+
+	// Roots are imported by URI.
+	roots, err := d.client.ListRoots(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list roots", err)
+		return
+	}
+	doc.Roots = make([]exportEntry, len(roots))
+	for i, r := range roots {
+		doc.Roots[i] = exportEntry{ID: r.URI, Name: derefString(r.Name)}
+	}
+
+	docJSON, err := json.Marshal(doc)
+	if err != nil {
+		resp.Diagnostics.AddError("Serialization Error", fmt.Sprintf("Unable to serialize export document: %s", err))
+		return
+	}
+
+	data.Document = types.StringValue(string(docJSON))
+	data.ID = types.StringValue("export")
+
+	tflog.Trace(ctx, "read export data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+func TestAccExportDataSource(t *testing.T) {
+	responses := map[string]interface{}{
+		"/servers":   []client.Server{{ID: "srv-1", Name: "server-one"}},
+		"/tools":     []client.Tool{{ID: "tool-1", Name: "tool-one"}},
+		"/prompts":   []client.Prompt{{ID: "prompt-1", Name: "prompt-one"}},
+		"/resources": []client.Resource{{ID: "res-1", Name: "resource-one", URI: "file:///one"}},
+		"/gateways":  []client.Gateway{{ID: "gw-1", Name: "gateway-one"}},
+		"/roots":     []client.Root{{URI: "file:///workspace", Name: stringPointer("workspace")}},
+	}
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok || r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(body); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+data "contextforge_export" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.contextforge_export.test",
+						tfjsonpath.New("document"),
+						knownvalue.StringExact(`{`+
+							`"contextforge_gateway":[{"id":"gw-1","name":"gateway-one"}],`+
+							`"contextforge_mcp_resource":[{"id":"res-1","name":"resource-one"}],`+
+							`"contextforge_prompt":[{"id":"prompt-1","name":"prompt-one"}],`+
+							`"contextforge_root":[{"id":"file:///workspace","name":"workspace"}],`+
+							`"contextforge_server":[{"id":"srv-1","name":"server-one"}],`+
+							`"contextforge_tool":[{"id":"tool-1","name":"tool-one"}]`+
+							`}`),
+					),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+var _ datasource.DataSource = &FederationDataSource{}
+
+func NewFederationDataSource() datasource.DataSource {
+	return &FederationDataSource{}
+}
+
+// FederationDataSource reads the federation state from the MCP Gateway.
+type FederationDataSource struct {
+	client *client.Client
+}
+
+// FederationDataSourceModel describes the data source data model.
+type FederationDataSourceModel struct {
+	PeerCount types.Int64               `tfsdk:"peer_count"`
+	Peers     []FederationPeerItemModel `tfsdk:"peers"`
+	ID        types.String              `tfsdk:"id"`
+}
+
+// FederationPeerItemModel describes a single federated peer.
+type FederationPeerItemModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	URL      types.String `tfsdk:"url"`
+	Status   types.String `tfsdk:"status"`
+	LastSeen types.String `tfsdk:"last_seen"`
+}
+
+func (d *FederationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_federation"
+}
+
+func (d *FederationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the federation state of the ContextForge MCP Gateway, i.e. the peer gateways it federates with.",
+		Attributes: map[string]schema.Attribute{
+			"peer_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of federated peer gateways.",
+				Computed:            true,
+			},
+			"peers": schema.ListNestedAttribute{
+				MarkdownDescription: "List of federated peer gateways.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Peer identifier.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Peer name.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "Peer URL.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Federation status of the peer, if reported.",
+							Computed:            true,
+						},
+						"last_seen": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the peer was last seen, if reported.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Placeholder identifier.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *FederationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *FederationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FederationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	federation, err := d.client.GetFederation(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read federation", err)
+		return
+	}
+
+	data.Peers = make([]FederationPeerItemModel, len(federation.Peers))
+	for i, p := range federation.Peers {
+		item := FederationPeerItemModel{
+			ID:       types.StringValue(p.ID),
+			Name:     types.StringValue(p.Name),
+			URL:      types.StringValue(p.URL),
+			Status:   types.StringNull(),
+			LastSeen: types.StringNull(),
+		}
+		if p.Status != "" {
+			item.Status = types.StringValue(p.Status)
+		}
+		if p.LastSeen != "" {
+			item.LastSeen = types.StringValue(p.LastSeen)
+		}
+		data.Peers[i] = item
+	}
+	data.PeerCount = types.Int64Value(int64(len(federation.Peers)))
+
+	data.ID = types.StringValue("federation")
+
+	tflog.Trace(ctx, "read federation data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccFederationDataSource(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/federation" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			if _, err := w.Write([]byte(`{
+				"peers": [
+					{"id": "peer-1", "name": "east", "url": "https://east.example.com", "status": "connected", "last_seen": "2025-01-01T00:00:00Z"},
+					{"id": "peer-2", "name": "west", "url": "https://west.example.com"}
+				]
+			}`)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+data "contextforge_federation" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.contextforge_federation.test",
+						tfjsonpath.New("peer_count"),
+						knownvalue.Int64Exact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_federation.test",
+						tfjsonpath.New("peers").AtSliceIndex(0).AtMapKey("status"),
+						knownvalue.StringExact("connected"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_federation.test",
+						tfjsonpath.New("peers").AtSliceIndex(1).AtMapKey("status"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+var _ datasource.DataSource = &GatewayCapabilitiesDataSource{}
+
+func NewGatewayCapabilitiesDataSource() datasource.DataSource {
+	return &GatewayCapabilitiesDataSource{}
+}
+
+// GatewayCapabilitiesDataSource reads a gateway's capabilities as decoded attributes.
+type GatewayCapabilitiesDataSource struct {
+	client *client.Client
+}
+
+// GatewayCapabilitiesDataSourceModel describes the data source data model.
+type GatewayCapabilitiesDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	SupportsTools     types.Bool   `tfsdk:"supports_tools"`
+	SupportsPrompts   types.Bool   `tfsdk:"supports_prompts"`
+	SupportsResources types.Bool   `tfsdk:"supports_resources"`
+	SupportsSampling  types.Bool   `tfsdk:"supports_sampling"`
+	Capabilities      types.String `tfsdk:"capabilities"`
+}
+
+func (d *GatewayCapabilitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway_capabilities"
+}
+
+func (d *GatewayCapabilitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the capabilities advertised by a gateway on the ContextForge MCP Gateway and exposes the well-known ones as booleans.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Gateway identifier.",
+				Required:            true,
+			},
+			"supports_tools": schema.BoolAttribute{
+				MarkdownDescription: "Whether the gateway advertises the `tools` capability.",
+				Computed:            true,
+			},
+			"supports_prompts": schema.BoolAttribute{
+				MarkdownDescription: "Whether the gateway advertises the `prompts` capability.",
+				Computed:            true,
+			},
+			"supports_resources": schema.BoolAttribute{
+				MarkdownDescription: "Whether the gateway advertises the `resources` capability.",
+				Computed:            true,
+			},
+			"supports_sampling": schema.BoolAttribute{
+				MarkdownDescription: "Whether the gateway advertises the `sampling` capability.",
+				Computed:            true,
+			},
+			"capabilities": schema.StringAttribute{
+				MarkdownDescription: "Gateway capabilities as a JSON string.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GatewayCapabilitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *GatewayCapabilitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GatewayCapabilitiesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gateway, err := d.client.GetGateway(ctx, data.ID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read gateway", err)
+		return
+	}
+	if gateway == nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Gateway with ID %s not found", data.ID.ValueString()))
+		return
+	}
+
+	data.ID = types.StringValue(gateway.ID)
+	data.SupportsTools = types.BoolValue(capabilitySupported(gateway.Capabilities, "tools"))
+	data.SupportsPrompts = types.BoolValue(capabilitySupported(gateway.Capabilities, "prompts"))
+	data.SupportsResources = types.BoolValue(capabilitySupported(gateway.Capabilities, "resources"))
+	data.SupportsSampling = types.BoolValue(capabilitySupported(gateway.Capabilities, "sampling"))
+
+	if gateway.Capabilities != nil {
+		capsJSON, err := json.Marshal(gateway.Capabilities)
+		if err != nil {
+			resp.Diagnostics.AddError("Capabilities Serialization Error", fmt.Sprintf("Unable to serialize capabilities: %s", err))
+			return
+		}
+		data.Capabilities = types.StringValue(string(capsJSON))
+	} else {
+		data.Capabilities = types.StringNull()
+	}
+
+	tflog.Trace(ctx, "read gateway capabilities data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// capabilitySupported reports whether the named capability is advertised. MCP
+// capabilities are objects whose presence signals support, so any value other
+// than null or false counts.
+func capabilitySupported(capabilities map[string]interface{}, name string) bool {
+	value, ok := capabilities[name]
+	if !ok || value == nil {
+		return false
+	}
+	if enabled, isBool := value.(bool); isBool {
+		return enabled
+	}
+	return true
+}
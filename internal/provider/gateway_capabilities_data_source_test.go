@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+func TestCapabilitySupported(t *testing.T) {
+	capabilities := map[string]interface{}{
+		"tools":     map[string]interface{}{"listChanged": true},
+		"prompts":   map[string]interface{}{},
+		"resources": false,
+		"sampling":  nil,
+		"logging":   true,
+	}
+
+	cases := map[string]bool{
+		"tools":       true,
+		"prompts":     true,
+		"resources":   false,
+		"sampling":    false,
+		"logging":     true,
+		"completions": false,
+	}
+
+	for name, want := range cases {
+		if got := capabilitySupported(capabilities, name); got != want {
+			t.Errorf("capabilitySupported(%q) = %t, want %t", name, got, want)
+		}
+	}
+
+	if capabilitySupported(nil, "tools") {
+		t.Error("expected nil capabilities to support nothing")
+	}
+}
+
+func TestAccGatewayCapabilitiesDataSource(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/gateways/gw-1" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(client.Gateway{
+				ID:   "gw-1",
+				Name: "test-gw",
+				URL:  "https://example.com/mcp",
+				Capabilities: map[string]interface{}{
+					"tools":     map[string]interface{}{"listChanged": true},
+					"resources": map[string]interface{}{"subscribe": false},
+				},
+			}); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayCapabilitiesDataSourceConfig(mockServer.URL),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.contextforge_gateway_capabilities.test",
+						tfjsonpath.New("supports_tools"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_gateway_capabilities.test",
+						tfjsonpath.New("supports_resources"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_gateway_capabilities.test",
+						tfjsonpath.New("supports_prompts"),
+						knownvalue.Bool(false),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_gateway_capabilities.test",
+						tfjsonpath.New("supports_sampling"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccGatewayCapabilitiesDataSourceConfig(endpoint string) string {
+	return `
+provider "contextforge" {
+  endpoint     = "` + endpoint + `"
+  bearer_token = "test"
+}
+
+data "contextforge_gateway_capabilities" "test" {
+  id = "gw-1"
+}
+`
+}
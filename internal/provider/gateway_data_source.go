@@ -7,6 +7,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -34,6 +36,7 @@ type GatewayDataSourceModel struct {
 	Description         types.String `tfsdk:"description"`
 	Transport           types.String `tfsdk:"transport"`
 	Capabilities        types.String `tfsdk:"capabilities"`
+	SupportedTransports types.List   `tfsdk:"supported_transports"`
 	HealthCheckURL      types.String `tfsdk:"health_check_url"`
 	HealthCheckInterval types.Int64  `tfsdk:"health_check_interval"`
 	HealthCheckTimeout  types.Int64  `tfsdk:"health_check_timeout"`
@@ -44,6 +47,7 @@ type GatewayDataSourceModel struct {
 	AuthType            types.String `tfsdk:"auth_type"`
 	CreatedAt           types.String `tfsdk:"created_at"`
 	UpdatedAt           types.String `tfsdk:"updated_at"`
+	LastError           types.String `tfsdk:"last_error"`
 }
 
 func (d *GatewayDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -78,6 +82,11 @@ func (d *GatewayDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "Gateway capabilities as a JSON string.",
 				Computed:            true,
 			},
+			"supported_transports": schema.ListAttribute{
+				MarkdownDescription: "Transports the gateway is known to serve: its `transport`, plus any transport whose specific capability (`sse` for `SSE`; `streamableHttp` or `resumability` for `STREAMABLEHTTP`) it advertises. Useful to check a `transport` choice against what the gateway supports.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"health_check_url": schema.StringAttribute{
 				MarkdownDescription: "Health check URL.",
 				Computed:            true,
@@ -120,6 +129,10 @@ func (d *GatewayDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "Timestamp when the gateway was last updated.",
 				Computed:            true,
 			},
+			"last_error": schema.StringAttribute{
+				MarkdownDescription: "Most recent error the gateway recorded while connecting to the upstream server. Null when the gateway is healthy.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -129,16 +142,16 @@ func (d *GatewayDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = apiClient
+	d.client = data.client
 }
 
 func (d *GatewayDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -151,7 +164,7 @@ func (d *GatewayDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	gateway, err := d.client.GetGateway(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read gateway, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read gateway", err)
 		return
 	}
 	if gateway == nil {
@@ -162,8 +175,8 @@ func (d *GatewayDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	data.ID = types.StringValue(gateway.ID)
 	data.Name = types.StringValue(gateway.Name)
 	data.URL = types.StringValue(gateway.URL)
-	data.Description = types.StringValue(gateway.Description)
-	data.Transport = types.StringValue(gateway.Transport)
+	data.Description = types.StringValue(derefString(gateway.Description))
+	data.Transport = types.StringValue(derefString(gateway.Transport))
 	data.IsActive = types.BoolValue(gateway.IsActive)
 	data.CreatedAt = types.StringValue(gateway.CreatedAt)
 	data.UpdatedAt = types.StringValue(gateway.UpdatedAt)
@@ -174,6 +187,12 @@ func (d *GatewayDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		data.AuthType = types.StringNull()
 	}
 
+	if gateway.LastError != "" {
+		data.LastError = types.StringValue(gateway.LastError)
+	} else {
+		data.LastError = types.StringNull()
+	}
+
 	if gateway.Capabilities != nil {
 		capsJSON, err := json.Marshal(gateway.Capabilities)
 		if err != nil {
@@ -185,6 +204,13 @@ func (d *GatewayDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		data.Capabilities = types.StringNull()
 	}
 
+	transports, diags := stringListValue(ctx, supportedTransports(derefString(gateway.Transport), gateway.Capabilities))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.SupportedTransports = transports
+
 	if gateway.HealthCheck != nil {
 		data.HealthCheckURL = types.StringValue(gateway.HealthCheck.URL)
 		data.HealthCheckInterval = types.Int64Value(int64(gateway.HealthCheck.Interval))
@@ -197,29 +223,51 @@ func (d *GatewayDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		data.HealthCheckRetries = types.Int64Null()
 	}
 
-	if gateway.Tags != nil {
-		tags, diags := types.ListValueFrom(ctx, types.StringType, gateway.Tags)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.Tags = tags
-	} else {
-		data.Tags = types.ListNull(types.StringType)
+	tags, diags := stringListValue(ctx, gateway.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.Tags = tags
 
-	if gateway.PassthroughHeaders != nil {
-		headers, diags := types.ListValueFrom(ctx, types.StringType, gateway.PassthroughHeaders)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.PassthroughHeaders = headers
-	} else {
-		data.PassthroughHeaders = types.ListNull(types.StringType)
+	headers, diags := stringListValue(ctx, gateway.PassthroughHeaders)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.PassthroughHeaders = headers
 
 	tflog.Trace(ctx, "read gateway data source")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// supportedTransports returns the transports a gateway serves: the one it is
+// registered with, plus those whose transport-specific capability it
+// advertises. Capabilities shared by several transports, like `sessions`, do
+// not tell them apart and are ignored. Known transports come first, in the
+// order of gatewayTransports.
+func supportedTransports(transport string, capabilities map[string]interface{}) []string {
+	transport = strings.ToUpper(transport)
+	var supported []string
+	for _, t := range gatewayTransports {
+		if t == transport || advertisesTransport(capabilities, t) {
+			supported = append(supported, t)
+		}
+	}
+	if transport != "" && !slices.Contains(gatewayTransports, transport) {
+		supported = append(supported, transport)
+	}
+	return supported
+}
+
+// advertisesTransport reports whether capabilities include a supported
+// capability that applies to transport alone.
+func advertisesTransport(capabilities map[string]interface{}, transport string) bool {
+	for key, transports := range capabilityTransports {
+		if len(transports) == 1 && transports[0] == transport && capabilitySupported(capabilities, key) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+func TestAccGatewayDataSource_LastError(t *testing.T) {
+	gateways := map[string]client.Gateway{
+		"gw-healthy": {
+			ID:       "gw-healthy",
+			Name:     "healthy",
+			URL:      "https://healthy.example.com/mcp",
+			IsActive: true,
+		},
+		"gw-unhealthy": {
+			ID:        "gw-unhealthy",
+			Name:      "unhealthy",
+			URL:       "https://unhealthy.example.com/mcp",
+			IsActive:  true,
+			LastError: "dial tcp 10.0.0.7:443: connect: connection refused",
+		},
+	}
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		gateway, ok := gateways[strings.TrimPrefix(r.URL.Path, "/gateways/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(gateway); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+data "contextforge_gateway" "healthy" {
+  id = "gw-healthy"
+}
+
+data "contextforge_gateway" "unhealthy" {
+  id = "gw-unhealthy"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.contextforge_gateway.unhealthy",
+						tfjsonpath.New("last_error"),
+						knownvalue.StringExact("dial tcp 10.0.0.7:443: connect: connection refused"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_gateway.healthy",
+						tfjsonpath.New("last_error"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestSupportedTransports(t *testing.T) {
+	cases := map[string]struct {
+		transport    string
+		capabilities map[string]interface{}
+		want         []string
+	}{
+		"registered transport only": {transport: "SSE", want: []string{"SSE"}},
+		"multiple transports": {
+			transport:    "SSE",
+			capabilities: map[string]interface{}{"sse": map[string]interface{}{}, "streamableHttp": map[string]interface{}{"enabled": true}, "tools": map[string]interface{}{}},
+			want:         []string{"STREAMABLEHTTP", "SSE"},
+		},
+		"implied by resumability": {
+			transport:    "SSE",
+			capabilities: map[string]interface{}{"resumability": true},
+			want:         []string{"STREAMABLEHTTP", "SSE"},
+		},
+		"disabled capability": {
+			transport:    "STREAMABLEHTTP",
+			capabilities: map[string]interface{}{"sse": false, "sessions": map[string]interface{}{}},
+			want:         []string{"STREAMABLEHTTP"},
+		},
+		"lowercase transport":     {transport: "stdio", want: []string{"STDIO"}},
+		"unknown transport kept":  {transport: "websocket", capabilities: map[string]interface{}{"sse": map[string]interface{}{}}, want: []string{"SSE", "WEBSOCKET"}},
+		"no transport":            {transport: "", want: nil},
+		"capabilities without it": {transport: "", capabilities: map[string]interface{}{"streamableHttp": map[string]interface{}{}}, want: []string{"STREAMABLEHTTP"}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := supportedTransports(tc.transport, tc.capabilities); !slices.Equal(got, tc.want) {
+				t.Errorf("supportedTransports(%q, %v) = %q, want %q", tc.transport, tc.capabilities, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAccGatewayDataSource_SupportedTransports(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/gateways/gw-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+  "id": "gw-1",
+  "name": "multi",
+  "url": "https://multi.example.com/mcp",
+  "transport": "SSE",
+  "capabilities": {"tools": {}, "sse": {}, "streamableHttp": {}}
+}`))
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+data "contextforge_gateway" "test" {
+  id = "gw-1"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.contextforge_gateway.test",
+						tfjsonpath.New("supported_transports"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("STREAMABLEHTTP"),
+							knownvalue.StringExact("SSE"),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
@@ -7,7 +7,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,6 +29,8 @@ import (
 
 var _ resource.Resource = &GatewayResource{}
 var _ resource.ResourceWithImportState = &GatewayResource{}
+var _ resource.ResourceWithModifyPlan = &GatewayResource{}
+var _ resource.ResourceWithValidateConfig = &GatewayResource{}
 
 func NewGatewayResource() resource.Resource {
 	return &GatewayResource{}
@@ -30,28 +38,35 @@ func NewGatewayResource() resource.Resource {
 
 // GatewayResource manages a gateway on the MCP Gateway.
 type GatewayResource struct {
-	client *client.Client
+	client   *client.Client
+	settings providerSettings
 }
 
 // GatewayResourceModel describes the resource data model.
 type GatewayResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Name                types.String `tfsdk:"name"`
-	URL                 types.String `tfsdk:"url"`
-	Description         types.String `tfsdk:"description"`
-	Transport           types.String `tfsdk:"transport"`
-	Capabilities        types.String `tfsdk:"capabilities"`
-	HealthCheckURL      types.String `tfsdk:"health_check_url"`
-	HealthCheckInterval types.Int64  `tfsdk:"health_check_interval"`
-	HealthCheckTimeout  types.Int64  `tfsdk:"health_check_timeout"`
-	HealthCheckRetries  types.Int64  `tfsdk:"health_check_retries"`
-	IsActive            types.Bool   `tfsdk:"is_active"`
-	Tags                types.List   `tfsdk:"tags"`
-	PassthroughHeaders  types.List   `tfsdk:"passthrough_headers"`
-	AuthType            types.String `tfsdk:"auth_type"`
-	AuthValue           types.String `tfsdk:"auth_value"`
-	CreatedAt           types.String `tfsdk:"created_at"`
-	UpdatedAt           types.String `tfsdk:"updated_at"`
+	ID                  types.String   `tfsdk:"id"`
+	Name                types.String   `tfsdk:"name"`
+	URL                 types.String   `tfsdk:"url"`
+	Description         types.String   `tfsdk:"description"`
+	Transport           types.String   `tfsdk:"transport"`
+	SSEPath             types.String   `tfsdk:"sse_path"`
+	Capabilities        types.String   `tfsdk:"capabilities"`
+	CapabilityTools     types.Bool     `tfsdk:"capability_tools"`
+	CapabilityPrompts   types.Bool     `tfsdk:"capability_prompts"`
+	CapabilityResources types.Bool     `tfsdk:"capability_resources"`
+	HealthCheckURL      types.String   `tfsdk:"health_check_url"`
+	HealthCheckInterval types.Int64    `tfsdk:"health_check_interval"`
+	HealthCheckTimeout  types.Int64    `tfsdk:"health_check_timeout"`
+	HealthCheckRetries  types.Int64    `tfsdk:"health_check_retries"`
+	IsActive            types.Bool     `tfsdk:"is_active"`
+	Tags                types.List     `tfsdk:"tags"`
+	PassthroughHeaders  types.List     `tfsdk:"passthrough_headers"`
+	AuthType            types.String   `tfsdk:"auth_type"`
+	AuthValue           types.String   `tfsdk:"auth_value"`
+	CreatedAt           types.String   `tfsdk:"created_at"`
+	UpdatedAt           types.String   `tfsdk:"updated_at"`
+	LastError           types.String   `tfsdk:"last_error"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *GatewayResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -60,7 +75,7 @@ func (r *GatewayResource) Metadata(ctx context.Context, req resource.MetadataReq
 
 func (r *GatewayResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a gateway on the ContextForge MCP Gateway.",
+		MarkdownDescription: "Manages a gateway on the ContextForge MCP Gateway. When the gateway registers the upstream server asynchronously, create waits for the registration job to finish, up to the `create` timeout.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Gateway identifier, assigned by the API.",
@@ -87,33 +102,69 @@ func (r *GatewayResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("STREAMABLEHTTP", "SSE", "STDIO"),
+					stringvalidator.OneOf(gatewayTransports...),
 				},
 			},
-			"capabilities": schema.StringAttribute{
-				MarkdownDescription: "Gateway capabilities as a JSON-encoded string.",
+			"sse_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the SSE event endpoint on the upstream server (e.g. `/sse`). Only valid when `transport` is `SSE`.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"capabilities": schema.StringAttribute{
+				MarkdownDescription: "Gateway capabilities as a JSON-encoded string. Transport-specific keys (`sse`, `streamableHttp`, `resumability`, `sessions`) that do not apply to `transport` produce a warning. " +
+					"Conflicts with the `capability_*` attributes; when those are used instead, this holds the capabilities the gateway reports.",
+				Optional: true,
+				Computed: true,
+			},
+			"capability_tools": schema.BoolAttribute{
+				MarkdownDescription: capabilityFlagDescription("tools"),
+				Optional:            true,
+				Validators:          capabilityFlagValidators,
+			},
+			"capability_prompts": schema.BoolAttribute{
+				MarkdownDescription: capabilityFlagDescription("prompts"),
+				Optional:            true,
+				Validators:          capabilityFlagValidators,
+			},
+			"capability_resources": schema.BoolAttribute{
+				MarkdownDescription: capabilityFlagDescription("resources"),
+				Optional:            true,
+				Validators:          capabilityFlagValidators,
 			},
 			"health_check_url": schema.StringAttribute{
-				MarkdownDescription: "Health check URL for the gateway.",
+				MarkdownDescription: "Health check URL for the gateway. A path such as `/health` is resolved against `url`, and an absolute URL on the same scheme and host as `url` but without a port is sent with the port of `url`.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					healthCheckURLValidator{},
+				},
 			},
 			"health_check_interval": schema.Int64Attribute{
-				MarkdownDescription: "Health check interval in seconds.",
+				MarkdownDescription: "Health check interval in seconds. Requires `health_check_url`.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("health_check_url")),
+				},
 			},
 			"health_check_timeout": schema.Int64Attribute{
-				MarkdownDescription: "Health check timeout in seconds.",
+				MarkdownDescription: "Health check timeout in seconds. Requires `health_check_url`.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("health_check_url")),
+				},
 			},
 			"health_check_retries": schema.Int64Attribute{
-				MarkdownDescription: "Number of health check retries.",
+				MarkdownDescription: "Number of health check retries. Requires `health_check_url`.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("health_check_url")),
+				},
 			},
 			"is_active": schema.BoolAttribute{
 				MarkdownDescription: "Whether the gateway is active.",
@@ -121,21 +172,27 @@ func (r *GatewayResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:            true,
 			},
 			"tags": schema.ListAttribute{
-				MarkdownDescription: "Tags associated with the gateway.",
+				MarkdownDescription: "Tags associated with the gateway. Leaving this unset and setting it to `[]` are equivalent. Tags are trimmed and de-duplicated before they are sent, and lowercased when the provider sets `lowercase_tags`.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
 			"passthrough_headers": schema.ListAttribute{
-				MarkdownDescription: "Headers to pass through to the gateway.",
+				MarkdownDescription: "Headers to pass through to the gateway. Hop-by-hop headers (`Connection`, `Keep-Alive`, `Transfer-Encoding`) are rejected, and credential-bearing headers (`Authorization`, `Cookie`) produce a warning.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(passthroughHeaderValidator{warnSensitive: true}),
+				},
 			},
 			"auth_type": schema.StringAttribute{
-				MarkdownDescription: "Authentication type for the gateway.",
+				MarkdownDescription: "Authentication type for the gateway. One of `basic`, `bearer`, `authheaders`, `oauth`, `query_param`, or `none`.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(gatewayAuthTypes...),
+				},
 			},
 			"auth_value": schema.StringAttribute{
 				MarkdownDescription: "Authentication value for the gateway.",
@@ -153,8 +210,136 @@ func (r *GatewayResource) Schema(ctx context.Context, req resource.SchemaRequest
 				MarkdownDescription: "Timestamp when the gateway was last updated.",
 				Computed:            true,
 			},
+			"last_error": schema.StringAttribute{
+				MarkdownDescription: "Most recent error the gateway recorded while connecting to the upstream server. Null when the gateway is healthy.",
+				Computed:            true,
+			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig rejects transport-specific attributes that do not apply to
+// the configured transport, and warns about capabilities that do not.
+func (r *GatewayResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var transport, ssePath, capabilities types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("transport"), &transport)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sse_path"), &ssePath)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("capabilities"), &capabilities)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if transport.IsNull() || transport.IsUnknown() {
+		return
+	}
+
+	if !ssePath.IsNull() && transport.ValueString() != "SSE" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sse_path"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("sse_path can only be set when transport is \"SSE\", got transport %q.", transport.ValueString()),
+		)
+	}
+
+	if capabilities.IsNull() || capabilities.IsUnknown() {
+		return
+	}
+
+	// Malformed JSON is reported by Create and Update, so it is ignored here.
+	var parsed map[string]interface{}
+	if err := json.Unmarshal([]byte(capabilities.ValueString()), &parsed); err != nil {
+		return
+	}
+
+	for _, key := range incompatibleCapabilities(transport.ValueString(), parsed) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("capabilities"),
+			"Capability Not Supported by Transport",
+			fmt.Sprintf("The %q capability only applies to the %s transport(s) and has no effect with transport %q; the gateway may reject it.",
+				key, strings.Join(capabilityTransports[key], ", "), transport.ValueString()),
+		)
+	}
+}
+
+// capabilityFlagValidators make the capability_* attributes mutually
+// exclusive with the raw capabilities JSON.
+var capabilityFlagValidators = []validator.Bool{
+	boolvalidator.ConflictsWith(path.MatchRoot("capabilities")),
+}
+
+// capabilityFlagDescription describes the capability_* attribute for key.
+func capabilityFlagDescription(key string) string {
+	return fmt.Sprintf("Whether the gateway advertises the `%s` capability. Setting any `capability_*` attribute sends the capabilities they compose, "+
+		"e.g. `{\"%s\": {}}`, instead of the `capabilities` JSON, with which they conflict. An unset attribute counts as `false`.", key, key)
+}
+
+// gatewayCapabilities returns the capabilities to send for data. When any
+// capability_* attribute is set, they are composed into a map with an empty
+// object for each enabled capability; otherwise the capabilities JSON is used.
+func gatewayCapabilities(data GatewayResourceModel, diagnostics *diag.Diagnostics) map[string]interface{} {
+	flags := map[string]types.Bool{
+		"tools":     data.CapabilityTools,
+		"prompts":   data.CapabilityPrompts,
+		"resources": data.CapabilityResources,
+	}
+
+	var composed map[string]interface{}
+	for key, flag := range flags {
+		if flag.IsNull() || flag.IsUnknown() {
+			continue
+		}
+		if composed == nil {
+			composed = map[string]interface{}{}
+		}
+		if flag.ValueBool() {
+			composed[key] = map[string]interface{}{}
+		}
+	}
+	if composed != nil {
+		return composed
+	}
+
+	return jsonObjectFromModel(data.Capabilities, path.Root("capabilities"), "Invalid Capabilities", diagnostics)
+}
+
+// gatewayTransports are the transports a gateway can be registered with.
+var gatewayTransports = []string{"STREAMABLEHTTP", "SSE", "STDIO"}
+
+// gatewayAuthTypes are the authentication types the gateway accepts for
+// upstream servers.
+var gatewayAuthTypes = []string{"basic", "bearer", "authheaders", "oauth", "query_param", "none"}
+
+// capabilityTransports lists the capability keys that describe a feature of a
+// particular transport, and the transports they apply to. Keys not listed
+// here are transport-independent.
+var capabilityTransports = map[string][]string{
+	"sse":            {"SSE"},
+	"streamableHttp": {"STREAMABLEHTTP"},
+	"resumability":   {"STREAMABLEHTTP"},
+	"sessions":       {"SSE", "STREAMABLEHTTP"},
+}
+
+// incompatibleCapabilities returns, sorted, the keys of capabilities that do
+// not apply to transport.
+func incompatibleCapabilities(transport string, capabilities map[string]interface{}) []string {
+	var keys []string
+	for key := range capabilities {
+		transports, ok := capabilityTransports[key]
+		if ok && !slices.Contains(transports, transport) {
+			keys = append(keys, key)
+		}
 	}
+	slices.Sort(keys)
+	return keys
 }
 
 func (r *GatewayResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -162,19 +347,30 @@ func (r *GatewayResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = apiClient
+	r.client = data.client
+	r.settings = data.settings
+}
+
+// ModifyPlan warns when the configured tags will be normalized before they
+// are sent to the gateway.
+func (r *GatewayResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnTagNormalization(ctx, req.Config, r.settings.lowercaseTags, &resp.Diagnostics)
 }
 
 func (r *GatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if readOnlyBlocked(r.settings, "create gateway", &resp.Diagnostics) {
+		return
+	}
+
 	var data GatewayResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -182,12 +378,18 @@ func (r *GatewayResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	var tags []string
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	createTimeout, diags := data.Timeouts.Create(ctx, r.settings.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tags := tagsFromModel(ctx, data.Tags, r.settings.lowercaseTags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	var passthroughHeaders []string
@@ -208,6 +410,7 @@ func (r *GatewayResource) Create(ctx context.Context, req resource.CreateRequest
 		URL:                data.URL.ValueString(),
 		Description:        data.Description.ValueString(),
 		Transport:          data.Transport.ValueString(),
+		SSEPath:            data.SSEPath.ValueString(),
 		IsActive:           isActiveCreate,
 		Tags:               tags,
 		PassthroughHeaders: passthroughHeaders,
@@ -215,18 +418,14 @@ func (r *GatewayResource) Create(ctx context.Context, req resource.CreateRequest
 		AuthValue:          data.AuthValue.ValueString(),
 	}
 
-	if !data.Capabilities.IsNull() && !data.Capabilities.IsUnknown() && data.Capabilities.ValueString() != "" {
-		var caps map[string]interface{}
-		if err := json.Unmarshal([]byte(data.Capabilities.ValueString()), &caps); err != nil {
-			resp.Diagnostics.AddError("Invalid Capabilities", fmt.Sprintf("Unable to parse capabilities JSON: %s", err))
-			return
-		}
-		createReq.Capabilities = caps
+	createReq.Capabilities = gatewayCapabilities(data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if !data.HealthCheckURL.IsNull() && !data.HealthCheckURL.IsUnknown() {
 		hc := &client.GatewayHealthCheck{
-			URL: data.HealthCheckURL.ValueString(),
+			URL: resolveHealthCheckURL(data.HealthCheckURL.ValueString(), data.URL.ValueString()),
 		}
 		if !data.HealthCheckInterval.IsNull() && !data.HealthCheckInterval.IsUnknown() {
 			hc.Interval = int(data.HealthCheckInterval.ValueInt64())
@@ -242,10 +441,21 @@ func (r *GatewayResource) Create(ctx context.Context, req resource.CreateRequest
 
 	gateway, err := r.client.CreateGateway(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create gateway, got error: %s", err))
+		addCreateError(&resp.Diagnostics, "create gateway", fmt.Sprintf("a gateway named %q", data.Name.ValueString()), err)
 		return
 	}
 
+	// Some gateway versions ignore is_active on create and always register the
+	// gateway enabled. Deactivate it explicitly so is_active = false holds.
+	if !isActiveCreate && gateway.IsActive {
+		inactive := false
+		gateway, err = r.client.UpdateGateway(ctx, gateway.ID, client.GatewayUpdate{IsActive: &inactive})
+		if err != nil {
+			addClientError(&resp.Diagnostics, "deactivate gateway", err)
+			return
+		}
+	}
+
 	r.gatewayToModel(ctx, gateway, &data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -264,9 +474,18 @@ func (r *GatewayResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, r.settings.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	gateway, err := r.client.GetGateway(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read gateway, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read gateway", err)
 		return
 	}
 	if gateway == nil {
@@ -291,6 +510,10 @@ func (r *GatewayResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *GatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if readOnlyBlocked(r.settings, "update gateway", &resp.Diagnostics) {
+		return
+	}
+
 	var data GatewayResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -298,12 +521,18 @@ func (r *GatewayResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	var tags []string
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.settings.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	tags := tagsFromModel(ctx, data.Tags, r.settings.lowercaseTags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	var passthroughHeaders []string
@@ -314,31 +543,40 @@ func (r *GatewayResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
-	isActive := data.IsActive.ValueBool()
+	// is_active is unknown when it is not configured; leave it unchanged
+	// rather than sending false.
+	var isActive *bool
+	if !data.IsActive.IsNull() && !data.IsActive.IsUnknown() {
+		v := data.IsActive.ValueBool()
+		isActive = &v
+	}
+
 	updateReq := client.GatewayUpdate{
 		Name:               data.Name.ValueString(),
 		URL:                data.URL.ValueString(),
 		Description:        data.Description.ValueString(),
 		Transport:          data.Transport.ValueString(),
-		IsActive:           &isActive,
+		SSEPath:            data.SSEPath.ValueString(),
+		IsActive:           isActive,
 		Tags:               tags,
 		PassthroughHeaders: passthroughHeaders,
 		AuthType:           data.AuthType.ValueString(),
 		AuthValue:          data.AuthValue.ValueString(),
 	}
 
-	if !data.Capabilities.IsNull() && !data.Capabilities.IsUnknown() && data.Capabilities.ValueString() != "" {
-		var caps map[string]interface{}
-		if err := json.Unmarshal([]byte(data.Capabilities.ValueString()), &caps); err != nil {
-			resp.Diagnostics.AddError("Invalid Capabilities", fmt.Sprintf("Unable to parse capabilities JSON: %s", err))
-			return
-		}
-		updateReq.Capabilities = caps
+	// Capabilities that compose to an empty map, e.g. every capability_*
+	// flag set to false, are sent as {} so the gateway drops the old ones.
+	capabilities := gatewayCapabilities(data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if capabilities != nil {
+		updateReq.Capabilities = &capabilities
 	}
 
 	if !data.HealthCheckURL.IsNull() && !data.HealthCheckURL.IsUnknown() {
 		hc := &client.GatewayHealthCheck{
-			URL: data.HealthCheckURL.ValueString(),
+			URL: resolveHealthCheckURL(data.HealthCheckURL.ValueString(), data.URL.ValueString()),
 		}
 		if !data.HealthCheckInterval.IsNull() && !data.HealthCheckInterval.IsUnknown() {
 			hc.Interval = int(data.HealthCheckInterval.ValueInt64())
@@ -354,7 +592,7 @@ func (r *GatewayResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	gateway, err := r.client.UpdateGateway(ctx, data.ID.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update gateway, got error: %s", err))
+		addClientError(&resp.Diagnostics, "update gateway", err)
 		return
 	}
 
@@ -377,6 +615,10 @@ func (r *GatewayResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *GatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if readOnlyBlocked(r.settings, "delete gateway", &resp.Diagnostics) {
+		return
+	}
+
 	var data GatewayResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -384,9 +626,18 @@ func (r *GatewayResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.settings.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := r.client.DeleteGateway(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete gateway, got error: %s", err))
+		addClientError(&resp.Diagnostics, "delete gateway", err)
 		return
 	}
 }
@@ -400,8 +651,9 @@ func (r *GatewayResource) gatewayToModel(ctx context.Context, gateway *client.Ga
 	data.ID = types.StringValue(gateway.ID)
 	data.Name = types.StringValue(gateway.Name)
 	data.URL = types.StringValue(gateway.URL)
-	data.Description = types.StringValue(gateway.Description)
-	data.Transport = types.StringValue(gateway.Transport)
+	data.Description = stringOrPrior(gateway.Description, data.Description)
+	data.Transport = stringOrPrior(gateway.Transport, data.Transport)
+	data.SSEPath = stringOrPrior(gateway.SSEPath, data.SSEPath)
 	data.IsActive = types.BoolValue(gateway.IsActive)
 	data.CreatedAt = types.StringValue(gateway.CreatedAt)
 	data.UpdatedAt = types.StringValue(gateway.UpdatedAt)
@@ -416,20 +668,21 @@ func (r *GatewayResource) gatewayToModel(ctx context.Context, gateway *client.Ga
 	} else {
 		data.AuthValue = types.StringNull()
 	}
-
-	if gateway.Capabilities != nil {
-		capsJSON, err := json.Marshal(gateway.Capabilities)
-		if err != nil {
-			diagnostics.AddError("Capabilities Serialization Error", fmt.Sprintf("Unable to serialize capabilities: %s", err))
-			return
-		}
-		data.Capabilities = types.StringValue(string(capsJSON))
+	if gateway.LastError != "" {
+		data.LastError = types.StringValue(gateway.LastError)
 	} else {
-		data.Capabilities = types.StringNull()
+		data.LastError = types.StringNull()
 	}
 
+	capabilities, err := jsonObjectToModel(gateway.Capabilities, data.Capabilities)
+	if err != nil {
+		diagnostics.AddError("Capabilities Serialization Error", fmt.Sprintf("Unable to serialize capabilities: %s", err))
+		return
+	}
+	data.Capabilities = capabilities
+
 	if gateway.HealthCheck != nil {
-		data.HealthCheckURL = types.StringValue(gateway.HealthCheck.URL)
+		data.HealthCheckURL = healthCheckURLToModel(gateway.HealthCheck.URL, data.HealthCheckURL, gateway.URL)
 		data.HealthCheckInterval = types.Int64Value(int64(gateway.HealthCheck.Interval))
 		data.HealthCheckTimeout = types.Int64Value(int64(gateway.HealthCheck.Timeout))
 		data.HealthCheckRetries = types.Int64Value(int64(gateway.HealthCheck.Retries))
@@ -440,16 +693,12 @@ func (r *GatewayResource) gatewayToModel(ctx context.Context, gateway *client.Ga
 		data.HealthCheckRetries = types.Int64Null()
 	}
 
-	if gateway.Tags != nil {
-		tagsList, diags := types.ListValueFrom(ctx, types.StringType, gateway.Tags)
-		diagnostics.Append(diags...)
-		if diagnostics.HasError() {
-			return
-		}
-		data.Tags = tagsList
-	} else {
-		data.Tags = types.ListNull(types.StringType)
+	tagsList, diags := normalizedTagsToModel(ctx, gateway.Tags, data.Tags, r.settings.lowercaseTags)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
 	}
+	data.Tags = tagsList
 
 	if gateway.PassthroughHeaders != nil {
 		headersList, diags := types.ListValueFrom(ctx, types.StringType, gateway.PassthroughHeaders)
@@ -462,3 +711,28 @@ func (r *GatewayResource) gatewayToModel(ctx context.Context, gateway *client.Ga
 		data.PassthroughHeaders = types.ListNull(types.StringType)
 	}
 }
+
+// stringOrPrior maps an optional string the gateway may omit from responses.
+// A missing value keeps prior when it is known, so a configured value survives
+// a gateway that does not report the field. An empty value is null, so an
+// unset attribute stays unset, unless prior is the empty string itself.
+func stringOrPrior(value *string, prior types.String) types.String {
+	if value == nil {
+		if prior.IsUnknown() {
+			return types.StringNull()
+		}
+		return prior
+	}
+	if *value == "" && !prior.Equal(types.StringValue("")) {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
+
+// derefString returns the string p points to, or "" when p is nil.
+func derefString(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
@@ -4,16 +4,32 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"slices"
+	"sync"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/nkbud/terraform-provider-contextforge/internal/client"
 )
@@ -33,8 +49,8 @@ func TestAccGatewayResource(t *testing.T) {
 				ID:                 "gw-created",
 				Name:               req.Name,
 				URL:                req.URL,
-				Description:        req.Description,
-				Transport:          req.Transport,
+				Description:        stringPointer(req.Description),
+				Transport:          stringPointer(req.Transport),
 				IsActive:           req.IsActive,
 				Tags:               req.Tags,
 				PassthroughHeaders: []string{},
@@ -50,7 +66,7 @@ func TestAccGatewayResource(t *testing.T) {
 				ID:                 "gw-created",
 				Name:               "test-gw",
 				URL:                "https://example.com/mcp",
-				Transport:          "STREAMABLEHTTP",
+				Transport:          stringPointer("STREAMABLEHTTP"),
 				IsActive:           true,
 				Tags:               []string{"test"},
 				PassthroughHeaders: []string{},
@@ -97,6 +113,669 @@ func TestAccGatewayResource(t *testing.T) {
 	})
 }
 
+func TestAccGatewayResource_Update(t *testing.T) {
+	api := newTestAccMockAPI()
+	api.Collection("gateways", "gw", "auth_value")
+	mockServer := api.Server(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayResourceUpdateConfig(mockServer.URL, "first", `["test"]`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_gateway.test",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact("first"),
+					),
+					statecheck.ExpectKnownValue(
+						"contextforge_gateway.test",
+						tfjsonpath.New("auth_value"),
+						knownvalue.StringExact("secret-token"),
+					),
+				},
+			},
+			{
+				Config: testAccGatewayResourceUpdateConfig(mockServer.URL, "second", `["test", "updated"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("contextforge_gateway.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_gateway.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("gw-1"),
+					),
+					statecheck.ExpectKnownValue(
+						"contextforge_gateway.test",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact("second"),
+					),
+					statecheck.ExpectKnownValue(
+						"contextforge_gateway.test",
+						tfjsonpath.New("tags"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("test"),
+							knownvalue.StringExact("updated"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"contextforge_gateway.test",
+						tfjsonpath.New("auth_type"),
+						knownvalue.StringExact("bearer"),
+					),
+					// The gateway never returns auth_value, so it must survive
+					// the update and the refresh that follows.
+					statecheck.ExpectKnownValue(
+						"contextforge_gateway.test",
+						tfjsonpath.New("auth_value"),
+						knownvalue.StringExact("secret-token"),
+					),
+					statecheck.ExpectKnownValue(
+						"contextforge_gateway.test",
+						tfjsonpath.New("created_at"),
+						knownvalue.StringExact("2025-01-01T00:00:00Z"),
+					),
+				},
+				Check: func(*terraform.State) error {
+					puts := api.Requests(http.MethodPut, "/gateways/gw-1")
+					if len(puts) != 1 {
+						return fmt.Errorf("expected one PUT request, got %d", len(puts))
+					}
+					if got := puts[0].Body["auth_value"]; got != "secret-token" {
+						return fmt.Errorf("expected the update to resend auth_value, got %v", got)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccGatewayResource_OmittedDescriptionAndTransport(t *testing.T) {
+	// The gateway echoes neither description nor transport.
+	gateway := client.Gateway{
+		ID:       "gw-sparse",
+		Name:     "sparse-gw",
+		URL:      "https://example.com/mcp",
+		IsActive: true,
+	}
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/gateways" && r.Method == http.MethodPost:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			if err := json.NewEncoder(w).Encode(gateway); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/gateways/gw-sparse" && r.Method == http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(gateway); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/gateways/gw-sparse" && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockServer.Close()
+
+	config := `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+resource "contextforge_gateway" "test" {
+  name        = "sparse-gw"
+  url         = "https://example.com/mcp"
+  description = "Configured description"
+  transport   = "SSE"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_gateway.test",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact("Configured description"),
+					),
+					statecheck.ExpectKnownValue(
+						"contextforge_gateway.test",
+						tfjsonpath.New("transport"),
+						knownvalue.StringExact("SSE"),
+					),
+				},
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccGatewayResource_SSEPath(t *testing.T) {
+	var created client.Gateway
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/gateways" && r.Method == http.MethodPost:
+			var req client.GatewayCreate
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if req.SSEPath != "/events" {
+				t.Errorf("expected sse_path /events in request, got %q", req.SSEPath)
+			}
+			created = client.Gateway{
+				ID:        "gw-sse",
+				Name:      req.Name,
+				URL:       req.URL,
+				Transport: stringPointer(req.Transport),
+				SSEPath:   stringPointer(req.SSEPath),
+				IsActive:  req.IsActive,
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			if err := json.NewEncoder(w).Encode(created); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/gateways/gw-sse" && r.Method == http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(created); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/gateways/gw-sse" && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayResourceSSEConfig(mockServer.URL, "SSE"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_gateway.test",
+						tfjsonpath.New("sse_path"),
+						knownvalue.StringExact("/events"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccGatewayResource_Disabled(t *testing.T) {
+	var mu sync.Mutex
+	var current client.Gateway
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.URL.Path == "/gateways" && r.Method == http.MethodPost:
+			var req client.GatewayCreate
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if req.IsActive {
+				t.Errorf("expected is_active=false in create request")
+			}
+			// Mimic a gateway that ignores is_active on create.
+			current = client.Gateway{ID: "gw-disabled", Name: req.Name, URL: req.URL, IsActive: true}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			if err := json.NewEncoder(w).Encode(current); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/gateways/gw-disabled" && r.Method == http.MethodPut:
+			var req client.GatewayUpdate
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if req.IsActive != nil {
+				current.IsActive = *req.IsActive
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(current); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/gateways/gw-disabled" && r.Method == http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(current); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/gateways/gw-disabled" && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockServer.Close()
+
+	config := `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+resource "contextforge_gateway" "test" {
+  name      = "disabled-gw"
+  url       = "https://example.com/mcp"
+  is_active = false
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_gateway.test",
+						tfjsonpath.New("is_active"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			{
+				// Reading the gateway back must not flip is_active or plan a change.
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_gateway.test",
+						tfjsonpath.New("is_active"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccGatewayResource_SSEPathRequiresSSETransport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGatewayResourceSSEConfig("http://localhost:4444", "STREAMABLEHTTP"),
+				ExpectError: regexp.MustCompile(`sse_path can only be set when transport is "SSE"`),
+			},
+		},
+	})
+}
+
+func testAccGatewayResourceSSEConfig(endpoint, transport string) string {
+	return `
+provider "contextforge" {
+  endpoint     = "` + endpoint + `"
+  bearer_token = "test"
+}
+
+resource "contextforge_gateway" "test" {
+  name      = "sse-gw"
+  url       = "https://example.com/mcp"
+  transport = "` + transport + `"
+  sse_path  = "/events"
+}
+`
+}
+
+func TestGatewayResourceAuthTypeValidation(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &fwresource.SchemaResponse{}
+	(&GatewayResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	validators := schemaResp.Schema.Attributes["auth_type"].(schema.StringAttribute).Validators
+
+	cases := map[string]struct {
+		value   types.String
+		wantErr bool
+	}{
+		"basic":       {value: types.StringValue("basic")},
+		"bearer":      {value: types.StringValue("bearer")},
+		"authheaders": {value: types.StringValue("authheaders")},
+		"oauth":       {value: types.StringValue("oauth")},
+		"query_param": {value: types.StringValue("query_param")},
+		"none":        {value: types.StringValue("none")},
+		"null":        {value: types.StringNull()},
+		"unknown":     {value: types.StringUnknown()},
+		"typo":        {value: types.StringValue("bearrer"), wantErr: true},
+		"uppercase":   {value: types.StringValue("Bearer"), wantErr: true},
+		"empty":       {value: types.StringValue(""), wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("auth_type"), ConfigValue: tc.value}
+			resp := &validator.StringResponse{}
+			for _, v := range validators {
+				v.ValidateString(ctx, req, resp)
+			}
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Errorf("expected error %t, got %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestGatewayResourceHealthCheckRequiresURL(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &fwresource.SchemaResponse{}
+	(&GatewayResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	cases := map[string]struct {
+		url       types.String
+		value     types.Int64
+		wantError bool
+	}{
+		"with url":         {url: types.StringValue("https://example.com/health"), value: types.Int64Value(30)},
+		"unknown url":      {url: types.StringUnknown(), value: types.Int64Value(30)},
+		"unset field":      {url: types.StringNull(), value: types.Int64Null()},
+		"unknown field":    {url: types.StringNull(), value: types.Int64Unknown()},
+		"without url":      {url: types.StringNull(), value: types.Int64Value(30), wantError: true},
+		"zero without url": {url: types.StringNull(), value: types.Int64Value(0), wantError: true},
+	}
+
+	for _, attribute := range []string{"health_check_interval", "health_check_timeout", "health_check_retries"} {
+		validators := schemaResp.Schema.Attributes[attribute].(schema.Int64Attribute).Validators
+
+		for name, tc := range cases {
+			t.Run(attribute+"/"+name, func(t *testing.T) {
+				plan := tfsdk.Plan{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				}
+				diags := plan.SetAttribute(ctx, path.Root("health_check_url"), tc.url)
+				diags.Append(plan.SetAttribute(ctx, path.Root(attribute), tc.value)...)
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics building config: %v", diags)
+				}
+
+				req := validator.Int64Request{
+					Path:           path.Root(attribute),
+					PathExpression: path.MatchRoot(attribute),
+					ConfigValue:    tc.value,
+					Config:         tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw},
+				}
+				resp := &validator.Int64Response{}
+				for _, v := range validators {
+					v.ValidateInt64(ctx, req, resp)
+				}
+				if resp.Diagnostics.HasError() != tc.wantError {
+					t.Errorf("expected error=%t, got %v", tc.wantError, resp.Diagnostics)
+				}
+			})
+		}
+	}
+}
+
+func TestGatewayResourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &GatewayResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	cases := map[string]struct {
+		transport types.String
+		ssePath   types.String
+		wantError bool
+	}{
+		"sse with path":           {transport: types.StringValue("SSE"), ssePath: types.StringValue("/events")},
+		"unset transport":         {transport: types.StringNull(), ssePath: types.StringValue("/events")},
+		"unknown transport":       {transport: types.StringUnknown(), ssePath: types.StringValue("/events")},
+		"streamable without path": {transport: types.StringValue("STREAMABLEHTTP"), ssePath: types.StringNull()},
+		"streamable with path":    {transport: types.StringValue("STREAMABLEHTTP"), ssePath: types.StringValue("/events"), wantError: true},
+		"stdio with path":         {transport: types.StringValue("STDIO"), ssePath: types.StringValue("/events"), wantError: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			plan := tfsdk.Plan{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			diags := plan.SetAttribute(ctx, path.Root("transport"), tc.transport)
+			diags.Append(plan.SetAttribute(ctx, path.Root("sse_path"), tc.ssePath)...)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics building config: %v", diags)
+			}
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw},
+			}, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tc.wantError {
+				t.Errorf("expected error=%t, got diagnostics: %v", tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestGatewayResourceValidateConfig_Capabilities(t *testing.T) {
+	ctx := context.Background()
+	r := &GatewayResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	cases := map[string]struct {
+		transport    types.String
+		capabilities types.String
+		wantWarnings int
+	}{
+		"stdio with generic capabilities": {transport: types.StringValue("STDIO"), capabilities: types.StringValue(`{"tools": {"listChanged": true}}`)},
+		"stdio with sse":                  {transport: types.StringValue("STDIO"), capabilities: types.StringValue(`{"sse": {}, "tools": {}}`), wantWarnings: 1},
+		"stdio with http keys":            {transport: types.StringValue("STDIO"), capabilities: types.StringValue(`{"resumability": true, "sessions": true}`), wantWarnings: 2},
+		"sse with sse":                    {transport: types.StringValue("SSE"), capabilities: types.StringValue(`{"sse": {}, "sessions": true}`)},
+		"streamable with sse":             {transport: types.StringValue("STREAMABLEHTTP"), capabilities: types.StringValue(`{"sse": {}}`), wantWarnings: 1},
+		"streamable with resumability":    {transport: types.StringValue("STREAMABLEHTTP"), capabilities: types.StringValue(`{"resumability": true}`)},
+		"unset transport":                 {transport: types.StringNull(), capabilities: types.StringValue(`{"sse": {}}`)},
+		"unknown capabilities":            {transport: types.StringValue("STDIO"), capabilities: types.StringUnknown()},
+		"malformed capabilities":          {transport: types.StringValue("STDIO"), capabilities: types.StringValue(`{"sse"`)},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			plan := tfsdk.Plan{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			diags := plan.SetAttribute(ctx, path.Root("transport"), tc.transport)
+			diags.Append(plan.SetAttribute(ctx, path.Root("capabilities"), tc.capabilities)...)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics building config: %v", diags)
+			}
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw},
+			}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount(); got != tc.wantWarnings {
+				t.Errorf("expected %d warning(s), got %d: %v", tc.wantWarnings, got, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestIncompatibleCapabilities(t *testing.T) {
+	got := incompatibleCapabilities("STDIO", map[string]interface{}{
+		"tools":          map[string]interface{}{},
+		"streamableHttp": true,
+		"sse":            true,
+	})
+	want := []string{"sse", "streamableHttp"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestStringOrPrior(t *testing.T) {
+	cases := map[string]struct {
+		value *string
+		prior types.String
+		want  types.String
+	}{
+		"api value wins":          {value: stringPointer("SSE"), prior: types.StringValue("STREAMABLEHTTP"), want: types.StringValue("SSE")},
+		"missing keeps prior":     {value: nil, prior: types.StringValue("Configured"), want: types.StringValue("Configured")},
+		"missing with null prior": {value: nil, prior: types.StringNull(), want: types.StringNull()},
+		"missing with unknown":    {value: nil, prior: types.StringUnknown(), want: types.StringNull()},
+		"empty clears prior":      {value: stringPointer(""), prior: types.StringValue("Configured"), want: types.StringNull()},
+		"empty with null prior":   {value: stringPointer(""), prior: types.StringNull(), want: types.StringNull()},
+		"empty with unknown":      {value: stringPointer(""), prior: types.StringUnknown(), want: types.StringNull()},
+		"empty keeps empty prior": {value: stringPointer(""), prior: types.StringValue(""), want: types.StringValue("")},
+		"api value without prior": {value: stringPointer("desc"), prior: types.StringUnknown(), want: types.StringValue("desc")},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := stringOrPrior(tc.value, tc.prior); !got.Equal(tc.want) {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGatewayToModel_CapabilitiesStable(t *testing.T) {
+	ctx := context.Background()
+	r := &GatewayResource{}
+
+	// Decode from JSON so the maps are built the same way the client builds
+	// them; the two responses list the same keys in different orders.
+	decode := func(s string) map[string]interface{} {
+		var caps map[string]interface{}
+		if err := json.Unmarshal([]byte(s), &caps); err != nil {
+			t.Fatalf("failed to decode capabilities: %v", err)
+		}
+		return caps
+	}
+	first := decode(`{"tools": {"listChanged": true}, "resources": {"subscribe": true, "listChanged": false}, "prompts": {}, "logging": {}, "experimental": {"zeta": 1, "alpha": [3, 2, 1]}, "completions": {}, "x-vendor": "acme"}`)
+	second := decode(`{"x-vendor": "acme", "completions": {}, "experimental": {"alpha": [3, 2, 1], "zeta": 1}, "logging": {}, "prompts": {}, "resources": {"listChanged": false, "subscribe": true}, "tools": {"listChanged": true}}`)
+
+	var data GatewayResourceModel
+	data.Tags = types.ListNull(types.StringType)
+	data.Capabilities = types.StringUnknown()
+
+	var diags diag.Diagnostics
+	r.gatewayToModel(ctx, &client.Gateway{ID: "gw-1", Capabilities: first}, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	afterFirst := data.Capabilities
+
+	r.gatewayToModel(ctx, &client.Gateway{ID: "gw-1", Capabilities: second}, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !data.Capabilities.Equal(afterFirst) {
+		t.Errorf("capabilities changed between reads: %s then %s", afterFirst, data.Capabilities)
+	}
+
+	// A configured value in a different key order and layout is kept as written.
+	configured := `{
+  "tools": {"listChanged": true},
+  "resources": {"listChanged": false, "subscribe": true},
+  "prompts": {}, "logging": {}, "completions": {},
+  "experimental": {"alpha": [3, 2, 1], "zeta": 1},
+  "x-vendor": "acme"
+}`
+	data.Capabilities = types.StringValue(configured)
+	r.gatewayToModel(ctx, &client.Gateway{ID: "gw-1", Capabilities: second}, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Capabilities.ValueString() != configured {
+		t.Errorf("expected configured capabilities to be kept, got %s", data.Capabilities)
+	}
+}
+
+func TestGatewayToModel_LastError(t *testing.T) {
+	ctx := context.Background()
+	r := &GatewayResource{}
+
+	var data GatewayResourceModel
+	data.Tags = types.ListNull(types.StringType)
+	var diags diag.Diagnostics
+
+	r.gatewayToModel(ctx, &client.Gateway{ID: "gw-1", LastError: "connection refused"}, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.LastError.ValueString() != "connection refused" {
+		t.Errorf("expected last_error to be mapped, got %s", data.LastError)
+	}
+
+	r.gatewayToModel(ctx, &client.Gateway{ID: "gw-1"}, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !data.LastError.IsNull() {
+		t.Errorf("expected last_error to be null for a healthy gateway, got %s", data.LastError)
+	}
+}
+
+func testAccGatewayResourceUpdateConfig(endpoint, description, tags string) string {
+	return `
+provider "contextforge" {
+  endpoint     = "` + endpoint + `"
+  bearer_token = "test"
+}
+
+resource "contextforge_gateway" "test" {
+  name        = "test-gw"
+  url         = "https://example.com/mcp"
+  description = "` + description + `"
+  transport   = "STREAMABLEHTTP"
+  is_active   = true
+  tags        = ` + tags + `
+  auth_type   = "bearer"
+  auth_value  = "secret-token"
+}
+`
+}
+
 func testAccGatewayResourceConfig(endpoint string) string {
 	return `
 provider "contextforge" {
@@ -113,3 +792,237 @@ resource "contextforge_gateway" "test" {
 }
 `
 }
+
+func TestAccGatewayResource_CapabilitiesArray(t *testing.T) {
+	api := newTestAccMockAPI()
+	api.Collection("gateways", "gw", "auth_value")
+	mockServer := api.Server(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+resource "contextforge_gateway" "test" {
+  name         = "test-gw"
+  url          = "https://example.com/mcp"
+  transport    = "STREAMABLEHTTP"
+  capabilities = jsonencode([{ tools = {} }])
+}
+`,
+				ExpectError: regexp.MustCompile(`capabilities must be a JSON object(.|\n)*JSON array`),
+			},
+		},
+	})
+}
+
+func TestGatewayResourceCapabilityFlagsConflict(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &fwresource.SchemaResponse{}
+	(&GatewayResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	cases := map[string]struct {
+		capabilities types.String
+		value        types.Bool
+		wantError    bool
+	}{
+		"flag only":            {capabilities: types.StringNull(), value: types.BoolValue(true)},
+		"false flag only":      {capabilities: types.StringNull(), value: types.BoolValue(false)},
+		"capabilities only":    {capabilities: types.StringValue(`{"tools": {}}`), value: types.BoolNull()},
+		"unknown capabilities": {capabilities: types.StringUnknown(), value: types.BoolValue(true)},
+		"both":                 {capabilities: types.StringValue(`{"tools": {}}`), value: types.BoolValue(true), wantError: true},
+		"both with false flag": {capabilities: types.StringValue(`{}`), value: types.BoolValue(false), wantError: true},
+	}
+
+	for _, attribute := range []string{"capability_tools", "capability_prompts", "capability_resources"} {
+		validators := schemaResp.Schema.Attributes[attribute].(schema.BoolAttribute).Validators
+
+		for name, tc := range cases {
+			t.Run(attribute+"/"+name, func(t *testing.T) {
+				plan := tfsdk.Plan{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				}
+				diags := plan.SetAttribute(ctx, path.Root("capabilities"), tc.capabilities)
+				diags.Append(plan.SetAttribute(ctx, path.Root(attribute), tc.value)...)
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics building config: %v", diags)
+				}
+
+				req := validator.BoolRequest{
+					Path:           path.Root(attribute),
+					PathExpression: path.MatchRoot(attribute),
+					ConfigValue:    tc.value,
+					Config:         tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw},
+				}
+				resp := &validator.BoolResponse{}
+				for _, v := range validators {
+					v.ValidateBool(ctx, req, resp)
+				}
+				if resp.Diagnostics.HasError() != tc.wantError {
+					t.Errorf("expected error=%t, got %v", tc.wantError, resp.Diagnostics)
+				}
+			})
+		}
+	}
+}
+
+func TestGatewayResourceCreate_Capabilities(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		attributes map[string]attr.Value
+		want       string
+	}{
+		"flags": {
+			attributes: map[string]attr.Value{
+				"capabilities":         types.StringUnknown(),
+				"capability_tools":     types.BoolValue(true),
+				"capability_prompts":   types.BoolValue(false),
+				"capability_resources": types.BoolValue(true),
+			},
+			want: "map[resources:map[] tools:map[]]",
+		},
+		"single flag": {
+			attributes: map[string]attr.Value{
+				"capabilities":       types.StringUnknown(),
+				"capability_prompts": types.BoolValue(true),
+			},
+			want: "map[prompts:map[]]",
+		},
+		"raw JSON": {
+			attributes: map[string]attr.Value{
+				"capabilities": types.StringValue(`{"tools": {"listChanged": true}}`),
+			},
+			want: "map[tools:map[listChanged:true]]",
+		},
+		"neither": {
+			attributes: map[string]attr.Value{
+				"capabilities": types.StringUnknown(),
+			},
+			want: "<nil>",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := newTestAccMockAPI()
+			api.Collection("gateways", "gw", "auth_value")
+			server := api.Server(t)
+
+			r := &GatewayResource{client: client.NewClient(server.URL, "test")}
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+			empty := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: empty}
+			diags := plan.SetAttribute(ctx, path.Root("name"), "test-gw")
+			diags.Append(plan.SetAttribute(ctx, path.Root("url"), "https://gw.example.com/mcp")...)
+			for attribute, value := range tc.attributes {
+				diags.Append(plan.SetAttribute(ctx, path.Root(attribute), value)...)
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics building plan: %v", diags)
+			}
+
+			resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: empty}}
+			r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			posts := api.Requests(http.MethodPost, "/gateways")
+			if len(posts) != 1 {
+				t.Fatalf("expected 1 create request, got %d", len(posts))
+			}
+			if got := fmt.Sprint(posts[0].Body["capabilities"]); got != tc.want {
+				t.Errorf("expected capabilities %s to be sent, got %s", tc.want, got)
+			}
+
+			var state GatewayResourceModel
+			if diags := resp.State.Get(ctx, &state); diags.HasError() {
+				t.Fatalf("unexpected diagnostics reading state: %v", diags)
+			}
+			for attribute, value := range tc.attributes {
+				var got attr.Value
+				if diags := resp.State.GetAttribute(ctx, path.Root(attribute), &got); diags.HasError() {
+					t.Fatalf("unexpected diagnostics reading %s: %v", attribute, diags)
+				}
+				if _, flag := value.(types.Bool); flag && !got.Equal(value) {
+					t.Errorf("expected %s to stay %s, got %s", attribute, value, got)
+				}
+			}
+			if state.Capabilities.IsUnknown() {
+				t.Errorf("expected capabilities to be known after create")
+			}
+		})
+	}
+}
+
+func TestGatewayResourceUpdate_CapabilityFlagsCleared(t *testing.T) {
+	ctx := context.Background()
+
+	api := newTestAccMockAPI()
+	api.Collection("gateways", "gw", "auth_value")
+	server := api.Server(t)
+
+	r := &GatewayResource{client: client.NewClient(server.URL, "test")}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	empty := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: empty}
+	diags := plan.SetAttribute(ctx, path.Root("name"), "test-gw")
+	diags.Append(plan.SetAttribute(ctx, path.Root("url"), "https://gw.example.com/mcp")...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("capabilities"), types.StringUnknown())...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("capability_tools"), true)...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("capability_prompts"), true)...)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics building plan: %v", diags)
+	}
+
+	createResp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: empty}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error creating gateway: %v", createResp.Diagnostics)
+	}
+
+	updatePlan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: createResp.State.Raw.Copy()}
+	diags = updatePlan.SetAttribute(ctx, path.Root("capabilities"), types.StringUnknown())
+	diags.Append(updatePlan.SetAttribute(ctx, path.Root("capability_tools"), false)...)
+	diags.Append(updatePlan.SetAttribute(ctx, path.Root("capability_prompts"), false)...)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics building update plan: %v", diags)
+	}
+
+	updateResp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: empty}}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: updatePlan, State: createResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error updating gateway: %v", updateResp.Diagnostics)
+	}
+
+	puts := api.Requests(http.MethodPut, "/gateways/gw-1")
+	if len(puts) != 1 {
+		t.Fatalf("expected 1 update request, got %d", len(puts))
+	}
+	capabilities, ok := puts[0].Body["capabilities"]
+	if !ok {
+		t.Fatal("expected capabilities to be sent when every flag is false")
+	}
+	if got := fmt.Sprint(capabilities); got != "map[]" {
+		t.Errorf("expected empty capabilities to be sent, got %s", got)
+	}
+
+	var state GatewayResourceModel
+	if diags := updateResp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics reading state: %v", diags)
+	}
+	if state.Capabilities.ValueString() != "{}" {
+		t.Errorf("expected the cleared capabilities to be read back, got %s", state.Capabilities)
+	}
+}
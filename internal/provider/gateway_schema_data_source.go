@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+var _ datasource.DataSource = &GatewaySchemaDataSource{}
+
+func NewGatewaySchemaDataSource() datasource.DataSource {
+	return &GatewaySchemaDataSource{}
+}
+
+// GatewaySchemaDataSource reads the OpenAPI document the MCP Gateway
+// publishes for its own API.
+type GatewaySchemaDataSource struct {
+	client *client.Client
+}
+
+// GatewaySchemaDataSourceModel describes the data source data model.
+type GatewaySchemaDataSourceModel struct {
+	SchemaJSON     types.String `tfsdk:"schema_json"`
+	Version        types.String `tfsdk:"version"`
+	OpenAPIVersion types.String `tfsdk:"openapi_version"`
+	ID             types.String `tfsdk:"id"`
+}
+
+func (d *GatewaySchemaDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway_schema"
+}
+
+func (d *GatewaySchemaDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the OpenAPI document the ContextForge MCP Gateway publishes at `/openapi.json`, e.g. to generate documentation for the gateway API.",
+		Attributes: map[string]schema.Attribute{
+			"schema_json": schema.StringAttribute{
+				MarkdownDescription: "The OpenAPI document as a JSON-encoded string, exactly as returned by the gateway.",
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of the gateway API the document describes, from `info.version`. Null when the document does not set it.",
+				Computed:            true,
+			},
+			"openapi_version": schema.StringAttribute{
+				MarkdownDescription: "OpenAPI specification version the document follows, e.g. `3.1.0`. Null when the document does not set it.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Placeholder identifier.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GatewaySchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *GatewaySchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GatewaySchemaDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	openAPISchema, err := d.client.GetOpenAPISchema(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read gateway OpenAPI schema", err)
+		return
+	}
+
+	data.SchemaJSON = types.StringValue(string(openAPISchema.Raw))
+
+	if openAPISchema.Version != "" {
+		data.Version = types.StringValue(openAPISchema.Version)
+	} else {
+		data.Version = types.StringNull()
+	}
+
+	if openAPISchema.OpenAPIVersion != "" {
+		data.OpenAPIVersion = types.StringValue(openAPISchema.OpenAPIVersion)
+	} else {
+		data.OpenAPIVersion = types.StringNull()
+	}
+
+	data.ID = types.StringValue("gateway_schema")
+
+	tflog.Trace(ctx, "read gateway_schema data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccGatewaySchemaDataSource(t *testing.T) {
+	const openAPIDoc = `{"openapi":"3.1.0","info":{"title":"MCP Gateway","version":"0.9.0"},"paths":{"/health":{"get":{}}}}`
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/openapi.json" || r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(openAPIDoc)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+data "contextforge_gateway_schema" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.contextforge_gateway_schema.test",
+						tfjsonpath.New("schema_json"),
+						knownvalue.StringExact(openAPIDoc),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_gateway_schema.test",
+						tfjsonpath.New("version"),
+						knownvalue.StringExact("0.9.0"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_gateway_schema.test",
+						tfjsonpath.New("openapi_version"),
+						knownvalue.StringExact("3.1.0"),
+					),
+				},
+			},
+		},
+	})
+}
@@ -8,8 +8,12 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nkbud/terraform-provider-contextforge/internal/client"
@@ -29,6 +33,9 @@ type GatewaysDataSource struct {
 // GatewaysDataSourceModel describes the data source data model.
 type GatewaysDataSourceModel struct {
 	IncludeInactive types.Bool         `tfsdk:"include_inactive"`
+	OnlyInactive    types.Bool         `tfsdk:"only_inactive"`
+	Active          types.Bool         `tfsdk:"active"`
+	Tags            types.List         `tfsdk:"tags"`
 	Gateways        []GatewayItemModel `tfsdk:"gateways"`
 	ID              types.String       `tfsdk:"id"`
 }
@@ -51,6 +58,7 @@ type GatewayItemModel struct {
 	AuthType            types.String `tfsdk:"auth_type"`
 	CreatedAt           types.String `tfsdk:"created_at"`
 	UpdatedAt           types.String `tfsdk:"updated_at"`
+	LastError           types.String `tfsdk:"last_error"`
 }
 
 func (d *GatewaysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -65,6 +73,22 @@ func (d *GatewaysDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				MarkdownDescription: "Whether to include inactive gateways in the list. Defaults to `false`.",
 				Optional:            true,
 			},
+			"only_inactive": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, only inactive gateways are returned. Shorthand for `active = false`.",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("active")),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "When set, only gateways whose `is_active` matches this value are returned. Setting it to `false` implies `include_inactive`.",
+				Optional:            true,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "When set, only gateways carrying at least one of these tags are returned. Filtering happens on the gateway where supported.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
 			"gateways": schema.ListNestedAttribute{
 				MarkdownDescription: "List of gateways.",
 				Computed:            true,
@@ -136,6 +160,10 @@ func (d *GatewaysDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 							MarkdownDescription: "Timestamp when the gateway was last updated.",
 							Computed:            true,
 						},
+						"last_error": schema.StringAttribute{
+							MarkdownDescription: "Most recent error the gateway recorded while connecting to the upstream server. Null when the gateway is healthy.",
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -152,16 +180,16 @@ func (d *GatewaysDataSource) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = apiClient
+	d.client = data.client
 }
 
 func (d *GatewaysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -172,87 +200,110 @@ func (d *GatewaysDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	includeInactive := false
-	if !data.IncludeInactive.IsNull() && !data.IncludeInactive.IsUnknown() {
-		includeInactive = data.IncludeInactive.ValueBool()
+	filter := newListFilter(data.IncludeInactive, data.OnlyInactive, data.Active)
+
+	var tags []string
+	if !data.Tags.IsNull() {
+		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	gateways, err := d.client.ListGateways(ctx, includeInactive)
+	gateways, err := d.client.ListGateways(ctx, filter.includeInactive, tags)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list gateways, got error: %s", err))
+		addClientError(&resp.Diagnostics, "list gateways", err)
 		return
 	}
+	gateways = filterByActive(gateways, filter, func(g client.Gateway) bool { return g.IsActive })
 
-	data.Gateways = make([]GatewayItemModel, len(gateways))
-	for i, g := range gateways {
-		item := GatewayItemModel{
-			ID:          types.StringValue(g.ID),
-			Name:        types.StringValue(g.Name),
-			URL:         types.StringValue(g.URL),
-			Description: types.StringValue(g.Description),
-			Transport:   types.StringValue(g.Transport),
-			IsActive:    types.BoolValue(g.IsActive),
-			CreatedAt:   types.StringValue(g.CreatedAt),
-			UpdatedAt:   types.StringValue(g.UpdatedAt),
-		}
+	data.Gateways = gatewayItemsFromAPI(ctx, gateways, &resp.Diagnostics)
 
-		if g.AuthType != "" {
-			item.AuthType = types.StringValue(g.AuthType)
-		} else {
-			item.AuthType = types.StringNull()
-		}
+	data.ID = types.StringValue("gateways")
 
-		if g.Capabilities != nil {
-			capsJSON, err := json.Marshal(g.Capabilities)
-			if err != nil {
-				resp.Diagnostics.AddError("Capabilities Serialization Error", fmt.Sprintf("Unable to serialize capabilities: %s", err))
-				return
-			}
-			item.Capabilities = types.StringValue(string(capsJSON))
-		} else {
-			item.Capabilities = types.StringNull()
-		}
+	tflog.Trace(ctx, "read gateways data source")
 
-		if g.HealthCheck != nil {
-			item.HealthCheckURL = types.StringValue(g.HealthCheck.URL)
-			item.HealthCheckInterval = types.Int64Value(int64(g.HealthCheck.Interval))
-			item.HealthCheckTimeout = types.Int64Value(int64(g.HealthCheck.Timeout))
-			item.HealthCheckRetries = types.Int64Value(int64(g.HealthCheck.Retries))
-		} else {
-			item.HealthCheckURL = types.StringNull()
-			item.HealthCheckInterval = types.Int64Null()
-			item.HealthCheckTimeout = types.Int64Null()
-			item.HealthCheckRetries = types.Int64Null()
-		}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
 
-		if g.Tags != nil {
-			tags, diags := types.ListValueFrom(ctx, types.StringType, g.Tags)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			item.Tags = tags
-		} else {
-			item.Tags = types.ListNull(types.StringType)
+// gatewayItemsFromAPI maps the listed gateways to item models. Elements that fail
+// to map are omitted with a warning so the remaining results are still returned.
+func gatewayItemsFromAPI(ctx context.Context, gateways []client.Gateway, diagnostics *diag.Diagnostics) []GatewayItemModel {
+	items := make([]GatewayItemModel, 0, len(gateways))
+	for _, g := range gateways {
+		item, diags := gatewayItemFromAPI(ctx, g)
+		if diags.HasError() {
+			addSkippedItemWarning(diagnostics, "gateway", g.ID, diags)
+			continue
 		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// gatewayItemFromAPI maps a single gateway to its item model.
+func gatewayItemFromAPI(ctx context.Context, g client.Gateway) (GatewayItemModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	item := GatewayItemModel{
+		ID:          types.StringValue(g.ID),
+		Name:        types.StringValue(g.Name),
+		URL:         types.StringValue(g.URL),
+		Description: types.StringValue(derefString(g.Description)),
+		Transport:   types.StringValue(derefString(g.Transport)),
+		IsActive:    types.BoolValue(g.IsActive),
+		CreatedAt:   types.StringValue(g.CreatedAt),
+		UpdatedAt:   types.StringValue(g.UpdatedAt),
+	}
+
+	if g.AuthType != "" {
+		item.AuthType = types.StringValue(g.AuthType)
+	} else {
+		item.AuthType = types.StringNull()
+	}
 
-		if g.PassthroughHeaders != nil {
-			headers, diags := types.ListValueFrom(ctx, types.StringType, g.PassthroughHeaders)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			item.PassthroughHeaders = headers
-		} else {
-			item.PassthroughHeaders = types.ListNull(types.StringType)
+	if g.LastError != "" {
+		item.LastError = types.StringValue(g.LastError)
+	} else {
+		item.LastError = types.StringNull()
+	}
+
+	if g.Capabilities != nil {
+		capsJSON, err := json.Marshal(g.Capabilities)
+		if err != nil {
+			diags.AddError("Capabilities Serialization Error", fmt.Sprintf("Unable to serialize capabilities: %s", err))
+			return item, diags
 		}
+		item.Capabilities = types.StringValue(string(capsJSON))
+	} else {
+		item.Capabilities = types.StringNull()
+	}
 
-		data.Gateways[i] = item
+	if g.HealthCheck != nil {
+		item.HealthCheckURL = types.StringValue(g.HealthCheck.URL)
+		item.HealthCheckInterval = types.Int64Value(int64(g.HealthCheck.Interval))
+		item.HealthCheckTimeout = types.Int64Value(int64(g.HealthCheck.Timeout))
+		item.HealthCheckRetries = types.Int64Value(int64(g.HealthCheck.Retries))
+	} else {
+		item.HealthCheckURL = types.StringNull()
+		item.HealthCheckInterval = types.Int64Null()
+		item.HealthCheckTimeout = types.Int64Null()
+		item.HealthCheckRetries = types.Int64Null()
 	}
 
-	data.ID = types.StringValue("gateways")
+	tags, listDiags := stringListValue(ctx, g.Tags)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return item, diags
+	}
+	item.Tags = tags
 
-	tflog.Trace(ctx, "read gateways data source")
+	headers, listDiags := stringListValue(ctx, g.PassthroughHeaders)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return item, diags
+	}
+	item.PassthroughHeaders = headers
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return item, diags
 }
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.String = healthCheckURLValidator{}
+
+// healthCheckURLValidator accepts an absolute http or https URL, or a path
+// starting with a slash that resolveHealthCheckURL resolves against the
+// gateway URL.
+type healthCheckURLValidator struct{}
+
+func (v healthCheckURLValidator) Description(ctx context.Context) string {
+	return "value must be an absolute http or https URL, or a path starting with a slash"
+}
+
+func (v healthCheckURLValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an absolute `http` or `https` URL, or a path starting with `/`"
+}
+
+func (v healthCheckURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	u, err := url.Parse(value)
+	switch {
+	case err != nil:
+	case u.IsAbs():
+		if (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" {
+			return
+		}
+	case strings.HasPrefix(value, "/"):
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Health Check URL",
+		fmt.Sprintf("%q is not a valid health check URL. Expected an absolute http or https URL, e.g. \"https://gateway.example.com:8443/health\", "+
+			"or a path resolved against the gateway url, e.g. \"/health\".", value),
+	)
+}
+
+// resolveHealthCheckURL returns the health check URL the gateway is sent for
+// healthCheckURL. A relative URL is resolved against gatewayURL, and an
+// absolute one on the same scheme and host as gatewayURL but without a port
+// gets the gateway's port, since some backends reject the mismatch. Anything
+// else, including values that cannot be parsed, is returned unchanged.
+func resolveHealthCheckURL(healthCheckURL, gatewayURL string) string {
+	base, err := url.Parse(gatewayURL)
+	if err != nil || !base.IsAbs() {
+		return healthCheckURL
+	}
+	ref, err := url.Parse(healthCheckURL)
+	if err != nil {
+		return healthCheckURL
+	}
+
+	if !ref.IsAbs() {
+		return base.ResolveReference(ref).String()
+	}
+	if ref.Scheme == base.Scheme && ref.Port() == "" && base.Port() != "" && strings.EqualFold(ref.Hostname(), base.Hostname()) {
+		ref.Host = base.Host
+		return ref.String()
+	}
+	return healthCheckURL
+}
+
+// healthCheckURLToModel maps the health check URL the gateway reports to the
+// health_check_url attribute. When the prior value resolves to it, the prior
+// spelling is kept, e.g. "/health", so a relative or port-less URL does not
+// show a diff after apply.
+func healthCheckURLToModel(healthCheckURL string, prior types.String, gatewayURL string) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() && resolveHealthCheckURL(prior.ValueString(), gatewayURL) == healthCheckURL {
+		return prior
+	}
+	return types.StringValue(healthCheckURL)
+}
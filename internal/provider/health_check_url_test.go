@@ -0,0 +1,217 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+func TestHealthCheckURLValidator(t *testing.T) {
+	cases := map[string]struct {
+		value   types.String
+		wantErr bool
+	}{
+		"absolute":          {value: types.StringValue("https://gw.example.com:8443/health")},
+		"absolute no port":  {value: types.StringValue("http://gw.example.com/health")},
+		"path":              {value: types.StringValue("/health")},
+		"path with query":   {value: types.StringValue("/health?deep=true")},
+		"null":              {value: types.StringNull()},
+		"unknown":           {value: types.StringUnknown()},
+		"relative no slash": {value: types.StringValue("health"), wantErr: true},
+		"other scheme":      {value: types.StringValue("ftp://gw.example.com/health"), wantErr: true},
+		"missing host":      {value: types.StringValue("https:///health"), wantErr: true},
+		"empty":             {value: types.StringValue(""), wantErr: true},
+		"malformed":         {value: types.StringValue("https://gw.example.com:port/health"), wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			healthCheckURLValidator{}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("health_check_url"),
+				ConfigValue: tc.value,
+			}, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Errorf("expected error=%t, got %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestResolveHealthCheckURL(t *testing.T) {
+	cases := map[string]struct {
+		healthCheckURL string
+		gatewayURL     string
+		want           string
+	}{
+		"relative path":            {healthCheckURL: "/health", gatewayURL: "https://gw.example.com:8443/mcp", want: "https://gw.example.com:8443/health"},
+		"relative with query":      {healthCheckURL: "/health?deep=1", gatewayURL: "http://10.0.0.7:9000/sse", want: "http://10.0.0.7:9000/health?deep=1"},
+		"relative without port":    {healthCheckURL: "/health", gatewayURL: "https://gw.example.com/mcp", want: "https://gw.example.com/health"},
+		"absolute gets port":       {healthCheckURL: "https://gw.example.com/health", gatewayURL: "https://gw.example.com:8443/mcp", want: "https://gw.example.com:8443/health"},
+		"host case ignored":        {healthCheckURL: "https://GW.example.com/health", gatewayURL: "https://gw.example.com:8443/mcp", want: "https://gw.example.com:8443/health"},
+		"absolute with port kept":  {healthCheckURL: "https://gw.example.com:9443/health", gatewayURL: "https://gw.example.com:8443/mcp", want: "https://gw.example.com:9443/health"},
+		"other host kept":          {healthCheckURL: "https://status.example.com/health", gatewayURL: "https://gw.example.com:8443/mcp", want: "https://status.example.com/health"},
+		"other scheme kept":        {healthCheckURL: "http://gw.example.com/health", gatewayURL: "https://gw.example.com:8443/mcp", want: "http://gw.example.com/health"},
+		"gateway without port":     {healthCheckURL: "https://gw.example.com/health", gatewayURL: "https://gw.example.com/mcp", want: "https://gw.example.com/health"},
+		"unparseable gateway URL":  {healthCheckURL: "/health", gatewayURL: "://gw", want: "/health"},
+		"relative gateway URL":     {healthCheckURL: "/health", gatewayURL: "gw.example.com/mcp", want: "/health"},
+		"unparseable health check": {healthCheckURL: "https://gw.example.com:port/health", gatewayURL: "https://gw.example.com:8443/mcp", want: "https://gw.example.com:port/health"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := resolveHealthCheckURL(tc.healthCheckURL, tc.gatewayURL); got != tc.want {
+				t.Errorf("resolveHealthCheckURL(%q, %q) = %q, want %q", tc.healthCheckURL, tc.gatewayURL, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHealthCheckURLToModel(t *testing.T) {
+	const gatewayURL = "https://gw.example.com:8443/mcp"
+
+	cases := map[string]struct {
+		apiURL string
+		prior  types.String
+		want   types.String
+	}{
+		"keeps relative prior":  {apiURL: "https://gw.example.com:8443/health", prior: types.StringValue("/health"), want: types.StringValue("/health")},
+		"keeps port-less prior": {apiURL: "https://gw.example.com:8443/health", prior: types.StringValue("https://gw.example.com/health"), want: types.StringValue("https://gw.example.com/health")},
+		"reports drift":         {apiURL: "https://gw.example.com:8443/ready", prior: types.StringValue("/health"), want: types.StringValue("https://gw.example.com:8443/ready")},
+		"null prior":            {apiURL: "https://gw.example.com:8443/health", prior: types.StringNull(), want: types.StringValue("https://gw.example.com:8443/health")},
+		"unknown prior":         {apiURL: "https://gw.example.com:8443/health", prior: types.StringUnknown(), want: types.StringValue("https://gw.example.com:8443/health")},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := healthCheckURLToModel(tc.apiURL, tc.prior, gatewayURL); !got.Equal(tc.want) {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGatewayResourceCreate_ResolvesHealthCheckURL(t *testing.T) {
+	ctx := context.Background()
+
+	for name, healthCheckURL := range map[string]string{
+		"relative": "/health",
+		"absolute": "https://gw.example.com/health",
+	} {
+		t.Run(name, func(t *testing.T) {
+			api := newTestAccMockAPI()
+			api.Collection("gateways", "gw", "auth_value")
+			server := api.Server(t)
+
+			r := &GatewayResource{client: client.NewClient(server.URL, "test")}
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+			empty := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: empty}
+			for attr, value := range map[string]string{
+				"name":             "test-gw",
+				"url":              "https://gw.example.com:8443/mcp",
+				"health_check_url": healthCheckURL,
+			} {
+				if diags := plan.SetAttribute(ctx, path.Root(attr), value); diags.HasError() {
+					t.Fatalf("unexpected diagnostics building plan: %v", diags)
+				}
+			}
+
+			resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: empty}}
+			r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			posts := api.Requests(http.MethodPost, "/gateways")
+			if len(posts) != 1 {
+				t.Fatalf("expected 1 create request, got %d", len(posts))
+			}
+			if got := fmt.Sprint(posts[0].Body["health_check"]); got != "map[url:https://gw.example.com:8443/health]" {
+				t.Errorf("expected the resolved health check URL to be sent, got %s", got)
+			}
+
+			var state GatewayResourceModel
+			if diags := resp.State.Get(ctx, &state); diags.HasError() {
+				t.Fatalf("unexpected diagnostics reading state: %v", diags)
+			}
+			if state.HealthCheckURL.ValueString() != healthCheckURL {
+				t.Errorf("expected state to keep the configured %q, got %s", healthCheckURL, state.HealthCheckURL)
+			}
+		})
+	}
+}
+
+func TestAccGatewayResource_RelativeHealthCheckURL(t *testing.T) {
+	api := newTestAccMockAPI()
+	api.Collection("gateways", "gw", "auth_value")
+	mockServer := api.Server(t)
+
+	config := fmt.Sprintf(`
+provider "contextforge" {
+  endpoint     = %q
+  bearer_token = "test"
+}
+
+resource "contextforge_gateway" "test" {
+  name             = "test-gw"
+  url              = "https://gw.example.com:8443/mcp"
+  transport        = "STREAMABLEHTTP"
+  health_check_url = "/health"
+}
+`, mockServer.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_gateway.test",
+						tfjsonpath.New("health_check_url"),
+						knownvalue.StringExact("/health"),
+					),
+				},
+				Check: func(*terraform.State) error {
+					posts := api.Requests(http.MethodPost, "/gateways")
+					if len(posts) != 1 {
+						return fmt.Errorf("expected 1 create request, got %d", len(posts))
+					}
+					if got := fmt.Sprint(posts[0].Body["health_check"]); got != "map[url:https://gw.example.com:8443/health]" {
+						return fmt.Errorf("expected the resolved health check URL to be sent, got %s", got)
+					}
+					return nil
+				},
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
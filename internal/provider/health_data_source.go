@@ -27,8 +27,12 @@ type HealthDataSource struct {
 
 // HealthDataSourceModel describes the data source data model.
 type HealthDataSourceModel struct {
-	Status types.String `tfsdk:"status"`
-	ID     types.String `tfsdk:"id"`
+	Status        types.String  `tfsdk:"status"`
+	Version       types.String  `tfsdk:"version"`
+	UptimeSeconds types.Int64   `tfsdk:"uptime_seconds"`
+	Components    types.Map     `tfsdk:"components"`
+	LatencyMS     types.Float64 `tfsdk:"latency_ms"`
+	ID            types.String  `tfsdk:"id"`
 }
 
 func (d *HealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -43,6 +47,23 @@ func (d *HealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				MarkdownDescription: "Health status of the MCP Gateway.",
 				Computed:            true,
 			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version reported by the MCP Gateway, if any.",
+				Computed:            true,
+			},
+			"uptime_seconds": schema.Int64Attribute{
+				MarkdownDescription: "Gateway uptime in seconds, if reported.",
+				Computed:            true,
+			},
+			"components": schema.MapAttribute{
+				MarkdownDescription: "Status of individual component checks, keyed by component name, if reported.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"latency_ms": schema.Float64Attribute{
+				MarkdownDescription: "Round-trip latency of a separate, uncached request to the health endpoint, in milliseconds. Null, with a warning, when that request fails after the health status was read.",
+				Computed:            true,
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Placeholder identifier.",
 				Computed:            true,
@@ -56,16 +77,16 @@ func (d *HealthDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = apiClient
+	d.client = data.client
 }
 
 func (d *HealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -78,11 +99,46 @@ func (d *HealthDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	health, err := d.client.GetHealth(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read health, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read health", err)
 		return
 	}
 
 	data.Status = types.StringValue(health.Status)
+
+	if health.Version != "" {
+		data.Version = types.StringValue(health.Version)
+	} else {
+		data.Version = types.StringNull()
+	}
+
+	if health.UptimeSeconds != nil {
+		data.UptimeSeconds = types.Int64Value(int64(*health.UptimeSeconds))
+	} else {
+		data.UptimeSeconds = types.Int64Null()
+	}
+
+	if health.Components != nil {
+		components, diags := types.MapValueFrom(ctx, types.StringType, health.Components)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Components = components
+	} else {
+		data.Components = types.MapNull(types.StringType)
+	}
+
+	latency, err := d.client.Ping(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Gateway Latency Not Measured",
+			fmt.Sprintf("The health status was read, but timing a second request to the health endpoint failed: %s", err),
+		)
+		data.LatencyMS = types.Float64Null()
+	} else {
+		data.LatencyMS = types.Float64Value(float64(latency.Microseconds()) / 1000)
+	}
+
 	data.ID = types.StringValue("health")
 
 	tflog.Trace(ctx, "read health data source")
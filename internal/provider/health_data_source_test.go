@@ -39,6 +39,117 @@ func TestAccHealthDataSource(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: testAccHealthDataSourceConfig(mockServer.URL),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.contextforge_health.test",
+						tfjsonpath.New("status"),
+						knownvalue.StringExact("ok"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_health.test",
+						tfjsonpath.New("version"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_health.test",
+						tfjsonpath.New("components"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_health.test",
+						tfjsonpath.New("latency_ms"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccHealthDataSource_Detailed(t *testing.T) {
+	uptime := 7200.0
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(client.HealthResponse{
+				Status:        "healthy",
+				Version:       "0.9.0",
+				UptimeSeconds: &uptime,
+				Components: map[string]client.HealthComponentStatus{
+					"database": "ok",
+					"redis":    "ok",
+				},
+			}); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHealthDataSourceConfig(mockServer.URL),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.contextforge_health.test",
+						tfjsonpath.New("version"),
+						knownvalue.StringExact("0.9.0"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_health.test",
+						tfjsonpath.New("uptime_seconds"),
+						knownvalue.Int64Exact(7200),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_health.test",
+						tfjsonpath.New("components"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"database": knownvalue.StringExact("ok"),
+							"redis":    knownvalue.StringExact("ok"),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccHealthDataSource_CustomHealthPath(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(client.HealthResponse{Status: "ok"}); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint        = "` + mockServer.URL + `"
+  health_path     = "/healthz"
+  require_healthy = true
+}
+
+data "contextforge_health" "test" {}
+`,
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(
 						"data.contextforge_health.test",
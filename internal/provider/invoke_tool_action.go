@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+var _ action.Action = &InvokeToolAction{}
+var _ action.ActionWithConfigure = &InvokeToolAction{}
+
+func NewInvokeToolAction() action.Action {
+	return &InvokeToolAction{}
+}
+
+// InvokeToolAction calls a registered tool once to verify that it works. It
+// reports the outcome and persists nothing.
+type InvokeToolAction struct {
+	client   *client.Client
+	settings providerSettings
+}
+
+// InvokeToolActionModel describes the action data model.
+type InvokeToolActionModel struct {
+	ToolID    types.String `tfsdk:"tool_id"`
+	Arguments types.String `tfsdk:"arguments"`
+}
+
+func (a *InvokeToolAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invoke_tool"
+}
+
+func (a *InvokeToolAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Invokes a tool on the ContextForge MCP Gateway to smoke-test it. The result is reported as progress output and a tool error fails the action; nothing is stored in state.",
+		Attributes: map[string]schema.Attribute{
+			"tool_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the tool to invoke.",
+				Required:            true,
+			},
+			"arguments": schema.StringAttribute{
+				MarkdownDescription: "Tool arguments as a JSON-encoded object. Defaults to no arguments.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *InvokeToolAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = data.client
+	a.settings = data.settings
+}
+
+func (a *InvokeToolAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if readOnlyBlocked(a.settings, "invoke tool", &resp.Diagnostics) {
+		return
+	}
+
+	var data InvokeToolActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var args map[string]interface{}
+	if !data.Arguments.IsNull() && data.Arguments.ValueString() != "" {
+		if err := json.Unmarshal([]byte(data.Arguments.ValueString()), &args); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("arguments"),
+				"Invalid Arguments",
+				fmt.Sprintf("Unable to parse arguments as a JSON object: %s", err),
+			)
+			return
+		}
+	}
+
+	toolID := data.ToolID.ValueString()
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("invoking tool %s", toolID),
+	})
+
+	result, err := a.client.InvokeTool(ctx, toolID, args)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "invoke tool", err)
+		return
+	}
+	if result == nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Tool with ID %s not found", toolID))
+		return
+	}
+
+	if result.IsError {
+		resp.Diagnostics.AddError(
+			"Tool Invocation Failed",
+			fmt.Sprintf("Tool %s reported an error: %s", toolID, result.Content),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("tool %s returned: %s", toolID, result.Content),
+	})
+
+	tflog.Trace(ctx, "invoked invoke_tool action")
+}
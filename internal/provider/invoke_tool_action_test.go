@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+// testAccEchoToolServer mocks a tool that echoes its "message" argument back,
+// and reports a tool error when the argument is missing.
+func testAccEchoToolServer(t *testing.T, echoed *[]string, mu *sync.Mutex) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tools/echo/invoke" || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var req client.ToolInvokeRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		message, ok := req.Arguments["message"].(string)
+		if !ok {
+			w.Header().Set("Content-Type", "application/json")
+			if _, err := w.Write([]byte(`{"content": [{"type": "text", "text": "missing message"}], "isError": true}`)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+			return
+		}
+		mu.Lock()
+		*echoed = append(*echoed, message)
+		mu.Unlock()
+		content, err := json.Marshal([]map[string]string{{"type": "text", "text": message}})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(client.ToolResult{Content: content}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+}
+
+func testAccInvokeToolActionConfig(endpoint, arguments string) string {
+	return `
+provider "contextforge" {
+  endpoint     = "` + endpoint + `"
+  bearer_token = "test"
+}
+
+resource "terraform_data" "test" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.contextforge_invoke_tool.test]
+    }
+  }
+}
+
+action "contextforge_invoke_tool" "test" {
+  config {
+    tool_id   = "echo"
+    arguments = ` + arguments + `
+  }
+}
+`
+}
+
+func TestAccInvokeToolAction(t *testing.T) {
+	var mu sync.Mutex
+	var echoed []string
+	mockServer := testAccEchoToolServer(t, &echoed, &mu)
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvokeToolActionConfig(mockServer.URL, `jsonencode({ message = "ping" })`),
+				PostApplyFunc: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					if len(echoed) != 1 || echoed[0] != "ping" {
+						t.Errorf("expected the tool to be invoked once with message ping, got %v", echoed)
+					}
+				},
+			},
+		},
+	})
+}
+
+func TestAccInvokeToolAction_ToolError(t *testing.T) {
+	var mu sync.Mutex
+	var echoed []string
+	mockServer := testAccEchoToolServer(t, &echoed, &mu)
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInvokeToolActionConfig(mockServer.URL, `jsonencode({})`),
+				ExpectError: regexp.MustCompile(`Tool Invocation Failed`),
+			},
+		},
+	})
+}
+
+func TestInvokeToolAction_ToolErrorFailsInvoke(t *testing.T) {
+	ctx := context.Background()
+	var mu sync.Mutex
+	var echoed []string
+	mockServer := testAccEchoToolServer(t, &echoed, &mu)
+	defer mockServer.Close()
+
+	a := &InvokeToolAction{client: client.NewClient(mockServer.URL, "test")}
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+
+	var progress []string
+	resp := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) { progress = append(progress, event.Message) },
+	}
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"tool_id":   tftypes.String,
+				"arguments": tftypes.String,
+			}}, map[string]tftypes.Value{
+				"tool_id":   tftypes.NewValue(tftypes.String, "echo"),
+				"arguments": tftypes.NewValue(tftypes.String, "{}"),
+			}),
+		},
+	}, resp)
+
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Tool Invocation Failed" {
+		t.Fatalf("expected a Tool Invocation Failed error, got %v", resp.Diagnostics)
+	}
+	if !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "missing message") {
+		t.Errorf("expected the tool error content in the detail, got %q", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(progress) != 1 {
+		t.Errorf("expected only the invoking progress event, got %v", progress)
+	}
+}
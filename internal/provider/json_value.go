@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// jsonObjectFromModel decodes a JSON-encoded string attribute that must hold
+// a JSON object, such as a gateway's capabilities. Null, unknown and empty
+// values, and the JSON literal null, decode to nil. Invalid JSON and JSON of
+// another type, e.g. an array, add an error with summary on attrPath.
+func jsonObjectFromModel(value types.String, attrPath path.Path, summary string, diagnostics *diag.Diagnostics) map[string]interface{} {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return nil
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &decoded); err != nil {
+		diagnostics.AddAttributeError(attrPath, summary, fmt.Sprintf("Unable to parse %s JSON: %s", attrPath, err))
+		return nil
+	}
+	if decoded == nil {
+		return nil
+	}
+	object, ok := decoded.(map[string]interface{})
+	if !ok {
+		diagnostics.AddAttributeError(attrPath, summary,
+			fmt.Sprintf("%s must be a JSON object, e.g. {\"key\": \"value\"}, but the value is a JSON %s.", attrPath, jsonTypeName(decoded)))
+		return nil
+	}
+	return object
+}
+
+// jsonTypeName names the JSON type of a value decoded by encoding/json.
+func jsonTypeName(v interface{}) string {
+	switch v.(type) {
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case map[string]interface{}:
+		return "object"
+	}
+	return "null"
+}
+
+// jsonObjectToModel maps a JSON object returned by the API to a JSON-encoded
+// string attribute value.
+//
+// The result is canonical (encoding/json sorts map keys), so repeated reads of
+// the same object always produce the same string regardless of the order the
+// gateway sends keys in. When prior (the planned or stored value) encodes the
+// same object, prior is kept as written, so differences in key order or
+// whitespace between the configuration and the API never show a diff.
+func jsonObjectToModel(value map[string]interface{}, prior types.String) (types.String, error) {
+	if value == nil {
+		return types.StringNull(), nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if canonical, ok := canonicalJSON(prior.ValueString()); ok && canonical == string(encoded) {
+			return prior, nil
+		}
+	}
+	return types.StringValue(string(encoded)), nil
+}
+
+// canonicalJSON re-encodes a JSON document with sorted keys and no
+// insignificant whitespace. It reports false if s is not valid JSON.
+func canonicalJSON(s string) (string, bool) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return "", false
+	}
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return "", false
+	}
+	return string(encoded), true
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJSONObjectToModel(t *testing.T) {
+	value := map[string]interface{}{"b": float64(1), "a": map[string]interface{}{"y": true, "x": "s"}}
+
+	cases := map[string]struct {
+		value map[string]interface{}
+		prior types.String
+		want  types.String
+	}{
+		"nil is null":              {value: nil, prior: types.StringValue(`{"a":1}`), want: types.StringNull()},
+		"canonical without prior":  {value: value, prior: types.StringUnknown(), want: types.StringValue(`{"a":{"x":"s","y":true},"b":1}`)},
+		"equivalent prior kept":    {value: value, prior: types.StringValue(`{ "b": 1, "a": {"y": true, "x": "s"} }`), want: types.StringValue(`{ "b": 1, "a": {"y": true, "x": "s"} }`)},
+		"different prior replaced": {value: value, prior: types.StringValue(`{"b": 2}`), want: types.StringValue(`{"a":{"x":"s","y":true},"b":1}`)},
+		"invalid prior replaced":   {value: value, prior: types.StringValue(`not json`), want: types.StringValue(`{"a":{"x":"s","y":true},"b":1}`)},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := jsonObjectToModel(tc.value, tc.prior)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestJSONObjectFromModel(t *testing.T) {
+	cases := map[string]struct {
+		value      types.String
+		wantKeys   int
+		wantDetail string
+	}{
+		"object":       {value: types.StringValue(`{"tools": {}, "prompts": {}}`), wantKeys: 2},
+		"null value":   {value: types.StringNull()},
+		"unknown":      {value: types.StringUnknown()},
+		"empty string": {value: types.StringValue("")},
+		"null literal": {value: types.StringValue("null")},
+		"array":        {value: types.StringValue(`[{"tools": {}}]`), wantDetail: "capabilities must be a JSON object, e.g. {\"key\": \"value\"}, but the value is a JSON array."},
+		"string":       {value: types.StringValue(`"tools"`), wantDetail: "but the value is a JSON string"},
+		"number":       {value: types.StringValue(`1`), wantDetail: "but the value is a JSON number"},
+		"invalid":      {value: types.StringValue(`{"tools":`), wantDetail: "Unable to parse capabilities JSON"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := jsonObjectFromModel(tc.value, path.Root("capabilities"), "Invalid Capabilities", &diags)
+
+			if tc.wantDetail == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				if len(got) != tc.wantKeys {
+					t.Errorf("expected %d keys, got %v", tc.wantKeys, got)
+				}
+				return
+			}
+
+			if got != nil || diags.ErrorsCount() != 1 {
+				t.Fatalf("expected a single error and no object, got %v and %v", got, diags)
+			}
+			d := diags.Errors()[0]
+			if d.Summary() != "Invalid Capabilities" || !strings.Contains(d.Detail(), tc.wantDetail) {
+				t.Errorf("expected Invalid Capabilities containing %q, got %q: %q", tc.wantDetail, d.Summary(), d.Detail())
+			}
+			withPath, ok := d.(diag.DiagnosticWithPath)
+			if !ok || !withPath.Path().Equal(path.Root("capabilities")) {
+				t.Errorf("expected the error to point at capabilities, got %v", d)
+			}
+		})
+	}
+}
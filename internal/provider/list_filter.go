@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listFilter selects items for the list data sources by active state. It is
+// built from the include_inactive, only_inactive and active attributes so
+// every list data source interprets them the same way.
+type listFilter struct {
+	// includeInactive is sent to the API as the include_inactive query parameter.
+	includeInactive bool
+	// active, when set, keeps only items whose is_active matches it.
+	active *bool
+}
+
+// newListFilter builds a listFilter from the data source attributes.
+//
+// Without filters the API default applies and only active items are returned
+// unless include_inactive is true. only_inactive is shorthand for
+// active = false. Filtering on inactive items implies include_inactive, since
+// the API would otherwise never return them.
+func newListFilter(includeInactive, onlyInactive, active types.Bool) listFilter {
+	f := listFilter{includeInactive: includeInactive.ValueBool()}
+
+	switch {
+	case onlyInactive.ValueBool():
+		f.active = new(bool)
+	case !active.IsNull() && !active.IsUnknown():
+		v := active.ValueBool()
+		f.active = &v
+	}
+
+	if f.active != nil && !*f.active {
+		f.includeInactive = true
+	}
+	return f
+}
+
+// matches reports whether an item with the given active state passes the filter.
+func (f listFilter) matches(isActive bool) bool {
+	return f.active == nil || *f.active == isActive
+}
+
+// filterByActive returns the items that pass f, using isActive to read each
+// item's active state.
+func filterByActive[T any](items []T, f listFilter, isActive func(T) bool) []T {
+	if f.active == nil {
+		return items
+	}
+	filtered := make([]T, 0, len(items))
+	for _, item := range items {
+		if f.matches(isActive(item)) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
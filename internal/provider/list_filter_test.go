@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+func TestNewListFilter(t *testing.T) {
+	servers := []client.Server{
+		{ID: "active", IsActive: true},
+		{ID: "inactive", IsActive: false},
+	}
+
+	cases := map[string]struct {
+		includeInactive     types.Bool
+		onlyInactive        types.Bool
+		active              types.Bool
+		wantIncludeInactive bool
+		wantIDs             []string
+	}{
+		"defaults": {
+			includeInactive: types.BoolNull(), onlyInactive: types.BoolNull(), active: types.BoolNull(),
+			wantIncludeInactive: false, wantIDs: []string{"active", "inactive"},
+		},
+		"include inactive": {
+			includeInactive: types.BoolValue(true), onlyInactive: types.BoolNull(), active: types.BoolNull(),
+			wantIncludeInactive: true, wantIDs: []string{"active", "inactive"},
+		},
+		"only inactive": {
+			includeInactive: types.BoolNull(), onlyInactive: types.BoolValue(true), active: types.BoolNull(),
+			wantIncludeInactive: true, wantIDs: []string{"inactive"},
+		},
+		"only inactive false": {
+			includeInactive: types.BoolNull(), onlyInactive: types.BoolValue(false), active: types.BoolNull(),
+			wantIncludeInactive: false, wantIDs: []string{"active", "inactive"},
+		},
+		"active true": {
+			includeInactive: types.BoolNull(), onlyInactive: types.BoolNull(), active: types.BoolValue(true),
+			wantIncludeInactive: false, wantIDs: []string{"active"},
+		},
+		"active false": {
+			includeInactive: types.BoolNull(), onlyInactive: types.BoolNull(), active: types.BoolValue(false),
+			wantIncludeInactive: true, wantIDs: []string{"inactive"},
+		},
+		"include inactive with active true": {
+			includeInactive: types.BoolValue(true), onlyInactive: types.BoolNull(), active: types.BoolValue(true),
+			wantIncludeInactive: true, wantIDs: []string{"active"},
+		},
+		"include inactive with active false": {
+			includeInactive: types.BoolValue(true), onlyInactive: types.BoolNull(), active: types.BoolValue(false),
+			wantIncludeInactive: true, wantIDs: []string{"inactive"},
+		},
+		"unknown active": {
+			includeInactive: types.BoolNull(), onlyInactive: types.BoolNull(), active: types.BoolUnknown(),
+			wantIncludeInactive: false, wantIDs: []string{"active", "inactive"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := newListFilter(tc.includeInactive, tc.onlyInactive, tc.active)
+			if f.includeInactive != tc.wantIncludeInactive {
+				t.Errorf("expected includeInactive=%t, got %t", tc.wantIncludeInactive, f.includeInactive)
+			}
+
+			got := filterByActive(servers, f, func(s client.Server) bool { return s.IsActive })
+			if len(got) != len(tc.wantIDs) {
+				t.Fatalf("expected %v, got %+v", tc.wantIDs, got)
+			}
+			for i, s := range got {
+				if s.ID != tc.wantIDs[i] {
+					t.Errorf("expected %v, got %+v", tc.wantIDs, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringListValue maps a list of strings returned by the API to a data source
+// list attribute. Data sources never surface null collections: a list the API
+// omitted or returned as null reads the same as an empty one, so
+// configurations can use length() and for expressions without null checks.
+func stringListValue(ctx context.Context, values []string) (types.List, diag.Diagnostics) {
+	if values == nil {
+		values = []string{}
+	}
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
+
+// parallelItemsThreshold is the number of list elements below which
+// mapItems maps them sequentially; for short lists starting workers costs
+// more than it saves.
+const parallelItemsThreshold = 256
+
+// mapItems maps the listed API objects to item models with a bounded pool of
+// workers, keeping the results in the order of the input. Elements that fail
+// to map are left out and reported with a warning, as addSkippedItemWarning
+// describes.
+//
+// Each worker writes only to the slots of the elements it maps, and the
+// results and diagnostics are gathered afterwards in input order, so the
+// output does not depend on scheduling.
+func mapItems[T, M any](
+	ctx context.Context,
+	objects []T,
+	kind string,
+	id func(T) string,
+	mapItem func(context.Context, T) (M, diag.Diagnostics),
+	diagnostics *diag.Diagnostics,
+) []M {
+	mapped := make([]M, len(objects))
+	itemDiags := make([]diag.Diagnostics, len(objects))
+
+	workers := min(runtime.GOMAXPROCS(0), len(objects)/parallelItemsThreshold+1)
+	if workers <= 1 {
+		for i, obj := range objects {
+			mapped[i], itemDiags[i] = mapItem(ctx, obj)
+		}
+	} else {
+		indexes := make(chan int)
+		var wg sync.WaitGroup
+		for range workers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := range indexes {
+					mapped[i], itemDiags[i] = mapItem(ctx, objects[i])
+				}
+			}()
+		}
+		for i := range objects {
+			indexes <- i
+		}
+		close(indexes)
+		wg.Wait()
+	}
+
+	items := make([]M, 0, len(objects))
+	for i, obj := range objects {
+		if itemDiags[i].HasError() {
+			addSkippedItemWarning(diagnostics, kind, id(obj), itemDiags[i])
+			continue
+		}
+		items = append(items, mapped[i])
+	}
+	return items
+}
@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+// testLargeToolList returns n tools with non-trivial input schemas, with every
+// badEvery-th tool carrying a schema that cannot be serialized.
+func testLargeToolList(n, badEvery int) []client.Tool {
+	tools := make([]client.Tool, n)
+	for i := range tools {
+		properties := map[string]interface{}{}
+		for p := 0; p < 20; p++ {
+			properties[fmt.Sprintf("arg%d", p)] = map[string]interface{}{
+				"type":        "string",
+				"description": fmt.Sprintf("argument %d of tool %d", p, i),
+			}
+		}
+		tools[i] = client.Tool{
+			ID:          fmt.Sprintf("tool-%d", i),
+			Name:        fmt.Sprintf("tool_%d", i),
+			Tags:        []string{"bulk"},
+			InputSchema: map[string]interface{}{"type": "object", "properties": properties},
+		}
+		if badEvery > 0 && i%badEvery == 0 {
+			tools[i].InputSchema = map[string]interface{}{"max": math.Inf(1)}
+		}
+	}
+	return tools
+}
+
+func TestMapItems_PreservesOrder(t *testing.T) {
+	const n = 5000
+	tools := testLargeToolList(n, 7)
+
+	var diags diag.Diagnostics
+	items := toolItemsFromAPI(context.Background(), tools, &diags)
+
+	var want []string
+	var skipped []string
+	for i, tool := range tools {
+		if i%7 == 0 {
+			skipped = append(skipped, "Skipped tool "+tool.ID)
+			continue
+		}
+		want = append(want, tool.ID)
+	}
+
+	if len(items) != len(want) {
+		t.Fatalf("expected %d items, got %d", len(want), len(items))
+	}
+	for i, item := range items {
+		if item.ID.ValueString() != want[i] {
+			t.Fatalf("item %d: expected %s, got %s", i, want[i], item.ID.ValueString())
+		}
+	}
+
+	if diags.HasError() {
+		t.Fatalf("expected no errors, got %v", diags)
+	}
+	warnings := diags.Warnings()
+	if len(warnings) != len(skipped) {
+		t.Fatalf("expected %d warnings, got %d", len(skipped), len(warnings))
+	}
+	for i, w := range warnings {
+		if w.Summary() != skipped[i] {
+			t.Fatalf("warning %d: expected %q, got %q", i, skipped[i], w.Summary())
+		}
+	}
+}
+
+func TestMapItems_Empty(t *testing.T) {
+	var diags diag.Diagnostics
+	items := toolItemsFromAPI(context.Background(), nil, &diags)
+	if items == nil || len(items) != 0 || diags.HasError() {
+		t.Errorf("expected an empty, non-nil result, got %v (%v)", items, diags)
+	}
+}
+
+func BenchmarkToolItemsFromAPI(b *testing.B) {
+	ctx := context.Background()
+	tools := testLargeToolList(5000, 0)
+
+	b.Run("sequential", func(b *testing.B) {
+		for b.Loop() {
+			items := make([]ToolItemModel, 0, len(tools))
+			for _, tool := range tools {
+				item, _ := toolItemFromAPI(ctx, tool)
+				items = append(items, item)
+			}
+		}
+	})
+	b.Run("parallel", func(b *testing.B) {
+		for b.Loop() {
+			var diags diag.Diagnostics
+			toolItemsFromAPI(ctx, tools, &diags)
+		}
+	})
+}
+
+func TestStringListValue(t *testing.T) {
+	ctx := context.Background()
+	empty := types.ListValueMust(types.StringType, []attr.Value{})
+
+	cases := map[string]struct {
+		values []string
+		want   types.List
+	}{
+		"nil":   {values: nil, want: empty},
+		"empty": {values: []string{}, want: empty},
+		"values": {
+			values: []string{"a", "b"},
+			want:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, diags := stringListValue(ctx, tc.values)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+// TestItemsFromAPI_EmptyLists checks that list attributes of data source items
+// are empty rather than null whether the API omits a list, returns it as null
+// or returns [].
+func TestItemsFromAPI_EmptyLists(t *testing.T) {
+	ctx := context.Background()
+
+	for name, body := range map[string]string{
+		"omitted": `{"id": "x"}`,
+		"null":    `{"id": "x", "tags": null, "passthrough_headers": null, "tool_ids": null}`,
+		"empty":   `{"id": "x", "tags": [], "passthrough_headers": [], "tool_ids": []}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			lists := map[string]types.List{}
+			var diags diag.Diagnostics
+
+			var tool client.Tool
+			decodeTestJSON(t, body, &tool)
+			toolItem, d := toolItemFromAPI(ctx, tool)
+			diags.Append(d...)
+			lists["tool tags"] = toolItem.Tags
+
+			var server client.Server
+			decodeTestJSON(t, body, &server)
+			serverItem, d := serverItemFromAPI(ctx, server, false)
+			diags.Append(d...)
+			lists["server tags"] = serverItem.Tags
+			lists["server tool_ids"] = serverItem.ToolIDs
+
+			var prompt client.Prompt
+			decodeTestJSON(t, body, &prompt)
+			promptItem, d := promptItemFromAPI(ctx, prompt)
+			diags.Append(d...)
+			lists["prompt tags"] = promptItem.Tags
+
+			var resource client.Resource
+			decodeTestJSON(t, body, &resource)
+			resourceItem, d := resourceItemFromAPI(ctx, resource)
+			diags.Append(d...)
+			lists["resource tags"] = resourceItem.Tags
+
+			var gateway client.Gateway
+			decodeTestJSON(t, body, &gateway)
+			gatewayItem, d := gatewayItemFromAPI(ctx, gateway)
+			diags.Append(d...)
+			lists["gateway tags"] = gatewayItem.Tags
+			lists["gateway passthrough_headers"] = gatewayItem.PassthroughHeaders
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			for attribute, list := range lists {
+				if list.IsNull() || list.IsUnknown() || len(list.Elements()) != 0 {
+					t.Errorf("%s: expected an empty list, got %s", attribute, list)
+				}
+			}
+		})
+	}
+}
+
+func decodeTestJSON(t *testing.T, body string, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal([]byte(body), v); err != nil {
+		t.Fatalf("unexpected error decoding %s: %v", body, err)
+	}
+}
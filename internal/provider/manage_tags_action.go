@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+var _ action.Action = &ManageTagsAction{}
+var _ action.ActionWithConfigure = &ManageTagsAction{}
+
+// manageTagsKinds maps the object_type values accepted by the action to the
+// API collections that support tag changes.
+var manageTagsKinds = map[string]client.TaggableKind{
+	"tool":     client.TaggableTools,
+	"server":   client.TaggableServers,
+	"prompt":   client.TaggablePrompts,
+	"resource": client.TaggableResources,
+}
+
+func NewManageTagsAction() action.Action {
+	return &ManageTagsAction{}
+}
+
+// ManageTagsAction adds and removes tags on a gateway object without a full update.
+type ManageTagsAction struct {
+	client   *client.Client
+	settings providerSettings
+}
+
+// ManageTagsActionModel describes the action data model.
+type ManageTagsActionModel struct {
+	ObjectType types.String `tfsdk:"object_type"`
+	ObjectID   types.String `tfsdk:"object_id"`
+	Add        types.List   `tfsdk:"add"`
+	Remove     types.List   `tfsdk:"remove"`
+}
+
+func (a *ManageTagsAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_manage_tags"
+}
+
+func (a *ManageTagsAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds and removes tags on a tool, server, prompt, or resource without a full update of the object.",
+		Attributes: map[string]schema.Attribute{
+			"object_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the object to tag. One of `tool`, `server`, `prompt`, or `resource`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("tool", "server", "prompt", "resource"),
+				},
+			},
+			"object_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the object to tag.",
+				Required:            true,
+			},
+			"add": schema.ListAttribute{
+				MarkdownDescription: "Tags to add to the object.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"remove": schema.ListAttribute{
+				MarkdownDescription: "Tags to remove from the object. Removals are applied after additions.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (a *ManageTagsAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = data.client
+	a.settings = data.settings
+}
+
+func (a *ManageTagsAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if readOnlyBlocked(a.settings, "manage tags", &resp.Diagnostics) {
+		return
+	}
+
+	var data ManageTagsActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var add, remove []string
+	if !data.Add.IsNull() {
+		resp.Diagnostics.Append(data.Add.ElementsAs(ctx, &add, false)...)
+	}
+	if !data.Remove.IsNull() {
+		resp.Diagnostics.Append(data.Remove.ElementsAs(ctx, &remove, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kind := manageTagsKinds[data.ObjectType.ValueString()]
+	id := data.ObjectID.ValueString()
+
+	if len(add) > 0 {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("adding %d tag(s) to %s %s", len(add), data.ObjectType.ValueString(), id),
+		})
+		if _, err := a.client.AddTags(ctx, kind, id, add); err != nil {
+			addClientError(&resp.Diagnostics, "add tags", err)
+			return
+		}
+	}
+
+	if len(remove) > 0 {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("removing %d tag(s) from %s %s", len(remove), data.ObjectType.ValueString(), id),
+		})
+		if _, err := a.client.RemoveTags(ctx, kind, id, remove); err != nil {
+			addClientError(&resp.Diagnostics, "remove tags", err)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "invoked manage_tags action")
+}
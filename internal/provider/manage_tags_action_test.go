@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+func TestAccManageTagsAction(t *testing.T) {
+	var mu sync.Mutex
+	var calls []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/prompts/prompt-1/tags" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var req client.TagsRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		calls = append(calls, r.Method+" "+strings.Join(req.Tags, ","))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(client.TagsResponse{Tags: req.Tags}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+resource "terraform_data" "test" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.contextforge_manage_tags.test]
+    }
+  }
+}
+
+action "contextforge_manage_tags" "test" {
+  config {
+    object_type = "prompt"
+    object_id   = "prompt-1"
+    add         = ["reviewed", "prod"]
+    remove      = ["draft"]
+  }
+}
+`,
+				PostApplyFunc: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					want := []string{"POST reviewed,prod", "DELETE draft"}
+					if strings.Join(calls, ";") != strings.Join(want, ";") {
+						t.Errorf("expected calls %v, got %v", want, calls)
+					}
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+var _ datasource.DataSource = &MCPResourceContentDataSource{}
+
+func NewMCPResourceContentDataSource() datasource.DataSource {
+	return &MCPResourceContentDataSource{}
+}
+
+// MCPResourceContentDataSource reads the content of an MCP resource from the MCP Gateway.
+type MCPResourceContentDataSource struct {
+	client *client.Client
+}
+
+// MCPResourceContentDataSourceModel describes the data source data model.
+type MCPResourceContentDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	URI           types.String `tfsdk:"uri"`
+	Content       types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	ContentType   types.String `tfsdk:"content_type"`
+}
+
+func (d *MCPResourceContentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mcp_resource_content"
+}
+
+func (d *MCPResourceContentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the content of an MCP resource from the ContextForge MCP Gateway by ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier.",
+				Required:            true,
+			},
+			"uri": schema.StringAttribute{
+				MarkdownDescription: "Resource URI.",
+				Computed:            true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Text content of the resource. Null for binary resources.",
+				Computed:            true,
+			},
+			"content_base64": schema.StringAttribute{
+				MarkdownDescription: "Base64-encoded content of the resource. Only set for binary resources.",
+				Computed:            true,
+			},
+			"content_type": schema.StringAttribute{
+				MarkdownDescription: "MIME type of the content, if reported by the gateway.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *MCPResourceContentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *MCPResourceContentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MCPResourceContentDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := d.client.ReadResourceContent(ctx, data.ID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read resource content", err)
+		return
+	}
+	if content == nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Resource with ID %s not found", data.ID.ValueString()))
+		return
+	}
+
+	data.URI = types.StringValue(content.URI)
+
+	if content.MimeType != "" {
+		data.ContentType = types.StringValue(content.MimeType)
+	} else {
+		data.ContentType = types.StringNull()
+	}
+
+	if content.Blob != nil {
+		data.Content = types.StringNull()
+		data.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString(content.Blob))
+	} else {
+		data.Content = types.StringPointerValue(content.Text)
+		data.ContentBase64 = types.StringNull()
+	}
+
+	tflog.Trace(ctx, "read mcp_resource_content data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccMCPResourceContentDataSource(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/resources/res-text":
+			if _, err := w.Write([]byte(`{"uri": "file:///readme.md", "mimeType": "text/markdown", "text": "# Hello"}`)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+		case "/resources/res-binary":
+			if _, err := w.Write([]byte(`{"uri": "file:///logo.png", "mimeType": "image/png", "blob": "iVBORw0KGgo="}`)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+data "contextforge_mcp_resource_content" "text" {
+  id = "res-text"
+}
+
+data "contextforge_mcp_resource_content" "binary" {
+  id = "res-binary"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.contextforge_mcp_resource_content.text",
+						tfjsonpath.New("content"),
+						knownvalue.StringExact("# Hello"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_mcp_resource_content.text",
+						tfjsonpath.New("content_base64"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_mcp_resource_content.text",
+						tfjsonpath.New("content_type"),
+						knownvalue.StringExact("text/markdown"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_mcp_resource_content.binary",
+						tfjsonpath.New("content"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_mcp_resource_content.binary",
+						tfjsonpath.New("content_base64"),
+						knownvalue.StringExact("iVBORw0KGgo="),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_mcp_resource_content.binary",
+						tfjsonpath.New("content_type"),
+						knownvalue.StringExact("image/png"),
+					),
+				},
+			},
+		},
+	})
+}
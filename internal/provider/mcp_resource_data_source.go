@@ -37,6 +37,7 @@ type MCPResourceDataSourceModel struct {
 	Visibility  types.String `tfsdk:"visibility"`
 	CreatedAt   types.String `tfsdk:"created_at"`
 	UpdatedAt   types.String `tfsdk:"updated_at"`
+	CreatedBy   types.String `tfsdk:"created_by"`
 }
 
 func (d *MCPResourceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -88,6 +89,10 @@ func (d *MCPResourceDataSource) Schema(ctx context.Context, req datasource.Schem
 				MarkdownDescription: "Timestamp when the resource was last updated.",
 				Computed:            true,
 			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "Principal that created the resource, if reported by the gateway.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -97,16 +102,16 @@ func (d *MCPResourceDataSource) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = apiClient
+	d.client = data.client
 }
 
 func (d *MCPResourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -119,7 +124,7 @@ func (d *MCPResourceDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	resource, err := d.client.GetResource(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read resource, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read resource", err)
 		return
 	}
 	if resource == nil {
@@ -130,23 +135,25 @@ func (d *MCPResourceDataSource) Read(ctx context.Context, req datasource.ReadReq
 	data.ID = types.StringValue(resource.ID)
 	data.URI = types.StringValue(resource.URI)
 	data.Name = types.StringValue(resource.Name)
-	data.Description = types.StringValue(resource.Description)
+	data.Description = types.StringValue(derefString(resource.Description))
 	data.MimeType = types.StringValue(resource.MimeType)
 	data.IsActive = types.BoolValue(resource.IsActive)
-	data.Visibility = types.StringValue(resource.Visibility)
+	data.Visibility = types.StringValue(derefString(resource.Visibility))
 	data.CreatedAt = types.StringValue(resource.CreatedAt)
 	data.UpdatedAt = types.StringValue(resource.UpdatedAt)
 
-	if resource.Tags != nil {
-		tags, diags := types.ListValueFrom(ctx, types.StringType, resource.Tags)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.Tags = tags
+	if resource.CreatedBy != "" {
+		data.CreatedBy = types.StringValue(resource.CreatedBy)
 	} else {
-		data.Tags = types.ListNull(types.StringType)
+		data.CreatedBy = types.StringNull()
+	}
+
+	tags, diags := stringListValue(ctx, resource.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.Tags = tags
 
 	tflog.Trace(ctx, "read mcp_resource data source")
 
@@ -5,7 +5,10 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -22,6 +25,7 @@ import (
 
 var _ resource.Resource = &MCPResourceResource{}
 var _ resource.ResourceWithImportState = &MCPResourceResource{}
+var _ resource.ResourceWithModifyPlan = &MCPResourceResource{}
 
 func NewMCPResourceResource() resource.Resource {
 	return &MCPResourceResource{}
@@ -29,23 +33,31 @@ func NewMCPResourceResource() resource.Resource {
 
 // MCPResourceResource manages an MCP resource on the MCP Gateway.
 type MCPResourceResource struct {
-	client *client.Client
+	client   *client.Client
+	settings providerSettings
 }
 
 // MCPResourceResourceModel describes the resource data model.
 type MCPResourceResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	URI         types.String `tfsdk:"uri"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	MimeType    types.String `tfsdk:"mime_type"`
-	Tags        types.List   `tfsdk:"tags"`
-	IsActive    types.Bool   `tfsdk:"is_active"`
-	Visibility  types.String `tfsdk:"visibility"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID            types.String `tfsdk:"id"`
+	URI           types.String `tfsdk:"uri"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	MimeType      types.String `tfsdk:"mime_type"`
+	Content       types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	Tags          types.List   `tfsdk:"tags"`
+	IsActive      types.Bool   `tfsdk:"is_active"`
+	DeletionMode  types.String `tfsdk:"deletion_mode"`
+	Visibility    types.String `tfsdk:"visibility"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+	CreatedBy     types.String `tfsdk:"created_by"`
 }
 
+// base64Pattern matches standard, padded base64.
+var base64Pattern = regexp.MustCompile(`^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=)?$`)
+
 func (r *MCPResourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_mcp_resource"
 }
@@ -78,9 +90,26 @@ func (r *MCPResourceResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "MIME type of the MCP resource.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					mimeTypeValidator{},
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Text content to upload to the gateway once the resource is created, and again whenever it changes. Conflicts with `content_base64`. The gateway's copy is not read back, so changes made outside Terraform are not detected.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("content_base64")),
+				},
+			},
+			"content_base64": schema.StringAttribute{
+				MarkdownDescription: "Base64-encoded binary content to upload to the gateway once the resource is created, and again whenever it changes, e.g. `filebase64(\"logo.png\")`. Conflicts with `content`. The gateway's copy is not read back, so changes made outside Terraform are not detected.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(base64Pattern, "must be standard base64 with padding"),
+				},
 			},
 			"tags": schema.ListAttribute{
-				MarkdownDescription: "Tags associated with the MCP resource.",
+				MarkdownDescription: "Tags associated with the MCP resource. Leaving this unset and setting it to `[]` are equivalent. Tags are trimmed and de-duplicated before they are sent, and lowercased when the provider sets `lowercase_tags`.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
@@ -89,12 +118,13 @@ func (r *MCPResourceResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "Whether the MCP resource is active.",
 				Computed:            true,
 			},
+			"deletion_mode": deletionModeAttribute("MCP resource"),
 			"visibility": schema.StringAttribute{
-				MarkdownDescription: "Visibility of the MCP resource (e.g. `public`, `private`).",
+				MarkdownDescription: "Visibility of the MCP resource (e.g. `public`, `private`). Defaults to the provider's `default_visibility` when that is set.",
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("public", "private", "team"),
+					stringvalidator.OneOf(visibilityValues...),
 				},
 			},
 			"created_at": schema.StringAttribute{
@@ -105,6 +135,10 @@ func (r *MCPResourceResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "Timestamp when the MCP resource was last updated.",
 				Computed:            true,
 			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "Principal that created the resource, if reported by the gateway.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -114,19 +148,30 @@ func (r *MCPResourceResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = apiClient
+	r.client = data.client
+	r.settings = data.settings
+}
+
+// ModifyPlan warns when the configured tags will be normalized before they
+// are sent to the gateway.
+func (r *MCPResourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnTagNormalization(ctx, req.Config, r.settings.lowercaseTags, &resp.Diagnostics)
 }
 
 func (r *MCPResourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if readOnlyBlocked(r.settings, "create resource", &resp.Diagnostics) {
+		return
+	}
+
 	var data MCPResourceResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -134,12 +179,10 @@ func (r *MCPResourceResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	var tags []string
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	tags := tagsFromModel(ctx, data.Tags, r.settings.lowercaseTags, &resp.Diagnostics)
+	content := resourceContentFromModel(data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	createReq := client.CreateResourceRequest{
@@ -155,7 +198,7 @@ func (r *MCPResourceResource) Create(ctx context.Context, req resource.CreateReq
 
 	mcpResource, err := r.client.CreateResource(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create MCP resource, got error: %s", err))
+		addCreateError(&resp.Diagnostics, "create MCP resource", fmt.Sprintf("an MCP resource with URI %q", data.URI.ValueString()), err)
 		return
 	}
 
@@ -164,6 +207,23 @@ func (r *MCPResourceResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	if content != nil {
+		uploaded, err := r.client.UploadResourceContent(ctx, mcpResource.ID, *content)
+		if err != nil {
+			// The resource exists without its content. Saving it keeps it
+			// tracked, and the error taints it so the next apply replaces it.
+			data.Content = types.StringNull()
+			data.ContentBase64 = types.StringNull()
+			addClientError(&resp.Diagnostics, fmt.Sprintf("upload content of MCP resource %s", mcpResource.ID), err)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		r.resourceToModel(ctx, uploaded, &data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	tflog.Trace(ctx, "created an MCP resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -179,7 +239,7 @@ func (r *MCPResourceResource) Read(ctx context.Context, req resource.ReadRequest
 
 	mcpResource, err := r.client.GetResource(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read MCP resource, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read MCP resource", err)
 		return
 	}
 	if mcpResource == nil {
@@ -196,32 +256,39 @@ func (r *MCPResourceResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *MCPResourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data MCPResourceResourceModel
+	if readOnlyBlocked(r.settings, "update resource", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state MCPResourceResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var tags []string
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	updateReq := resourceUpdateFromModel(ctx, data, r.settings.lowercaseTags, &resp.Diagnostics)
+	priorReq := resourceUpdateFromModel(ctx, state, r.settings.lowercaseTags, &resp.Diagnostics)
+	var content *client.ResourceContentUpload
+	if !data.Content.Equal(state.Content) || !data.ContentBase64.Equal(state.ContentBase64) {
+		content = resourceContentFromModel(data, &resp.Diagnostics)
 	}
-
-	updateReq := client.ResourceUpdate{
-		URI:         data.URI.ValueString(),
-		Name:        data.Name.ValueString(),
-		Description: data.Description.ValueString(),
-		MimeType:    data.MimeType.ValueString(),
-		Tags:        tags,
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	mcpResource, err := r.client.UpdateResource(ctx, data.ID.ValueString(), updateReq)
+	id := data.ID.ValueString()
+	mcpResource, err := updateWithPatch(priorReq, updateReq,
+		func(changes map[string]json.RawMessage) (*client.Resource, error) {
+			return r.client.PatchResource(ctx, id, changes)
+		},
+		func(full client.ResourceUpdate) (*client.Resource, error) {
+			return r.client.UpdateResource(ctx, id, full)
+		},
+	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update MCP resource, got error: %s", err))
+		addClientError(&resp.Diagnostics, "update MCP resource", err)
 		return
 	}
 
@@ -230,12 +297,70 @@ func (r *MCPResourceResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	if content != nil {
+		uploaded, err := r.client.UploadResourceContent(ctx, id, *content)
+		if err != nil {
+			// The metadata was updated but the gateway still holds the
+			// previous content, so that is what state records.
+			data.Content = state.Content
+			data.ContentBase64 = state.ContentBase64
+			addClientError(&resp.Diagnostics, fmt.Sprintf("upload content of MCP resource %s", id), err)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		r.resourceToModel(ctx, uploaded, &data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	tflog.Trace(ctx, "updated an MCP resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// resourceUpdateFromModel builds the update request for the MCP resource
+// described by data.
+func resourceUpdateFromModel(ctx context.Context, data MCPResourceResourceModel, lowercase bool, diagnostics *diag.Diagnostics) client.ResourceUpdate {
+	tags := tagsFromModel(ctx, data.Tags, lowercase, diagnostics)
+
+	return client.ResourceUpdate{
+		URI:         data.URI.ValueString(),
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		MimeType:    data.MimeType.ValueString(),
+		Tags:        tags,
+	}
+}
+
+// resourceContentFromModel returns the content to upload for the MCP resource
+// described by data, or nil when neither content nor content_base64 is set.
+func resourceContentFromModel(data MCPResourceResourceModel, diagnostics *diag.Diagnostics) *client.ResourceContentUpload {
+	if !data.Content.IsNull() && !data.Content.IsUnknown() {
+		text := data.Content.ValueString()
+		return &client.ResourceContentUpload{Content: &text}
+	}
+	if data.ContentBase64.IsNull() || data.ContentBase64.IsUnknown() {
+		return nil
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(data.ContentBase64.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("content_base64"),
+			"Invalid Base64 Content",
+			fmt.Sprintf("Unable to decode content_base64: %s", err),
+		)
+		return nil
+	}
+	return &client.ResourceContentUpload{Blob: blob}
+}
+
 func (r *MCPResourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if readOnlyBlocked(r.settings, "delete resource", &resp.Diagnostics) {
+		return
+	}
+
 	var data MCPResourceResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -243,9 +368,32 @@ func (r *MCPResourceResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	if deactivateOnDelete(r.settings, data.DeletionMode) {
+		priorReq := resourceUpdateFromModel(ctx, data, r.settings.lowercaseTags, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		deactivateReq := priorReq
+		deactivateReq.IsActive = new(bool)
+
+		id := data.ID.ValueString()
+		_, err := updateWithPatch(priorReq, deactivateReq,
+			func(changes map[string]json.RawMessage) (*client.Resource, error) {
+				return r.client.PatchResource(ctx, id, changes)
+			},
+			func(full client.ResourceUpdate) (*client.Resource, error) {
+				return r.client.UpdateResource(ctx, id, full)
+			},
+		)
+		if err != nil {
+			addDeactivateError(&resp.Diagnostics, "deactivate MCP resource", err)
+		}
+		return
+	}
+
 	err := r.client.DeleteResource(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete MCP resource, got error: %s", err))
+		addClientError(&resp.Diagnostics, "delete MCP resource", err)
 		return
 	}
 }
@@ -259,21 +407,23 @@ func (r *MCPResourceResource) resourceToModel(ctx context.Context, mcpResource *
 	data.ID = types.StringValue(mcpResource.ID)
 	data.URI = types.StringValue(mcpResource.URI)
 	data.Name = types.StringValue(mcpResource.Name)
-	data.Description = types.StringValue(mcpResource.Description)
+	data.Description = stringOrPrior(mcpResource.Description, data.Description)
 	data.MimeType = types.StringValue(mcpResource.MimeType)
 	data.IsActive = types.BoolValue(mcpResource.IsActive)
-	data.Visibility = types.StringValue(mcpResource.Visibility)
+	data.Visibility = stringOrPrior(mcpResource.Visibility, data.Visibility)
 	data.CreatedAt = types.StringValue(mcpResource.CreatedAt)
 	data.UpdatedAt = types.StringValue(mcpResource.UpdatedAt)
 
-	if mcpResource.Tags != nil {
-		tagsList, diags := types.ListValueFrom(ctx, types.StringType, mcpResource.Tags)
-		diagnostics.Append(diags...)
-		if diagnostics.HasError() {
-			return
-		}
-		data.Tags = tagsList
+	if mcpResource.CreatedBy != "" {
+		data.CreatedBy = types.StringValue(mcpResource.CreatedBy)
 	} else {
-		data.Tags = types.ListNull(types.StringType)
+		data.CreatedBy = types.StringNull()
+	}
+
+	tagsList, diags := normalizedTagsToModel(ctx, mcpResource.Tags, data.Tags, r.settings.lowercaseTags)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
 	}
+	data.Tags = tagsList
 }
@@ -4,16 +4,29 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/nkbud/terraform-provider-contextforge/internal/client"
 )
@@ -33,11 +46,11 @@ func TestAccMCPResourceResource(t *testing.T) {
 				ID:          "res-created",
 				URI:         req.Resource.URI,
 				Name:        req.Resource.Name,
-				Description: req.Resource.Description,
+				Description: stringPointer(req.Resource.Description),
 				MimeType:    req.Resource.MimeType,
 				Tags:        []string{},
 				IsActive:    true,
-				Visibility:  req.Visibility,
+				Visibility:  stringPointer(req.Visibility),
 				CreatedAt:   "2025-01-01T00:00:00Z",
 				UpdatedAt:   "2025-01-01T00:00:00Z",
 			}); err != nil {
@@ -53,7 +66,7 @@ func TestAccMCPResourceResource(t *testing.T) {
 				MimeType:   "application/json",
 				Tags:       []string{},
 				IsActive:   true,
-				Visibility: "private",
+				Visibility: stringPointer("private"),
 				CreatedAt:  "2025-01-01T00:00:00Z",
 				UpdatedAt:  "2025-01-01T00:00:00Z",
 			}); err != nil {
@@ -92,6 +105,46 @@ func TestAccMCPResourceResource(t *testing.T) {
 	})
 }
 
+func TestMCPResourceToModel_EmptyStrings(t *testing.T) {
+	cases := map[string]struct {
+		prior    types.String
+		apiValue *string
+		want     types.String
+	}{
+		"unset config, API empty": {prior: types.StringUnknown(), apiValue: stringPointer(""), want: types.StringNull()},
+		"null prior, API empty":   {prior: types.StringNull(), apiValue: stringPointer(""), want: types.StringNull()},
+		"empty config, API empty": {prior: types.StringValue(""), apiValue: stringPointer(""), want: types.StringValue("")},
+		"configured, API value":   {prior: types.StringValue("old"), apiValue: stringPointer("new"), want: types.StringValue("new")},
+		"configured, API omits":   {prior: types.StringValue("old"), apiValue: nil, want: types.StringValue("old")},
+		"configured, API empty":   {prior: types.StringValue("old"), apiValue: stringPointer(""), want: types.StringNull()},
+		"unset config, API value": {prior: types.StringUnknown(), apiValue: stringPointer("new"), want: types.StringValue("new")},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			data := MCPResourceResourceModel{
+				Description: tc.prior,
+				Visibility:  tc.prior,
+				Tags:        types.ListNull(types.StringType),
+			}
+			var diags diag.Diagnostics
+
+			mcpResource := &client.Resource{ID: "res-1", Description: tc.apiValue, Visibility: tc.apiValue}
+			(&MCPResourceResource{}).resourceToModel(context.Background(), mcpResource, &data, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if !data.Description.Equal(tc.want) {
+				t.Errorf("expected description %s, got %s", tc.want, data.Description)
+			}
+			if !data.Visibility.Equal(tc.want) {
+				t.Errorf("expected visibility %s, got %s", tc.want, data.Visibility)
+			}
+		})
+	}
+}
+
 func testAccMCPResourceResourceConfig(endpoint string) string {
 	return `
 provider "contextforge" {
@@ -107,3 +160,191 @@ resource "contextforge_mcp_resource" "test" {
 }
 `
 }
+
+func TestResourceContentFromModel(t *testing.T) {
+	cases := map[string]struct {
+		content, contentBase64 types.String
+		wantText               *string
+		wantBlob               string
+		wantErr                bool
+	}{
+		"neither":        {content: types.StringNull(), contentBase64: types.StringNull()},
+		"text":           {content: types.StringValue("# Hello"), contentBase64: types.StringNull(), wantText: func() *string { s := "# Hello"; return &s }()},
+		"base64":         {content: types.StringNull(), contentBase64: types.StringValue("iVBORw0KGgo="), wantBlob: "\x89PNG\r\n\x1a\n"},
+		"unknown":        {content: types.StringUnknown(), contentBase64: types.StringNull()},
+		"invalid base64": {content: types.StringNull(), contentBase64: types.StringValue("not base64!"), wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := resourceContentFromModel(MCPResourceResourceModel{Content: tc.content, ContentBase64: tc.contentBase64}, &diags)
+
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%t, got %v", tc.wantErr, diags)
+			}
+			if tc.wantText == nil && tc.wantBlob == "" {
+				if got != nil {
+					t.Errorf("expected no upload, got %+v", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("expected content to upload")
+			}
+			if tc.wantText != nil && (got.Content == nil || *got.Content != *tc.wantText) {
+				t.Errorf("expected text %q, got %+v", *tc.wantText, got)
+			}
+			if string(got.Blob) != tc.wantBlob {
+				t.Errorf("expected blob %q, got %q", tc.wantBlob, got.Blob)
+			}
+		})
+	}
+}
+
+// newTestResourceContentAPI serves a single MCP resource and records the
+// content uploaded to it. Uploads fail with uploadStatus when it is not 200.
+func newTestResourceContentAPI(t *testing.T, uploadStatus int) (*httptest.Server, *[]string) {
+	t.Helper()
+	var (
+		mu      sync.Mutex
+		uploads []string
+	)
+	created := client.Resource{ID: "res-1", URI: "file:///test/logo.png", Name: "logo", Tags: []string{}, IsActive: true, UpdatedAt: "2025-01-01T00:00:00Z"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/resources" && r.Method == http.MethodPost:
+			testAccMockWrite(w, http.StatusCreated, created)
+		case r.URL.Path == "/resources/res-1/info" && r.Method == http.MethodGet:
+			testAccMockWrite(w, http.StatusOK, created)
+		case r.URL.Path == "/resources/res-1" && (r.Method == http.MethodPut || r.Method == http.MethodPatch):
+			body, _ := io.ReadAll(r.Body)
+			if !strings.Contains(string(body), `"content"`) && !strings.Contains(string(body), `"blob"`) {
+				testAccMockWrite(w, http.StatusOK, created)
+				return
+			}
+			mu.Lock()
+			uploads = append(uploads, string(body))
+			mu.Unlock()
+			if uploadStatus != http.StatusOK {
+				testAccMockWrite(w, uploadStatus, map[string]string{"detail": "storage unavailable"})
+				return
+			}
+			uploaded := created
+			uploaded.UpdatedAt = "2025-01-02T00:00:00Z"
+			testAccMockWrite(w, http.StatusOK, uploaded)
+		case r.URL.Path == "/resources/res-1" && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &uploads
+}
+
+func TestMCPResourceResourceCreate_UploadsContent(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		attribute    string
+		value        string
+		uploadStatus int
+		wantUpload   string
+		wantErr      bool
+	}{
+		"text":           {attribute: "content", value: "# Hello", uploadStatus: http.StatusOK, wantUpload: `{"content":"# Hello"}`},
+		"base64":         {attribute: "content_base64", value: "iVBORw0KGgo=", uploadStatus: http.StatusOK, wantUpload: `{"blob":"iVBORw0KGgo="}`},
+		"upload failure": {attribute: "content", value: "# Hello", uploadStatus: http.StatusInternalServerError, wantUpload: `{"content":"# Hello"}`, wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server, uploads := newTestResourceContentAPI(t, tc.uploadStatus)
+			r := &MCPResourceResource{client: client.NewClient(server.URL, "test")}
+			r.client.MaxRetries = 0
+
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+			empty := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: empty}
+			for attr, value := range map[string]string{"uri": "file:///test/logo.png", "name": "logo", tc.attribute: tc.value} {
+				if diags := plan.SetAttribute(ctx, path.Root(attr), value); diags.HasError() {
+					t.Fatalf("unexpected diagnostics building plan: %v", diags)
+				}
+			}
+
+			resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: empty}}
+			r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%t, got %v", tc.wantErr, resp.Diagnostics)
+			}
+			if len(*uploads) != 1 || (*uploads)[0] != tc.wantUpload {
+				t.Errorf("expected one upload %s, got %v", tc.wantUpload, *uploads)
+			}
+
+			var state MCPResourceResourceModel
+			if diags := resp.State.Get(ctx, &state); diags.HasError() {
+				t.Fatalf("unexpected diagnostics reading state: %v", diags)
+			}
+			if state.ID.ValueString() != "res-1" {
+				t.Errorf("expected the created resource to be saved in state, got ID %s", state.ID)
+			}
+			if tc.wantErr {
+				if !state.Content.IsNull() {
+					t.Errorf("expected content to be null after a failed upload, got %s", state.Content)
+				}
+				return
+			}
+			if state.UpdatedAt.ValueString() != "2025-01-02T00:00:00Z" {
+				t.Errorf("expected state to reflect the upload response, got updated_at %s", state.UpdatedAt)
+			}
+		})
+	}
+}
+
+func TestAccMCPResourceResource_Content(t *testing.T) {
+	server, uploads := newTestResourceContentAPI(t, http.StatusOK)
+
+	config := func(content string) string {
+		return `
+provider "contextforge" {
+  endpoint     = "` + server.URL + `"
+  bearer_token = "test"
+}
+
+resource "contextforge_mcp_resource" "test" {
+  uri  = "file:///test/logo.png"
+  name = "logo"
+  ` + content + `
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`content = "# Hello"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("contextforge_mcp_resource.test", tfjsonpath.New("content"), knownvalue.StringExact("# Hello")),
+				},
+			},
+			{
+				Config: config(`content_base64 = "iVBORw0KGgo="`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("contextforge_mcp_resource.test", tfjsonpath.New("content_base64"), knownvalue.StringExact("iVBORw0KGgo=")),
+				},
+				Check: func(*terraform.State) error {
+					want := []string{`{"content":"# Hello"}`, `{"blob":"iVBORw0KGgo="}`}
+					if !slices.Equal(*uploads, want) {
+						return fmt.Errorf("expected uploads %v, got %v", want, *uploads)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
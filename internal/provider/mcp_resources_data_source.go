@@ -7,8 +7,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nkbud/terraform-provider-contextforge/internal/client"
@@ -28,7 +32,11 @@ type MCPResourcesDataSource struct {
 // MCPResourcesDataSourceModel describes the data source data model.
 type MCPResourcesDataSourceModel struct {
 	IncludeInactive types.Bool             `tfsdk:"include_inactive"`
+	OnlyInactive    types.Bool             `tfsdk:"only_inactive"`
+	Active          types.Bool             `tfsdk:"active"`
+	MimeType        types.String           `tfsdk:"mime_type"`
 	Resources       []MCPResourceItemModel `tfsdk:"resources"`
+	Count           types.Int64            `tfsdk:"count"`
 	ID              types.String           `tfsdk:"id"`
 }
 
@@ -58,6 +66,25 @@ func (d *MCPResourcesDataSource) Schema(ctx context.Context, req datasource.Sche
 				MarkdownDescription: "Whether to include inactive resources in the list. Defaults to `false`.",
 				Optional:            true,
 			},
+			"only_inactive": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, only inactive resources are returned. Shorthand for `active = false`.",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("active")),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "When set, only resources whose `is_active` matches this value are returned. Setting it to `false` implies `include_inactive`.",
+				Optional:            true,
+			},
+			"mime_type": schema.StringAttribute{
+				MarkdownDescription: "When set, only resources with this MIME type, ignoring case, are returned, e.g. `application/json`. Filtering happens on the gateway where supported.",
+				Optional:            true,
+			},
+			"count": schema.Int64Attribute{
+				MarkdownDescription: "Number of resources returned.",
+				Computed:            true,
+			},
 			"resources": schema.ListNestedAttribute{
 				MarkdownDescription: "List of resources.",
 				Computed:            true,
@@ -120,16 +147,16 @@ func (d *MCPResourcesDataSource) Configure(ctx context.Context, req datasource.C
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = apiClient
+	d.client = data.client
 }
 
 func (d *MCPResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -140,44 +167,17 @@ func (d *MCPResourcesDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	includeInactive := false
-	if !data.IncludeInactive.IsNull() && !data.IncludeInactive.IsUnknown() {
-		includeInactive = data.IncludeInactive.ValueBool()
-	}
+	filter := newListFilter(data.IncludeInactive, data.OnlyInactive, data.Active)
 
-	resources, err := d.client.ListResources(ctx, includeInactive)
+	resources, err := d.client.ListResources(ctx, filter.includeInactive, data.MimeType.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list resources, got error: %s", err))
+		addClientError(&resp.Diagnostics, "list resources", err)
 		return
 	}
+	resources = filterByActive(resources, filter, func(r client.Resource) bool { return r.IsActive })
 
-	data.Resources = make([]MCPResourceItemModel, len(resources))
-	for i, r := range resources {
-		item := MCPResourceItemModel{
-			ID:          types.StringValue(r.ID),
-			URI:         types.StringValue(r.URI),
-			Name:        types.StringValue(r.Name),
-			Description: types.StringValue(r.Description),
-			MimeType:    types.StringValue(r.MimeType),
-			IsActive:    types.BoolValue(r.IsActive),
-			Visibility:  types.StringValue(r.Visibility),
-			CreatedAt:   types.StringValue(r.CreatedAt),
-			UpdatedAt:   types.StringValue(r.UpdatedAt),
-		}
-
-		if r.Tags != nil {
-			tags, diags := types.ListValueFrom(ctx, types.StringType, r.Tags)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			item.Tags = tags
-		} else {
-			item.Tags = types.ListNull(types.StringType)
-		}
-
-		data.Resources[i] = item
-	}
+	data.Resources = resourceItemsFromAPI(ctx, resources, &resp.Diagnostics)
+	data.Count = types.Int64Value(int64(len(data.Resources)))
 
 	data.ID = types.StringValue("mcp_resources")
 
@@ -185,3 +185,35 @@ func (d *MCPResourcesDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// resourceItemsFromAPI maps the listed resources to item models. Elements that fail
+// to map are omitted with a warning so the remaining results are still returned.
+func resourceItemsFromAPI(ctx context.Context, resources []client.Resource, diagnostics *diag.Diagnostics) []MCPResourceItemModel {
+	return mapItems(ctx, resources, "resource", func(r client.Resource) string { return r.ID }, resourceItemFromAPI, diagnostics)
+}
+
+// resourceItemFromAPI maps a single resource to its item model.
+func resourceItemFromAPI(ctx context.Context, r client.Resource) (MCPResourceItemModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	item := MCPResourceItemModel{
+		ID:          types.StringValue(r.ID),
+		URI:         types.StringValue(r.URI),
+		Name:        types.StringValue(r.Name),
+		Description: types.StringValue(derefString(r.Description)),
+		MimeType:    types.StringValue(r.MimeType),
+		IsActive:    types.BoolValue(r.IsActive),
+		Visibility:  types.StringValue(derefString(r.Visibility)),
+		CreatedAt:   types.StringValue(r.CreatedAt),
+		UpdatedAt:   types.StringValue(r.UpdatedAt),
+	}
+
+	tags, listDiags := stringListValue(ctx, r.Tags)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return item, diags
+	}
+	item.Tags = tags
+
+	return item, diags
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+func TestAccMCPResourcesDataSource_MimeType(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/resources" || r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		// The mock ignores the mime_type parameter, so filtering must happen
+		// in the provider.
+		resources := []client.Resource{
+			{ID: "r-1", URI: "file:///a.json", Name: "a", MimeType: "application/json"},
+			{ID: "r-2", URI: "file:///b.txt", Name: "b", MimeType: "text/plain"},
+			{ID: "r-3", URI: "file:///c.json", Name: "c", MimeType: "application/json"},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resources); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer mockServer.Close()
+
+	providerConfig := `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "contextforge_mcp_resources" "test" {
+  mime_type = "application/json"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.contextforge_mcp_resources.test",
+						tfjsonpath.New("count"),
+						knownvalue.Int64Exact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_mcp_resources.test",
+						tfjsonpath.New("resources").AtSliceIndex(1).AtMapKey("id"),
+						knownvalue.StringExact("r-3"),
+					),
+				},
+			},
+			{
+				Config: providerConfig + `
+data "contextforge_mcp_resources" "test" {
+  mime_type = "image/png"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.contextforge_mcp_resources.test",
+						tfjsonpath.New("count"),
+						knownvalue.Int64Exact(0),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_mcp_resources.test",
+						tfjsonpath.New("resources"),
+						knownvalue.ListSizeExact(0),
+					),
+				},
+			},
+		},
+	})
+}
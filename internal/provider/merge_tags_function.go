@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = MergeTagsFunction{}
+)
+
+func NewMergeTagsFunction() function.Function {
+	return MergeTagsFunction{}
+}
+
+// MergeTagsFunction combines two tag lists, e.g. a module's base tags and the
+// tags of a single resource, into one sorted list without duplicates.
+type MergeTagsFunction struct{}
+
+func (r MergeTagsFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "merge_tags"
+}
+
+func (r MergeTagsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Merge two tag lists",
+		MarkdownDescription: "Concatenates two tag lists and returns the distinct tags in sorted order, so composing base tags with per-resource tags " +
+			"gives the same result regardless of order or repetition. Tags are trimmed and blank tags are dropped. A `null` list counts as empty.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "tags",
+				MarkdownDescription: "First list of tags, e.g. the base tags of a module.",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+			},
+			function.ListParameter{
+				Name:                "more_tags",
+				MarkdownDescription: "Second list of tags, e.g. the tags of a single resource.",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (r MergeTagsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tags, moreTags types.List
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tags, &moreTags))
+
+	if resp.Error != nil {
+		return
+	}
+
+	var values []string
+	for _, list := range []types.List{tags, moreTags} {
+		for _, elem := range list.Elements() {
+			if tag, ok := elem.(types.String); ok && !tag.IsNull() && !tag.IsUnknown() {
+				values = append(values, tag.ValueString())
+			}
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, mergeTags(values)))
+}
+
+// mergeTags returns the distinct tags, normalized as normalizeTags does, in
+// sorted order. The result is never nil.
+func mergeTags(tags []string) []string {
+	merged := normalizeTags(tags, false)
+	if merged == nil {
+		merged = []string{}
+	}
+	slices.Sort(merged)
+	return merged
+}
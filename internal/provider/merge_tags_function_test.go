@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMergeTagsFunction_Run(t *testing.T) {
+	ctx := context.Background()
+	list := func(tags ...string) types.List {
+		values := make([]attr.Value, len(tags))
+		for i, tag := range tags {
+			values[i] = types.StringValue(tag)
+		}
+		return types.ListValueMust(types.StringType, values)
+	}
+	null := types.ListNull(types.StringType)
+
+	cases := map[string]struct {
+		tags, moreTags types.List
+		want           types.List
+	}{
+		"concatenates and sorts":     {tags: list("team-a", "prod"), moreTags: list("billing"), want: list("billing", "prod", "team-a")},
+		"duplicates across lists":    {tags: list("prod", "team-a"), moreTags: list("team-a", "prod"), want: list("prod", "team-a")},
+		"duplicates within a list":   {tags: list("prod", "prod"), moreTags: list(), want: list("prod")},
+		"trims and drops blank tags": {tags: list(" prod", ""), moreTags: list("prod ", "  "), want: list("prod")},
+		"keeps case":                 {tags: list("Prod"), moreTags: list("prod"), want: list("Prod", "prod")},
+		"first list empty":           {tags: list(), moreTags: list("b", "a"), want: list("a", "b")},
+		"both lists empty":           {tags: list(), moreTags: list(), want: list()},
+		"null lists":                 {tags: null, moreTags: null, want: list()},
+		"null and non-empty":         {tags: null, moreTags: list("prod"), want: list("prod")},
+		"null elements": {
+			tags:     types.ListValueMust(types.StringType, []attr.Value{types.StringNull(), types.StringValue("prod")}),
+			moreTags: list(),
+			want:     list("prod"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.ListUnknown(types.StringType)),
+			}
+			MergeTagsFunction{}.Run(ctx, function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{tc.tags, tc.moreTags}),
+			}, resp)
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if got := resp.Result.Value(); !got.Equal(tc.want) {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+var _ datasource.DataSource = &MetricsDataSource{}
+
+func NewMetricsDataSource() datasource.DataSource {
+	return &MetricsDataSource{}
+}
+
+// MetricsDataSource reads aggregate metrics from the MCP Gateway.
+type MetricsDataSource struct {
+	client *client.Client
+}
+
+// MetricsDataSourceModel describes the data source data model.
+type MetricsDataSourceModel struct {
+	TotalTools       types.Int64  `tfsdk:"total_tools"`
+	TotalServers     types.Int64  `tfsdk:"total_servers"`
+	TotalGateways    types.Int64  `tfsdk:"total_gateways"`
+	RequestsLastHour types.Int64  `tfsdk:"requests_last_hour"`
+	ID               types.String `tfsdk:"id"`
+}
+
+func (d *MetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metrics"
+}
+
+func (d *MetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads aggregate metrics from the ContextForge MCP Gateway.",
+		Attributes: map[string]schema.Attribute{
+			"total_tools": schema.Int64Attribute{
+				MarkdownDescription: "Number of tools registered on the gateway.",
+				Computed:            true,
+			},
+			"total_servers": schema.Int64Attribute{
+				MarkdownDescription: "Number of virtual servers on the gateway.",
+				Computed:            true,
+			},
+			"total_gateways": schema.Int64Attribute{
+				MarkdownDescription: "Number of federated gateways registered on the gateway.",
+				Computed:            true,
+			},
+			"requests_last_hour": schema.Int64Attribute{
+				MarkdownDescription: "Number of requests the gateway handled in the last hour.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Placeholder identifier.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *MetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *MetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MetricsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metrics, err := d.client.GetMetrics(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read metrics", err)
+		return
+	}
+
+	data.TotalTools = types.Int64Value(metrics.TotalTools)
+	data.TotalServers = types.Int64Value(metrics.TotalServers)
+	data.TotalGateways = types.Int64Value(metrics.TotalGateways)
+	data.RequestsLastHour = types.Int64Value(metrics.RequestsLastHour)
+	data.ID = types.StringValue("metrics")
+
+	tflog.Trace(ctx, "read metrics data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
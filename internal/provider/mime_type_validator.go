@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = mimeTypeValidator{}
+
+// mimeTypeNameRegexp matches a type or subtype name as defined by RFC 6838.
+var mimeTypeNameRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9!#$&^_.+-]{0,126}$`)
+
+// knownMIMETopLevelTypes are the top-level media types registered with IANA.
+var knownMIMETopLevelTypes = map[string]bool{
+	"application": true,
+	"audio":       true,
+	"font":        true,
+	"image":       true,
+	"message":     true,
+	"model":       true,
+	"multipart":   true,
+	"text":        true,
+	"video":       true,
+}
+
+// mimeTypeValidator rejects values that are not of the form type/subtype and
+// warns when the top-level type is not a registered one, which usually
+// indicates a typo such as "aplication/json".
+type mimeTypeValidator struct{}
+
+func (v mimeTypeValidator) Description(ctx context.Context) string {
+	return "value must be a MIME type of the form type/subtype"
+}
+
+func (v mimeTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a MIME type of the form `type/subtype`"
+}
+
+func (v mimeTypeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	mediaType, _, err := mime.ParseMediaType(value)
+	typ, subtype, found := strings.Cut(mediaType, "/")
+	if err != nil || !found || !mimeTypeNameRegexp.MatchString(typ) || !mimeTypeNameRegexp.MatchString(subtype) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid MIME Type",
+			fmt.Sprintf("%q is not a valid MIME type. Expected the form type/subtype, e.g. \"application/json\".", value),
+		)
+		return
+	}
+
+	if !knownMIMETopLevelTypes[typ] {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Unknown MIME Type",
+			fmt.Sprintf("%q has an unregistered top-level type %q. Check the value for typos.", value, typ),
+		)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMIMETypeValidator(t *testing.T) {
+	cases := map[string]struct {
+		value        types.String
+		wantErrors   int
+		wantWarnings int
+	}{
+		"json":             {value: types.StringValue("application/json")},
+		"vendor":           {value: types.StringValue("application/vnd.api+json")},
+		"with-parameter":   {value: types.StringValue("text/plain; charset=utf-8")},
+		"uppercase":        {value: types.StringValue("Text/Markdown")},
+		"null":             {value: types.StringNull()},
+		"unknown":          {value: types.StringUnknown()},
+		"typo-type":        {value: types.StringValue("aplication/json"), wantWarnings: 1},
+		"missing-subtype":  {value: types.StringValue("application"), wantErrors: 1},
+		"empty-subtype":    {value: types.StringValue("application/"), wantErrors: 1},
+		"extra-slash":      {value: types.StringValue("application/json/x"), wantErrors: 1},
+		"whitespace":       {value: types.StringValue("application/ json"), wantErrors: 1},
+		"empty":            {value: types.StringValue(""), wantErrors: 1},
+		"malformed-params": {value: types.StringValue("text/plain; charset"), wantErrors: 1},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attrPath := path.Root("mime_type")
+			req := validator.StringRequest{
+				Path:        attrPath,
+				ConfigValue: tc.value,
+			}
+			resp := &validator.StringResponse{}
+
+			mimeTypeValidator{}.ValidateString(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tc.wantErrors {
+				t.Errorf("expected %d errors, got %d: %v", tc.wantErrors, got, resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount(); got != tc.wantWarnings {
+				t.Errorf("expected %d warnings, got %d: %v", tc.wantWarnings, got, resp.Diagnostics)
+			}
+			for _, d := range resp.Diagnostics {
+				withPath, ok := d.(diag.DiagnosticWithPath)
+				if !ok {
+					t.Fatalf("expected attribute-pathed diagnostic, got %T", d)
+				}
+				if !withPath.Path().Equal(attrPath) {
+					t.Errorf("expected path %s, got %s", attrPath, withPath.Path())
+				}
+			}
+		})
+	}
+}
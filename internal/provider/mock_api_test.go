@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+// testAccMockAPI is an in-memory stand-in for the gateway REST API, for
+// acceptance tests that need more than a single create and read. Objects are
+// kept as decoded JSON, so any collection can be served without a handler
+// written for its resource, and every request is recorded so tests can assert
+// on what the provider sent.
+type testAccMockAPI struct {
+	mu          sync.Mutex
+	collections map[string]*testAccMockCollection
+	requests    []testAccMockRequest
+}
+
+// testAccMockCollection holds the objects served under one collection path.
+type testAccMockCollection struct {
+	idPrefix string
+	hidden   []string
+	objects  map[string]map[string]interface{}
+	nextID   int
+}
+
+// testAccMockRequest is a request received by a testAccMockAPI.
+type testAccMockRequest struct {
+	Method string
+	Path   string
+	Body   map[string]interface{}
+}
+
+// stringPointer returns a pointer to s, for the optional string fields of the
+// client types.
+func stringPointer(s string) *string {
+	return &s
+}
+
+func newTestAccMockAPI() *testAccMockAPI {
+	return &testAccMockAPI{collections: map[string]*testAccMockCollection{}}
+}
+
+// Collection serves a collection under /name, assigning created objects the
+// IDs idPrefix-1, idPrefix-2, and so on. Fields listed in hidden are stored
+// but never returned, the way the gateway treats credentials such as a
+// gateway's auth_value.
+func (m *testAccMockAPI) Collection(name, idPrefix string, hidden ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.collections[name] = &testAccMockCollection{
+		idPrefix: idPrefix,
+		hidden:   hidden,
+		objects:  map[string]map[string]interface{}{},
+	}
+}
+
+// Server starts an HTTP server backed by the mock and closes it when the test
+// finishes.
+func (m *testAccMockAPI) Server(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(m)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// Requests returns the recorded requests with the given method and path.
+func (m *testAccMockAPI) Requests(method, path string) []testAccMockRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var matched []testAccMockRequest
+	for _, req := range m.requests {
+		if req.Method == method && req.Path == path {
+			matched = append(matched, req)
+		}
+	}
+	return matched
+}
+
+// ServeHTTP implements create, list, get, replace (PUT), patch, and delete for
+// the registered collections. PUT and PATCH both merge the request body into
+// the stored object, since the provider omits empty fields from updates.
+func (m *testAccMockAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var body map[string]interface{}
+	if r.ContentLength != 0 && r.Method != http.MethodGet && r.Method != http.MethodDelete {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	m.requests = append(m.requests, testAccMockRequest{Method: r.Method, Path: r.URL.Path, Body: body})
+
+	name, id, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	collection, ok := m.collections[name]
+	if !ok || strings.Contains(id, "/") {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if id == "" {
+		switch r.Method {
+		case http.MethodGet:
+			items := make([]map[string]interface{}, 0, len(collection.objects))
+			for _, key := range slices.Sorted(maps.Keys(collection.objects)) {
+				items = append(items, collection.visible(collection.objects[key]))
+			}
+			testAccMockWrite(w, http.StatusOK, items)
+		case http.MethodPost:
+			collection.nextID++
+			obj := maps.Clone(body)
+			if obj == nil {
+				obj = map[string]interface{}{}
+			}
+			obj["id"] = fmt.Sprintf("%s-%d", collection.idPrefix, collection.nextID)
+			obj["created_at"] = "2025-01-01T00:00:00Z"
+			obj["updated_at"] = "2025-01-01T00:00:00Z"
+			collection.objects[obj["id"].(string)] = obj
+			testAccMockWrite(w, http.StatusCreated, collection.visible(obj))
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	obj, ok := collection.objects[id]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		testAccMockWrite(w, http.StatusOK, collection.visible(obj))
+	case http.MethodPut, http.MethodPatch:
+		maps.Copy(obj, body)
+		obj["id"] = id
+		obj["updated_at"] = fmt.Sprintf("2025-01-02T00:00:%02dZ", len(m.requests)%60)
+		testAccMockWrite(w, http.StatusOK, collection.visible(obj))
+	case http.MethodDelete:
+		delete(collection.objects, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// visible returns a copy of obj without the collection's hidden fields.
+func (c *testAccMockCollection) visible(obj map[string]interface{}) map[string]interface{} {
+	out := maps.Clone(obj)
+	for _, field := range c.hidden {
+		delete(out, field)
+	}
+	return out
+}
+
+func testAccMockWrite(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func TestTestAccMockAPI(t *testing.T) {
+	api := newTestAccMockAPI()
+	api.Collection("gateways", "gw", "auth_value")
+	c := client.NewClient(api.Server(t).URL, "test")
+	ctx := context.Background()
+
+	created, err := c.CreateGateway(ctx, client.GatewayCreate{
+		Name:      "test-gw",
+		URL:       "https://example.com/mcp",
+		AuthType:  "bearer",
+		AuthValue: "secret-token",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.ID != "gw-1" || created.AuthType != "bearer" || created.AuthValue != "" {
+		t.Errorf("unexpected created gateway: %+v", created)
+	}
+
+	updated, err := c.UpdateGateway(ctx, "gw-1", client.GatewayUpdate{Description: "second"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.Name != "test-gw" || derefString(updated.Description) != "second" || updated.UpdatedAt == created.UpdatedAt {
+		t.Errorf("expected the update to be merged into the stored gateway, got %+v", updated)
+	}
+
+	if puts := api.Requests(http.MethodPut, "/gateways/gw-1"); len(puts) != 1 || puts[0].Body["description"] != "second" {
+		t.Errorf("expected the PUT request to be recorded, got %+v", puts)
+	}
+
+	if err := c.DeleteGateway(ctx, "gw-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	gateway, err := c.GetGateway(ctx, "gw-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gateway != nil {
+		t.Errorf("expected the gateway to be deleted, got %+v", gateway)
+	}
+}
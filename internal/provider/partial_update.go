@@ -0,0 +1,28 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+// updateWithPatch sends only the fields that differ between the update
+// requests built from the prior state and from the plan, so fields the
+// gateway manages out-of-band are left alone. When the gateway does not
+// support PATCH it falls back to a full update with put.
+func updateWithPatch[U, T any](prior, planned U, patch func(map[string]json.RawMessage) (*T, error), put func(U) (*T, error)) (*T, error) {
+	changes, err := client.MergePatch(prior, planned)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := patch(changes)
+	if errors.Is(err, client.ErrPatchNotSupported) {
+		return put(planned)
+	}
+	return obj, err
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+func TestUpdateWithPatch(t *testing.T) {
+	prior := client.PromptUpdate{Name: "greet", Description: "Old"}
+	planned := client.PromptUpdate{Name: "greet", Description: "New"}
+
+	var sent map[string]json.RawMessage
+	prompt, err := updateWithPatch(prior, planned,
+		func(changes map[string]json.RawMessage) (*client.Prompt, error) {
+			sent = changes
+			return &client.Prompt{ID: "p-1", Description: stringPointer("New")}, nil
+		},
+		func(client.PromptUpdate) (*client.Prompt, error) {
+			t.Fatal("expected no fallback to PUT")
+			return nil, nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if derefString(prompt.Description) != "New" {
+		t.Errorf("expected description New, got %s", derefString(prompt.Description))
+	}
+	if len(sent) != 1 || string(sent["description"]) != `"New"` {
+		t.Errorf("expected only description to be patched, got %v", sent)
+	}
+}
+
+func TestUpdateWithPatch_FallsBackToPut(t *testing.T) {
+	planned := client.PromptUpdate{Name: "greet", Description: "New"}
+
+	var put *client.PromptUpdate
+	_, err := updateWithPatch(client.PromptUpdate{Name: "greet"}, planned,
+		func(map[string]json.RawMessage) (*client.Prompt, error) {
+			return nil, client.ErrPatchNotSupported
+		},
+		func(full client.PromptUpdate) (*client.Prompt, error) {
+			put = &full
+			return &client.Prompt{ID: "p-1"}, nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if put == nil || put.Description != "New" {
+		t.Errorf("expected the full planned update to be sent with PUT, got %+v", put)
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+var _ resource.Resource = &PassthroughConfigResource{}
+var _ resource.ResourceWithImportState = &PassthroughConfigResource{}
+
+// passthroughConfigID is the fixed ID of the passthrough config singleton.
+const passthroughConfigID = "passthrough_config"
+
+func NewPassthroughConfigResource() resource.Resource {
+	return &PassthroughConfigResource{}
+}
+
+// PassthroughConfigResource manages the gateway-wide passthrough header
+// allowlist. The gateway has exactly one, so the resource is a singleton.
+type PassthroughConfigResource struct {
+	client   *client.Client
+	settings providerSettings
+}
+
+// PassthroughConfigResourceModel describes the resource data model.
+type PassthroughConfigResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	AllowedHeaders types.Set    `tfsdk:"allowed_headers"`
+}
+
+func (r *PassthroughConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_passthrough_config"
+}
+
+func (r *PassthroughConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the global passthrough header allowlist of the ContextForge MCP Gateway, which applies to " +
+			"every gateway that does not set its own `passthrough_headers`. The gateway has a single allowlist, so " +
+			"declare at most one instance of this resource. Destroying it clears the allowlist.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Always `passthrough_config`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allowed_headers": schema.SetAttribute{
+				MarkdownDescription: "Names of the request headers the gateway may pass through to upstream servers. Header names are " +
+					"case-insensitive. Hop-by-hop headers (`Connection`, `Keep-Alive`, `Transfer-Encoding`) are rejected, and " +
+					"credential-bearing headers (`Authorization`, `Cookie`) produce a warning.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(passthroughHeaderValidator{warnSensitive: true}),
+				},
+			},
+		},
+	}
+}
+
+func (r *PassthroughConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.client
+	r.settings = data.settings
+}
+
+func (r *PassthroughConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if readOnlyBlocked(r.settings, "create passthrough config", &resp.Diagnostics) {
+		return
+	}
+
+	var data PassthroughConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.put(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created a passthrough config resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PassthroughConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PassthroughConfigResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetPassthroughConfig(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read passthrough config", err)
+		return
+	}
+
+	passthroughConfigToModel(ctx, config, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PassthroughConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if readOnlyBlocked(r.settings, "update passthrough config", &resp.Diagnostics) {
+		return
+	}
+
+	var data PassthroughConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.put(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a passthrough config resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete clears the allowlist, since the singleton itself cannot be removed.
+func (r *PassthroughConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if readOnlyBlocked(r.settings, "delete passthrough config", &resp.Diagnostics) {
+		return
+	}
+
+	if _, err := r.client.UpdatePassthroughConfig(ctx, client.PassthroughConfig{}); err != nil {
+		addClientError(&resp.Diagnostics, "clear passthrough config", err)
+		return
+	}
+}
+
+// ImportState adopts the current allowlist. The import ID is not used; by
+// convention it is "passthrough_config".
+func (r *PassthroughConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), passthroughConfigID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allowed_headers"), types.SetNull(types.StringType))...)
+}
+
+// put replaces the allowlist with the planned headers and maps the result
+// back into data.
+func (r *PassthroughConfigResource) put(ctx context.Context, data *PassthroughConfigResourceModel, diagnostics *diag.Diagnostics) {
+	var headers []string
+	diagnostics.Append(data.AllowedHeaders.ElementsAs(ctx, &headers, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.UpdatePassthroughConfig(ctx, client.PassthroughConfig{PassthroughHeaders: headers})
+	if err != nil {
+		addClientError(diagnostics, "update passthrough config", err)
+		return
+	}
+
+	passthroughConfigToModel(ctx, config, data, diagnostics)
+}
+
+// passthroughConfigToModel maps the gateway's allowlist into data. The gateway
+// may normalize the case of header names, so a returned header that matches a
+// header already in data apart from case keeps the spelling in data and does
+// not show up as a change.
+func passthroughConfigToModel(ctx context.Context, config *client.PassthroughConfig, data *PassthroughConfigResourceModel, diagnostics *diag.Diagnostics) {
+	var prior []string
+	if !data.AllowedHeaders.IsNull() && !data.AllowedHeaders.IsUnknown() {
+		diagnostics.Append(data.AllowedHeaders.ElementsAs(ctx, &prior, false)...)
+		if diagnostics.HasError() {
+			return
+		}
+	}
+
+	headers := make([]string, 0, len(config.PassthroughHeaders))
+	for _, header := range config.PassthroughHeaders {
+		for _, p := range prior {
+			if strings.EqualFold(p, header) {
+				header = p
+				break
+			}
+		}
+		headers = append(headers, header)
+	}
+
+	allowed, setDiags := types.SetValueFrom(ctx, types.StringType, headers)
+	diagnostics.Append(setDiags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(passthroughConfigID)
+	data.AllowedHeaders = allowed
+}
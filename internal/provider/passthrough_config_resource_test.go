@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+func TestPassthroughConfigToModel(t *testing.T) {
+	ctx := context.Background()
+
+	prior, diags := types.SetValueFrom(ctx, types.StringType, []string{"x-tenant-id", "X-Trace-ID"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	data := PassthroughConfigResourceModel{AllowedHeaders: prior}
+
+	passthroughConfigToModel(ctx, &client.PassthroughConfig{
+		PassthroughHeaders: []string{"X-Tenant-Id", "X-Trace-ID", "X-Request-ID"},
+	}, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var got []string
+	diags.Append(data.AllowedHeaders.ElementsAs(ctx, &got, false)...)
+	slices.Sort(got)
+	if want := []string{"X-Request-ID", "X-Trace-ID", "x-tenant-id"}; !slices.Equal(got, want) {
+		t.Errorf("expected headers %v, got %v", want, got)
+	}
+	if data.ID.ValueString() != passthroughConfigID {
+		t.Errorf("expected ID %q, got %s", passthroughConfigID, data.ID)
+	}
+}
+
+func TestPassthroughConfigToModel_Empty(t *testing.T) {
+	ctx := context.Background()
+	data := PassthroughConfigResourceModel{AllowedHeaders: types.SetNull(types.StringType)}
+
+	var diags diag.Diagnostics
+	passthroughConfigToModel(ctx, &client.PassthroughConfig{}, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.AllowedHeaders.IsNull() || len(data.AllowedHeaders.Elements()) != 0 {
+		t.Errorf("expected an empty set, got %s", data.AllowedHeaders)
+	}
+}
+
+func TestAccPassthroughConfigResource(t *testing.T) {
+	var mu sync.Mutex
+	headers := []string{"X-Preexisting"}
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.URL.Path != "/config/passthrough" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			var req client.PassthroughConfig
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			headers = req.PassthroughHeaders
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		testAccMockWrite(w, http.StatusOK, client.PassthroughConfig{PassthroughHeaders: headers})
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPassthroughConfigResourceConfig(mockServer.URL, `"X-Tenant-ID", "Connection"`),
+				ExpectError: regexp.MustCompile(`Invalid Passthrough Header`),
+			},
+			{
+				Config: testAccPassthroughConfigResourceConfig(mockServer.URL, `"X-Tenant-ID"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_passthrough_config.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("passthrough_config"),
+					),
+					statecheck.ExpectKnownValue(
+						"contextforge_passthrough_config.test",
+						tfjsonpath.New("allowed_headers"),
+						knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("X-Tenant-ID")}),
+					),
+				},
+			},
+			{
+				Config: testAccPassthroughConfigResourceConfig(mockServer.URL, `"X-Tenant-ID", "X-Trace-ID"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_passthrough_config.test",
+						tfjsonpath.New("allowed_headers"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("X-Tenant-ID"),
+							knownvalue.StringExact("X-Trace-ID"),
+						}),
+					),
+				},
+			},
+			{
+				ResourceName:      "contextforge_passthrough_config.test",
+				ImportState:       true,
+				ImportStateId:     "passthrough_config",
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: func(*terraform.State) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if len(headers) != 0 {
+				return fmt.Errorf("expected destroy to clear the allowlist, got %v", headers)
+			}
+			return nil
+		},
+	})
+}
+
+func testAccPassthroughConfigResourceConfig(endpoint, headers string) string {
+	return fmt.Sprintf(`
+provider "contextforge" {
+  endpoint     = %q
+  bearer_token = "test"
+}
+
+resource "contextforge_passthrough_config" "test" {
+  allowed_headers = [%s]
+}
+`, endpoint, headers)
+}
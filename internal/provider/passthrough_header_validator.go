@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = passthroughHeaderValidator{}
+
+// hopByHopHeaders are connection-scoped headers that must never be forwarded
+// by a proxy and are rejected by the gateway.
+var hopByHopHeaders = map[string]bool{
+	"Connection":        true,
+	"Keep-Alive":        true,
+	"Transfer-Encoding": true,
+}
+
+// sensitivePassthroughHeaders are headers that are allowed but leak
+// credentials to the upstream gateway when forwarded.
+var sensitivePassthroughHeaders = map[string]bool{
+	"Authorization": true,
+	"Cookie":        true,
+}
+
+// passthroughHeaderValidator rejects hop-by-hop headers in a gateway's
+// passthrough header list and, with warnSensitive, warns about
+// credential-bearing headers.
+type passthroughHeaderValidator struct {
+	warnSensitive bool
+}
+
+func (v passthroughHeaderValidator) Description(ctx context.Context) string {
+	return "header must not be a hop-by-hop header (Connection, Keep-Alive, Transfer-Encoding)"
+}
+
+func (v passthroughHeaderValidator) MarkdownDescription(ctx context.Context) string {
+	return "header must not be a hop-by-hop header (`Connection`, `Keep-Alive`, `Transfer-Encoding`)"
+}
+
+func (v passthroughHeaderValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	header := http.CanonicalHeaderKey(req.ConfigValue.ValueString())
+
+	if hopByHopHeaders[header] {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Passthrough Header",
+			fmt.Sprintf("%q is a hop-by-hop header and cannot be passed through the gateway.", req.ConfigValue.ValueString()),
+		)
+		return
+	}
+
+	if v.warnSensitive && sensitivePassthroughHeaders[header] {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Sensitive Passthrough Header",
+			fmt.Sprintf("%q carries credentials and will be forwarded to the upstream gateway. Make sure this is intended.", req.ConfigValue.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPassthroughHeaderValidator(t *testing.T) {
+	cases := map[string]struct {
+		value        types.String
+		quiet        bool
+		wantErrors   int
+		wantWarnings int
+	}{
+		"allowed":             {value: types.StringValue("X-Tenant-Id")},
+		"null":                {value: types.StringNull()},
+		"unknown":             {value: types.StringUnknown()},
+		"connection":          {value: types.StringValue("Connection"), wantErrors: 1},
+		"keep-alive-lower":    {value: types.StringValue("keep-alive"), wantErrors: 1},
+		"transfer-encoding":   {value: types.StringValue("TRANSFER-ENCODING"), wantErrors: 1},
+		"authorization":       {value: types.StringValue("Authorization"), wantWarnings: 1},
+		"cookie-lower":        {value: types.StringValue("cookie"), wantWarnings: 1},
+		"authorization-quiet": {value: types.StringValue("Authorization"), quiet: true},
+		"connection-quiet":    {value: types.StringValue("Connection"), quiet: true, wantErrors: 1},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attrPath := path.Root("passthrough_headers").AtListIndex(0)
+			req := validator.StringRequest{
+				Path:        attrPath,
+				ConfigValue: tc.value,
+			}
+			resp := &validator.StringResponse{}
+
+			passthroughHeaderValidator{warnSensitive: !tc.quiet}.ValidateString(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tc.wantErrors {
+				t.Errorf("expected %d errors, got %d: %v", tc.wantErrors, got, resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount(); got != tc.wantWarnings {
+				t.Errorf("expected %d warnings, got %d: %v", tc.wantWarnings, got, resp.Diagnostics)
+			}
+			for _, d := range resp.Diagnostics {
+				withPath, ok := d.(diag.DiagnosticWithPath)
+				if !ok {
+					t.Fatalf("expected attribute-pathed diagnostic, got %T", d)
+				}
+				if !withPath.Path().Equal(attrPath) {
+					t.Errorf("expected path %s, got %s", attrPath, withPath.Path())
+				}
+			}
+		})
+	}
+}
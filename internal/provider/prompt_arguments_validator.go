@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+var _ validator.String = promptArgumentsValidator{}
+
+// promptArgumentsValidator rejects prompt arguments without a name. The
+// gateway accepts them, but rendering the prompt then fails with an error
+// that does not point at the argument, e.g. for a required argument that can
+// never be supplied. Malformed JSON is left to Create and Update, which
+// report it when decoding the arguments.
+type promptArgumentsValidator struct{}
+
+func (v promptArgumentsValidator) Description(ctx context.Context) string {
+	return "every argument must have a non-empty name"
+}
+
+func (v promptArgumentsValidator) MarkdownDescription(ctx context.Context) string {
+	return "every argument must have a non-empty `name`"
+}
+
+func (v promptArgumentsValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var arguments []client.PromptArgument
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &arguments); err != nil {
+		return
+	}
+
+	for i, argument := range arguments {
+		if strings.TrimSpace(argument.Name) != "" {
+			continue
+		}
+		detail := fmt.Sprintf("The argument at index %d has an empty name. Every prompt argument needs a name, which is how its value is supplied when rendering the prompt.", i)
+		if argument.Required {
+			detail = fmt.Sprintf("The required argument at index %d has an empty name, so the prompt could never be rendered. Every prompt argument needs a name.", i)
+		}
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Prompt Argument", detail)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPromptArgumentsValidator(t *testing.T) {
+	cases := map[string]struct {
+		value      types.String
+		wantErrors int
+		wantDetail string
+	}{
+		"named":          {value: types.StringValue(`[{"name": "topic", "required": true}, {"name": "tone"}]`)},
+		"empty-array":    {value: types.StringValue(`[]`)},
+		"null":           {value: types.StringNull()},
+		"unknown":        {value: types.StringUnknown()},
+		"malformed-json": {value: types.StringValue(`[{"name": `)},
+		"blank-required": {
+			value:      types.StringValue(`[{"name": "topic"}, {"name": "  ", "required": true}]`),
+			wantErrors: 1,
+			wantDetail: "required argument at index 1",
+		},
+		"missing-name": {
+			value:      types.StringValue(`[{"description": "no name"}]`),
+			wantErrors: 1,
+			wantDetail: "argument at index 0",
+		},
+		"several": {
+			value:      types.StringValue(`[{"name": ""}, {"name": "ok"}, {"name": "", "required": true}]`),
+			wantErrors: 2,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("arguments"),
+				ConfigValue: tc.value,
+			}
+			resp := &validator.StringResponse{}
+
+			promptArgumentsValidator{}.ValidateString(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tc.wantErrors {
+				t.Fatalf("expected %d errors, got %d: %v", tc.wantErrors, got, resp.Diagnostics)
+			}
+			if tc.wantDetail != "" && !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tc.wantDetail) {
+				t.Errorf("expected the error to mention %q, got %q", tc.wantDetail, resp.Diagnostics.Errors()[0].Detail())
+			}
+		})
+	}
+}
@@ -37,6 +37,7 @@ type PromptDataSourceModel struct {
 	Visibility  types.String `tfsdk:"visibility"`
 	CreatedAt   types.String `tfsdk:"created_at"`
 	UpdatedAt   types.String `tfsdk:"updated_at"`
+	CreatedBy   types.String `tfsdk:"created_by"`
 }
 
 func (d *PromptDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -84,6 +85,10 @@ func (d *PromptDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				MarkdownDescription: "Timestamp when the prompt was last updated.",
 				Computed:            true,
 			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "Principal that created the prompt, if reported by the gateway.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -93,16 +98,16 @@ func (d *PromptDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = apiClient
+	d.client = data.client
 }
 
 func (d *PromptDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -115,7 +120,7 @@ func (d *PromptDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	prompt, err := d.client.GetPrompt(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read prompt, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read prompt", err)
 		return
 	}
 	if prompt == nil {
@@ -125,12 +130,18 @@ func (d *PromptDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	data.ID = types.StringValue(prompt.ID)
 	data.Name = types.StringValue(prompt.Name)
-	data.Description = types.StringValue(prompt.Description)
+	data.Description = types.StringValue(derefString(prompt.Description))
 	data.IsActive = types.BoolValue(prompt.IsActive)
-	data.Visibility = types.StringValue(prompt.Visibility)
+	data.Visibility = types.StringValue(derefString(prompt.Visibility))
 	data.CreatedAt = types.StringValue(prompt.CreatedAt)
 	data.UpdatedAt = types.StringValue(prompt.UpdatedAt)
 
+	if prompt.CreatedBy != "" {
+		data.CreatedBy = types.StringValue(prompt.CreatedBy)
+	} else {
+		data.CreatedBy = types.StringNull()
+	}
+
 	if prompt.Arguments != nil {
 		argsJSON, err := json.Marshal(prompt.Arguments)
 		if err != nil {
@@ -142,16 +153,12 @@ func (d *PromptDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		data.Arguments = types.StringNull()
 	}
 
-	if prompt.Tags != nil {
-		tags, diags := types.ListValueFrom(ctx, types.StringType, prompt.Tags)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.Tags = tags
-	} else {
-		data.Tags = types.ListNull(types.StringType)
+	tags, diags := stringListValue(ctx, prompt.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.Tags = tags
 
 	tflog.Trace(ctx, "read prompt data source")
 
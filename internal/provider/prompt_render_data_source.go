@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+var _ datasource.DataSource = &PromptRenderDataSource{}
+
+func NewPromptRenderDataSource() datasource.DataSource {
+	return &PromptRenderDataSource{}
+}
+
+// PromptRenderDataSource renders a prompt with arguments on the MCP Gateway.
+type PromptRenderDataSource struct {
+	client *client.Client
+}
+
+// PromptRenderDataSourceModel describes the data source data model.
+type PromptRenderDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Arguments types.Map    `tfsdk:"arguments"`
+	Messages  types.String `tfsdk:"messages"`
+}
+
+func (d *PromptRenderDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prompt_render"
+}
+
+func (d *PromptRenderDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Renders a prompt from the ContextForge MCP Gateway with the given arguments, for previewing its output during plan.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Prompt identifier.",
+				Required:            true,
+			},
+			"arguments": schema.MapAttribute{
+				MarkdownDescription: "Values for the prompt's arguments, keyed by argument name. Every argument the prompt marks as required must be set.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"messages": schema.StringAttribute{
+				MarkdownDescription: "Rendered prompt messages, as a JSON-encoded array. Use `jsondecode` to access individual messages.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *PromptRenderDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *PromptRenderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PromptRenderDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := map[string]string{}
+	if !data.Arguments.IsNull() {
+		resp.Diagnostics.Append(data.Arguments.ElementsAs(ctx, &args, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	prompt, err := d.client.GetPrompt(ctx, data.ID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read prompt", err)
+		return
+	}
+	if prompt == nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Prompt with ID %s not found", data.ID.ValueString()))
+		return
+	}
+
+	if missing := missingPromptArguments(prompt.Arguments, args); len(missing) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("arguments"),
+			"Missing Prompt Arguments",
+			fmt.Sprintf("Prompt %q requires arguments that were not set: %s.", prompt.Name, strings.Join(missing, ", ")),
+		)
+		return
+	}
+
+	result, err := d.client.RenderPrompt(ctx, data.ID.ValueString(), args)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "render prompt", err)
+		return
+	}
+	if result == nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Prompt with ID %s not found", data.ID.ValueString()))
+		return
+	}
+
+	var messages bytes.Buffer
+	if err := json.Compact(&messages, result.Messages); err != nil {
+		resp.Diagnostics.AddError("Invalid Render Response", fmt.Sprintf("Unable to encode rendered messages: %s", err))
+		return
+	}
+	data.Messages = types.StringValue(messages.String())
+
+	tflog.Trace(ctx, "read prompt_render data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// missingPromptArguments returns the names of required arguments that have no
+// value in args, in declaration order.
+func missingPromptArguments(declared []client.PromptArgument, args map[string]string) []string {
+	var missing []string
+	for _, arg := range declared {
+		if !arg.Required {
+			continue
+		}
+		if _, ok := args[arg.Name]; !ok {
+			missing = append(missing, arg.Name)
+		}
+	}
+	return missing
+}
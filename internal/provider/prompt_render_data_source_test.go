@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+func testAccPromptRenderMockServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/prompts/prompt-1" && r.Method == http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(client.Prompt{
+				ID:   "prompt-1",
+				Name: "greeting",
+				Arguments: []client.PromptArgument{
+					{Name: "name", Required: true},
+					{Name: "tone"},
+				},
+			}); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/prompts/prompt-1" && r.Method == http.MethodPost:
+			var args map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&args); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if _, err := w.Write([]byte(`{"messages": [{"role": "user", "content": {"type": "text", "text": "Hello ` + args["name"] + `"}}]}`)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestAccPromptRenderDataSource(t *testing.T) {
+	mockServer := testAccPromptRenderMockServer(t)
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+data "contextforge_prompt_render" "test" {
+  id = "prompt-1"
+  arguments = {
+    name = "Ada"
+  }
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.contextforge_prompt_render.test",
+						tfjsonpath.New("messages"),
+						knownvalue.StringExact(`[{"role":"user","content":{"type":"text","text":"Hello Ada"}}]`),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccPromptRenderDataSource_MissingRequiredArgument(t *testing.T) {
+	mockServer := testAccPromptRenderMockServer(t)
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+data "contextforge_prompt_render" "test" {
+  id = "prompt-1"
+  arguments = {
+    tone = "formal"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`requires arguments that were not set: name`),
+			},
+		},
+	})
+}
+
+func TestMissingPromptArguments(t *testing.T) {
+	declared := []client.PromptArgument{
+		{Name: "name", Required: true},
+		{Name: "tone"},
+		{Name: "language", Required: true},
+	}
+
+	if got := missingPromptArguments(declared, map[string]string{"name": "Ada", "language": "en"}); got != nil {
+		t.Errorf("expected no missing arguments, got %v", got)
+	}
+	if got, want := missingPromptArguments(declared, map[string]string{"tone": "formal"}), []string{"name", "language"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected missing %v, got %v", want, got)
+	}
+}
@@ -23,6 +23,7 @@ import (
 
 var _ resource.Resource = &PromptResource{}
 var _ resource.ResourceWithImportState = &PromptResource{}
+var _ resource.ResourceWithModifyPlan = &PromptResource{}
 
 func NewPromptResource() resource.Resource {
 	return &PromptResource{}
@@ -30,20 +31,23 @@ func NewPromptResource() resource.Resource {
 
 // PromptResource manages a prompt on the MCP Gateway.
 type PromptResource struct {
-	client *client.Client
+	client   *client.Client
+	settings providerSettings
 }
 
 // PromptResourceModel describes the resource data model.
 type PromptResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Arguments   types.String `tfsdk:"arguments"`
-	Tags        types.List   `tfsdk:"tags"`
-	IsActive    types.Bool   `tfsdk:"is_active"`
-	Visibility  types.String `tfsdk:"visibility"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Arguments    types.String `tfsdk:"arguments"`
+	Tags         types.List   `tfsdk:"tags"`
+	IsActive     types.Bool   `tfsdk:"is_active"`
+	DeletionMode types.String `tfsdk:"deletion_mode"`
+	Visibility   types.String `tfsdk:"visibility"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+	CreatedBy    types.String `tfsdk:"created_by"`
 }
 
 func (r *PromptResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -71,12 +75,15 @@ func (r *PromptResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 			},
 			"arguments": schema.StringAttribute{
-				MarkdownDescription: "JSON-encoded arguments array for the prompt.",
+				MarkdownDescription: "JSON-encoded arguments array for the prompt. Every argument must have a non-empty `name`.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					promptArgumentsValidator{},
+				},
 			},
 			"tags": schema.ListAttribute{
-				MarkdownDescription: "Tags associated with the prompt.",
+				MarkdownDescription: "Tags associated with the prompt. Leaving this unset and setting it to `[]` are equivalent. Tags are trimmed and de-duplicated before they are sent, and lowercased when the provider sets `lowercase_tags`.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
@@ -85,12 +92,13 @@ func (r *PromptResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "Whether the prompt is active.",
 				Computed:            true,
 			},
+			"deletion_mode": deletionModeAttribute("prompt"),
 			"visibility": schema.StringAttribute{
-				MarkdownDescription: "Visibility of the prompt (e.g. `public`, `private`).",
+				MarkdownDescription: "Visibility of the prompt (e.g. `public`, `private`). Defaults to the provider's `default_visibility` when that is set.",
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("public", "private", "team"),
+					stringvalidator.OneOf(visibilityValues...),
 				},
 			},
 			"created_at": schema.StringAttribute{
@@ -101,6 +109,10 @@ func (r *PromptResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "Timestamp when the prompt was last updated.",
 				Computed:            true,
 			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "Principal that created the prompt, if reported by the gateway.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -110,19 +122,30 @@ func (r *PromptResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = apiClient
+	r.client = data.client
+	r.settings = data.settings
+}
+
+// ModifyPlan warns when the configured tags will be normalized before they
+// are sent to the gateway.
+func (r *PromptResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnTagNormalization(ctx, req.Config, r.settings.lowercaseTags, &resp.Diagnostics)
 }
 
 func (r *PromptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if readOnlyBlocked(r.settings, "create prompt", &resp.Diagnostics) {
+		return
+	}
+
 	var data PromptResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -130,12 +153,9 @@ func (r *PromptResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	var tags []string
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	tags := tagsFromModel(ctx, data.Tags, r.settings.lowercaseTags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	var arguments []client.PromptArgument
@@ -158,7 +178,7 @@ func (r *PromptResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	prompt, err := r.client.CreatePrompt(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create prompt, got error: %s", err))
+		addCreateError(&resp.Diagnostics, "create prompt", fmt.Sprintf("a prompt named %q", data.Name.ValueString()), err)
 		return
 	}
 
@@ -182,7 +202,7 @@ func (r *PromptResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	prompt, err := r.client.GetPrompt(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read prompt, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read prompt", err)
 		return
 	}
 	if prompt == nil {
@@ -199,63 +219,106 @@ func (r *PromptResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *PromptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data PromptResourceModel
+	if readOnlyBlocked(r.settings, "update prompt", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state PromptResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var tags []string
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	updateReq := promptUpdateFromModel(ctx, data, r.settings.lowercaseTags, &resp.Diagnostics)
+	priorReq := promptUpdateFromModel(ctx, state, r.settings.lowercaseTags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.ID.ValueString()
+	prompt, err := updateWithPatch(priorReq, updateReq,
+		func(changes map[string]json.RawMessage) (*client.Prompt, error) {
+			return r.client.PatchPrompt(ctx, id, changes)
+		},
+		func(full client.PromptUpdate) (*client.Prompt, error) {
+			return r.client.UpdatePrompt(ctx, id, full)
+		},
+	)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "update prompt", err)
+		return
 	}
 
+	r.promptToModel(ctx, prompt, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a prompt resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// promptUpdateFromModel builds the update request for the prompt described by
+// data.
+func promptUpdateFromModel(ctx context.Context, data PromptResourceModel, lowercase bool, diagnostics *diag.Diagnostics) client.PromptUpdate {
+	tags := tagsFromModel(ctx, data.Tags, lowercase, diagnostics)
+
 	var arguments []client.PromptArgument
 	if !data.Arguments.IsNull() && !data.Arguments.IsUnknown() && data.Arguments.ValueString() != "" {
 		if err := json.Unmarshal([]byte(data.Arguments.ValueString()), &arguments); err != nil {
-			resp.Diagnostics.AddError("Invalid Arguments", fmt.Sprintf("Unable to parse arguments JSON: %s", err))
-			return
+			diagnostics.AddError("Invalid Arguments", fmt.Sprintf("Unable to parse arguments JSON: %s", err))
 		}
 	}
 
-	updateReq := client.PromptUpdate{
+	return client.PromptUpdate{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
 		Arguments:   arguments,
 		Tags:        tags,
 	}
+}
 
-	prompt, err := r.client.UpdatePrompt(ctx, data.ID.ValueString(), updateReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update prompt, got error: %s", err))
+func (r *PromptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if readOnlyBlocked(r.settings, "delete prompt", &resp.Diagnostics) {
 		return
 	}
 
-	r.promptToModel(ctx, prompt, &data, &resp.Diagnostics)
+	var data PromptResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	tflog.Trace(ctx, "updated a prompt resource")
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
-
-func (r *PromptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data PromptResourceModel
+	if deactivateOnDelete(r.settings, data.DeletionMode) {
+		priorReq := promptUpdateFromModel(ctx, data, r.settings.lowercaseTags, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		deactivateReq := priorReq
+		deactivateReq.IsActive = new(bool)
 
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
+		id := data.ID.ValueString()
+		_, err := updateWithPatch(priorReq, deactivateReq,
+			func(changes map[string]json.RawMessage) (*client.Prompt, error) {
+				return r.client.PatchPrompt(ctx, id, changes)
+			},
+			func(full client.PromptUpdate) (*client.Prompt, error) {
+				return r.client.UpdatePrompt(ctx, id, full)
+			},
+		)
+		if err != nil {
+			addDeactivateError(&resp.Diagnostics, "deactivate prompt", err)
+		}
 		return
 	}
 
 	err := r.client.DeletePrompt(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete prompt, got error: %s", err))
+		addClientError(&resp.Diagnostics, "delete prompt", err)
 		return
 	}
 }
@@ -268,12 +331,18 @@ func (r *PromptResource) ImportState(ctx context.Context, req resource.ImportSta
 func (r *PromptResource) promptToModel(ctx context.Context, prompt *client.Prompt, data *PromptResourceModel, diagnostics *diag.Diagnostics) {
 	data.ID = types.StringValue(prompt.ID)
 	data.Name = types.StringValue(prompt.Name)
-	data.Description = types.StringValue(prompt.Description)
+	data.Description = stringOrPrior(prompt.Description, data.Description)
 	data.IsActive = types.BoolValue(prompt.IsActive)
-	data.Visibility = types.StringValue(prompt.Visibility)
+	data.Visibility = stringOrPrior(prompt.Visibility, data.Visibility)
 	data.CreatedAt = types.StringValue(prompt.CreatedAt)
 	data.UpdatedAt = types.StringValue(prompt.UpdatedAt)
 
+	if prompt.CreatedBy != "" {
+		data.CreatedBy = types.StringValue(prompt.CreatedBy)
+	} else {
+		data.CreatedBy = types.StringNull()
+	}
+
 	if prompt.Arguments != nil {
 		argumentsJSON, err := json.Marshal(prompt.Arguments)
 		if err != nil {
@@ -285,14 +354,10 @@ func (r *PromptResource) promptToModel(ctx context.Context, prompt *client.Promp
 		data.Arguments = types.StringNull()
 	}
 
-	if prompt.Tags != nil {
-		tagsList, diags := types.ListValueFrom(ctx, types.StringType, prompt.Tags)
-		diagnostics.Append(diags...)
-		if diagnostics.HasError() {
-			return
-		}
-		data.Tags = tagsList
-	} else {
-		data.Tags = types.ListNull(types.StringType)
+	tagsList, diags := normalizedTagsToModel(ctx, prompt.Tags, data.Tags, r.settings.lowercaseTags)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
 	}
+	data.Tags = tagsList
 }
@@ -4,12 +4,15 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -32,10 +35,10 @@ func TestAccPromptResource(t *testing.T) {
 			if err := json.NewEncoder(w).Encode(client.Prompt{
 				ID:          "prompt-created",
 				Name:        req.Prompt.Name,
-				Description: req.Prompt.Description,
+				Description: stringPointer(req.Prompt.Description),
 				Tags:        []string{},
 				IsActive:    true,
-				Visibility:  req.Visibility,
+				Visibility:  stringPointer(req.Visibility),
 				CreatedAt:   "2025-01-01T00:00:00Z",
 				UpdatedAt:   "2025-01-01T00:00:00Z",
 			}); err != nil {
@@ -47,10 +50,10 @@ func TestAccPromptResource(t *testing.T) {
 			if err := json.NewEncoder(w).Encode(client.Prompt{
 				ID:          "prompt-created",
 				Name:        "test-prompt",
-				Description: "A test prompt",
+				Description: stringPointer("A test prompt"),
 				Tags:        []string{},
 				IsActive:    true,
-				Visibility:  "public",
+				Visibility:  stringPointer("public"),
 				CreatedAt:   "2025-01-01T00:00:00Z",
 				UpdatedAt:   "2025-01-01T00:00:00Z",
 			}); err != nil {
@@ -89,6 +92,46 @@ func TestAccPromptResource(t *testing.T) {
 	})
 }
 
+func TestPromptToModel_EmptyStrings(t *testing.T) {
+	cases := map[string]struct {
+		prior    types.String
+		apiValue *string
+		want     types.String
+	}{
+		"unset config, API empty": {prior: types.StringUnknown(), apiValue: stringPointer(""), want: types.StringNull()},
+		"null prior, API empty":   {prior: types.StringNull(), apiValue: stringPointer(""), want: types.StringNull()},
+		"empty config, API empty": {prior: types.StringValue(""), apiValue: stringPointer(""), want: types.StringValue("")},
+		"configured, API value":   {prior: types.StringValue("old"), apiValue: stringPointer("new"), want: types.StringValue("new")},
+		"configured, API omits":   {prior: types.StringValue("old"), apiValue: nil, want: types.StringValue("old")},
+		"configured, API empty":   {prior: types.StringValue("old"), apiValue: stringPointer(""), want: types.StringNull()},
+		"unset config, API value": {prior: types.StringUnknown(), apiValue: stringPointer("new"), want: types.StringValue("new")},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			data := PromptResourceModel{
+				Description: tc.prior,
+				Visibility:  tc.prior,
+				Tags:        types.ListNull(types.StringType),
+			}
+			var diags diag.Diagnostics
+
+			prompt := &client.Prompt{ID: "prompt-1", Description: tc.apiValue, Visibility: tc.apiValue}
+			(&PromptResource{}).promptToModel(context.Background(), prompt, &data, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if !data.Description.Equal(tc.want) {
+				t.Errorf("expected description %s, got %s", tc.want, data.Description)
+			}
+			if !data.Visibility.Equal(tc.want) {
+				t.Errorf("expected visibility %s, got %s", tc.want, data.Visibility)
+			}
+		})
+	}
+}
+
 func testAccPromptResourceConfig(endpoint string) string {
 	return `
 provider "contextforge" {
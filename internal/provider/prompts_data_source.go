@@ -8,8 +8,12 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nkbud/terraform-provider-contextforge/internal/client"
@@ -29,7 +33,11 @@ type PromptsDataSource struct {
 // PromptsDataSourceModel describes the data source data model.
 type PromptsDataSourceModel struct {
 	IncludeInactive types.Bool        `tfsdk:"include_inactive"`
+	OnlyInactive    types.Bool        `tfsdk:"only_inactive"`
+	Active          types.Bool        `tfsdk:"active"`
+	NameFilter      types.String      `tfsdk:"name_filter"`
 	Prompts         []PromptItemModel `tfsdk:"prompts"`
+	Count           types.Int64       `tfsdk:"count"`
 	ID              types.String      `tfsdk:"id"`
 }
 
@@ -58,6 +66,25 @@ func (d *PromptsDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "Whether to include inactive prompts in the list. Defaults to `false`.",
 				Optional:            true,
 			},
+			"only_inactive": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, only inactive prompts are returned. Shorthand for `active = false`.",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("active")),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "When set, only prompts whose `is_active` matches this value are returned. Setting it to `false` implies `include_inactive`.",
+				Optional:            true,
+			},
+			"name_filter": schema.StringAttribute{
+				MarkdownDescription: "When set, only prompts whose name contains this value, ignoring case, are returned. Filtering happens on the gateway where supported.",
+				Optional:            true,
+			},
+			"count": schema.Int64Attribute{
+				MarkdownDescription: "Number of prompts returned.",
+				Computed:            true,
+			},
 			"prompts": schema.ListNestedAttribute{
 				MarkdownDescription: "List of prompts.",
 				Computed:            true,
@@ -116,16 +143,16 @@ func (d *PromptsDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = apiClient
+	d.client = data.client
 }
 
 func (d *PromptsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -136,57 +163,62 @@ func (d *PromptsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	includeInactive := false
-	if !data.IncludeInactive.IsNull() && !data.IncludeInactive.IsUnknown() {
-		includeInactive = data.IncludeInactive.ValueBool()
-	}
+	filter := newListFilter(data.IncludeInactive, data.OnlyInactive, data.Active)
 
-	prompts, err := d.client.ListPrompts(ctx, includeInactive)
+	prompts, err := d.client.ListPrompts(ctx, filter.includeInactive, data.NameFilter.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list prompts, got error: %s", err))
+		addClientError(&resp.Diagnostics, "list prompts", err)
 		return
 	}
+	prompts = filterByActive(prompts, filter, func(p client.Prompt) bool { return p.IsActive })
 
-	data.Prompts = make([]PromptItemModel, len(prompts))
-	for i, p := range prompts {
-		item := PromptItemModel{
-			ID:          types.StringValue(p.ID),
-			Name:        types.StringValue(p.Name),
-			Description: types.StringValue(p.Description),
-			IsActive:    types.BoolValue(p.IsActive),
-			Visibility:  types.StringValue(p.Visibility),
-			CreatedAt:   types.StringValue(p.CreatedAt),
-			UpdatedAt:   types.StringValue(p.UpdatedAt),
-		}
+	data.Prompts = promptItemsFromAPI(ctx, prompts, &resp.Diagnostics)
+	data.Count = types.Int64Value(int64(len(data.Prompts)))
 
-		if p.Arguments != nil {
-			argsJSON, err := json.Marshal(p.Arguments)
-			if err != nil {
-				resp.Diagnostics.AddError("Arguments Serialization Error", fmt.Sprintf("Unable to serialize arguments: %s", err))
-				return
-			}
-			item.Arguments = types.StringValue(string(argsJSON))
-		} else {
-			item.Arguments = types.StringNull()
-		}
+	data.ID = types.StringValue("prompts")
 
-		if p.Tags != nil {
-			tags, diags := types.ListValueFrom(ctx, types.StringType, p.Tags)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			item.Tags = tags
-		} else {
-			item.Tags = types.ListNull(types.StringType)
-		}
+	tflog.Trace(ctx, "read prompts data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// promptItemsFromAPI maps the listed prompts to item models. Elements that fail
+// to map are omitted with a warning so the remaining results are still returned.
+func promptItemsFromAPI(ctx context.Context, prompts []client.Prompt, diagnostics *diag.Diagnostics) []PromptItemModel {
+	return mapItems(ctx, prompts, "prompt", func(p client.Prompt) string { return p.ID }, promptItemFromAPI, diagnostics)
+}
 
-		data.Prompts[i] = item
+// promptItemFromAPI maps a single prompt to its item model.
+func promptItemFromAPI(ctx context.Context, p client.Prompt) (PromptItemModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	item := PromptItemModel{
+		ID:          types.StringValue(p.ID),
+		Name:        types.StringValue(p.Name),
+		Description: types.StringValue(derefString(p.Description)),
+		IsActive:    types.BoolValue(p.IsActive),
+		Visibility:  types.StringValue(derefString(p.Visibility)),
+		CreatedAt:   types.StringValue(p.CreatedAt),
+		UpdatedAt:   types.StringValue(p.UpdatedAt),
 	}
 
-	data.ID = types.StringValue("prompts")
+	if p.Arguments != nil {
+		argsJSON, err := json.Marshal(p.Arguments)
+		if err != nil {
+			diags.AddError("Arguments Serialization Error", fmt.Sprintf("Unable to serialize arguments: %s", err))
+			return item, diags
+		}
+		item.Arguments = types.StringValue(string(argsJSON))
+	} else {
+		item.Arguments = types.StringNull()
+	}
 
-	tflog.Trace(ctx, "read prompts data source")
+	tags, listDiags := stringListValue(ctx, p.Tags)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return item, diags
+	}
+	item.Tags = tags
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return item, diags
 }
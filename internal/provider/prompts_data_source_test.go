@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+func TestAccPromptsDataSource_NameFilter(t *testing.T) {
+	all := []client.Prompt{
+		{ID: "p-1", Name: "greeting"},
+		{ID: "p-2", Name: "Formal-Greeting"},
+		{ID: "p-3", Name: "summary"},
+	}
+
+	for name, serverSide := range map[string]bool{"server-side": true, "fallback": false} {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/prompts" || r.Method != http.MethodGet {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				prompts := all
+				if search := r.URL.Query().Get("search"); serverSide && search != "" {
+					prompts = nil
+					for _, p := range all {
+						if strings.Contains(strings.ToLower(p.Name), strings.ToLower(search)) {
+							prompts = append(prompts, p)
+						}
+					}
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if err := json.NewEncoder(w).Encode(prompts); err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+			}))
+			defer mockServer.Close()
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+					"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+data "contextforge_prompts" "test" {
+  name_filter = "GREET"
+}
+`,
+						ConfigStateChecks: []statecheck.StateCheck{
+							statecheck.ExpectKnownValue(
+								"data.contextforge_prompts.test",
+								tfjsonpath.New("count"),
+								knownvalue.Int64Exact(2),
+							),
+							statecheck.ExpectKnownValue(
+								"data.contextforge_prompts.test",
+								tfjsonpath.New("prompts").AtSliceIndex(1).AtMapKey("id"),
+								knownvalue.StringExact("p-2"),
+							),
+						},
+					},
+				},
+			})
+		})
+	}
+}
@@ -5,16 +5,32 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nkbud/terraform-provider-contextforge/internal/client"
 )
 
@@ -24,6 +40,42 @@ var _ provider.ProviderWithFunctions = &ContextForgeProvider{}
 var _ provider.ProviderWithEphemeralResources = &ContextForgeProvider{}
 var _ provider.ProviderWithActions = &ContextForgeProvider{}
 
+// defaultEndpoint is the gateway endpoint used when neither the endpoint
+// attribute nor CONTEXTFORGE_ENDPOINT is set, unless require_endpoint is.
+const defaultEndpoint = "http://localhost:4444"
+
+// defaultOperationTimeout is the timeout applied to a resource operation when
+// neither its timeouts block nor the operation_timeout setting sets one.
+const defaultOperationTimeout = 20 * time.Minute
+
+// visibilityValues are the visibility levels the gateway accepts for servers,
+// tools, resources, and prompts.
+var visibilityValues = []string{"public", "private", "team"}
+
+// authSchemePattern matches an HTTP token (RFC 9110), the form an
+// Authorization scheme must take.
+var authSchemePattern = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
+// minimumGatewayVersion is the oldest gateway release whose API shapes the
+// provider is known to work with.
+const minimumGatewayVersion = "0.7.0"
+
+// tlsVersions maps the accepted min_tls_version values to their tls constants.
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// defaultMinTLSVersion is used when min_tls_version is not set.
+const defaultMinTLSVersion = "1.2"
+
+// defaultMaxRetries is used when max_retries is not set.
+const defaultMaxRetries = 3
+
+// versionCheckTimeout bounds the /health call made to detect the gateway
+// version when require_healthy is not set.
+const versionCheckTimeout = 5 * time.Second
+
 // ContextForgeProvider defines the provider implementation.
 type ContextForgeProvider struct {
 	// version is set to the provider version on release, "dev" when the
@@ -34,8 +86,82 @@ type ContextForgeProvider struct {
 
 // ContextForgeProviderModel describes the provider data model.
 type ContextForgeProviderModel struct {
-	Endpoint    types.String `tfsdk:"endpoint"`
-	BearerToken types.String `tfsdk:"bearer_token"`
+	Endpoint              types.String `tfsdk:"endpoint"`
+	Endpoints             types.List   `tfsdk:"endpoints"`
+	BearerToken           types.String `tfsdk:"bearer_token"`
+	RequireHealthy        types.Bool   `tfsdk:"require_healthy"`
+	EnableIdempotencyKeys types.Bool   `tfsdk:"enable_idempotency_keys"`
+	DisableCompression    types.Bool   `tfsdk:"disable_compression"`
+	HealthPath            types.String `tfsdk:"health_path"`
+	MaxResponseBytes      types.Int64  `tfsdk:"max_response_bytes"`
+	MaxRetries            types.Int64  `tfsdk:"max_retries"`
+	DefaultVisibility     types.String `tfsdk:"default_visibility"`
+	DeletionMode          types.String `tfsdk:"deletion_mode"`
+	DefaultHeaders        types.Map    `tfsdk:"default_headers"`
+	AuthScheme            types.String `tfsdk:"auth_scheme"`
+	APIKey                types.String `tfsdk:"api_key"`
+	APIKeyHeader          types.String `tfsdk:"api_key_header"`
+	CacheTTL              types.Int64  `tfsdk:"cache_ttl"`
+	MinTLSVersion         types.String `tfsdk:"min_tls_version"`
+	AllowToolRename       types.Bool   `tfsdk:"allow_tool_rename"`
+	RequireEndpoint       types.Bool   `tfsdk:"require_endpoint"`
+	ServerSideValidation  types.Bool   `tfsdk:"server_side_validation"`
+	ReadOnly              types.Bool   `tfsdk:"read_only"`
+	TraceHeader           types.String `tfsdk:"trace_header"`
+	LowercaseTags         types.Bool   `tfsdk:"lowercase_tags"`
+	InsecureSkipVerify    types.Bool   `tfsdk:"insecure_skip_verify"`
+	RequestTimeout        types.Int64  `tfsdk:"request_timeout"`
+	OperationTimeout      types.Int64  `tfsdk:"operation_timeout"`
+	RetryStatusCodes      types.List   `tfsdk:"retry_status_codes"`
+	CACertificateFile     types.String `tfsdk:"ca_certificate_file"`
+	VerifyGateway         types.Bool   `tfsdk:"verify_gateway"`
+}
+
+// providerData is handed to resources, data sources and actions through
+// Configure: the API client, plus the provider settings that decide how
+// resources use it rather than how requests are sent.
+type providerData struct {
+	client   *client.Client
+	settings providerSettings
+
+	// adoptedRoots is shared by the root resources of this provider
+	// configuration; see rootAdoptions.
+	adoptedRoots *rootAdoptions
+}
+
+// providerSettings holds the provider settings that resources and actions
+// apply themselves. The zero value is the default of every setting.
+type providerSettings struct {
+	// allowToolRename updates a tool in place when its name changes instead
+	// of replacing it, since some gateways reject renames.
+	allowToolRename bool
+
+	// serverSideValidation sends planned tools to the gateway's validation
+	// endpoint so definitions it would reject fail the plan.
+	serverSideValidation bool
+
+	// readOnly refuses every create, update, delete and mutating action.
+	readOnly bool
+
+	// lowercaseTags lowercases tags before they are sent to the gateway.
+	lowercaseTags bool
+
+	// deletionMode decides whether destroying an object deletes or only
+	// deactivates it when the resource does not set its own deletion_mode.
+	deletionMode string
+
+	// operationTimeout bounds a resource operation whose timeouts block does
+	// not set one. Zero means defaultOperationTimeout.
+	operationTimeout time.Duration
+}
+
+// defaultTimeout returns the timeout passed to a timeouts block as the
+// default for operations it does not set.
+func (s providerSettings) defaultTimeout() time.Duration {
+	if s.operationTimeout > 0 {
+		return s.operationTimeout
+	}
+	return defaultOperationTimeout
 }
 
 func (p *ContextForgeProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -48,14 +174,174 @@ func (p *ContextForgeProvider) Schema(ctx context.Context, req provider.SchemaRe
 		MarkdownDescription: "The ContextForge provider manages resources on a ContextForge MCP Gateway instance.",
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				MarkdownDescription: "ContextForge MCP Gateway endpoint URL. Can also be set with the `CONTEXTFORGE_ENDPOINT` environment variable. Defaults to `http://localhost:4444`.",
+				MarkdownDescription: "ContextForge MCP Gateway endpoint URL. Can also be set with the `CONTEXTFORGE_ENDPOINT` environment variable. Defaults to `http://localhost:4444` unless `require_endpoint` is set.",
 				Optional:            true,
 			},
+			"endpoints": schema.ListAttribute{
+				MarkdownDescription: "Endpoint URLs of the same gateway, e.g. an active and a standby instance, in order of preference. A request that cannot connect to the endpoint in use moves on to the next one, and the provider keeps using the last endpoint that answered. Conflicts with `endpoint`, which is shorthand for a single endpoint.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("endpoint")),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"allow_tool_rename": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, changing the `name` of a `contextforge_tool` updates the tool in place. Only enable this for gateways that accept tool renames. Defaults to `false`, in which case a rename replaces the tool.",
+				Optional:            true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "API key for authenticating with the MCP Gateway, sent in the `api_key_header` header instead of a bearer token. Conflicts with `bearer_token` and `auth_scheme`; when set, `MCPGATEWAY_BEARER_TOKEN` is ignored.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("bearer_token"), path.MatchRoot("auth_scheme")),
+				},
+			},
+			"api_key_header": schema.StringAttribute{
+				MarkdownDescription: "Header the `api_key` is sent in. Requires `api_key`. Defaults to `X-API-Key`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("api_key")),
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"auth_scheme": schema.StringAttribute{
+				MarkdownDescription: "Scheme used in the `Authorization` header when sending the token, e.g. `Token` for `Authorization: Token <token>`. Must be a single word. Defaults to `Bearer`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(authSchemePattern, "must be a single token without spaces"),
+				},
+			},
 			"bearer_token": schema.StringAttribute{
 				MarkdownDescription: "JWT bearer token for authenticating with the MCP Gateway API. Can also be set with the `MCPGATEWAY_BEARER_TOKEN` environment variable.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"ca_certificate_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM-encoded CA bundle used to verify the gateway's certificate instead of the system roots. Can also be set with the `CONTEXTFORGE_CA_CERT` environment variable; the attribute takes precedence.",
+				Optional:            true,
+			},
+			"cache_ttl": schema.Int64Attribute{
+				MarkdownDescription: "Seconds for which successful read responses are cached in memory, so configurations that combine list data sources with many single-object lookups issue fewer requests. Any create, update, or delete clears the cache. Defaults to `0`, which disables caching.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"default_headers": schema.MapAttribute{
+				MarkdownDescription: "Extra HTTP headers sent with every request to the gateway, e.g. `X-Tenant-ID` for deployments behind a WAF. `Authorization`, `Content-Type`, `Idempotency-Key`, and `If-None-Match` are managed by the provider and cannot be set here.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"default_visibility": schema.StringAttribute{
+				MarkdownDescription: "Visibility applied when creating a server, tool, prompt, or MCP resource that does not set `visibility`. One of `public`, `private`, or `team`. A resource-level `visibility` always takes precedence.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(visibilityValues...),
+				},
+			},
+			"deletion_mode": schema.StringAttribute{
+				MarkdownDescription: "What destroying a `contextforge_tool`, `contextforge_prompt`, `contextforge_mcp_resource`, or `contextforge_server` does on the gateway: `delete` removes the object, and `deactivate` only marks it inactive so it is kept. A resource-level `deletion_mode` always takes precedence. Defaults to `delete`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(deletionModes...),
+				},
+			},
+			"disable_compression": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, the provider does not request gzip-compressed responses from the gateway. Useful when debugging raw API traffic. Defaults to `false`.",
+				Optional:            true,
+			},
+			"enable_idempotency_keys": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, create requests carry an `Idempotency-Key` header so they can be safely retried on transient failures. Requires gateway support for idempotency keys. Defaults to `false`.",
+				Optional:            true,
+			},
+			"health_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the gateway health endpoint, relative to `endpoint`. Used by the `contextforge_health` data source and the `require_healthy` check. Defaults to `/health`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "must start with a slash"),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, the provider does not verify the gateway's TLS certificate. Only use this against test gateways. Can also be set with the `CONTEXTFORGE_INSECURE` environment variable; the attribute takes precedence. Defaults to `false`.",
+				Optional:            true,
+			},
+			"lowercase_tags": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, resource tags are lowercased before they are sent to the gateway, in addition to the trimming and de-duplication that always applies. A plan shows a warning for configured tags that change. Defaults to `false`.",
+				Optional:            true,
+			},
+			"max_response_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Largest response body, in bytes, the provider reads from the gateway. Requests whose response exceeds it fail instead of being buffered in memory. Defaults to `33554432` (32 MiB).",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Number of times a request that failed transiently, e.g. with one of `retry_status_codes` or a dropped connection, is retried with exponential backoff. Only reads, updates, deletes, and creates sent with idempotency keys are retried. Set to `0` to disable retries. Defaults to `3`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"min_tls_version": schema.StringAttribute{
+				MarkdownDescription: "Minimum TLS version accepted when connecting to the gateway over HTTPS. One of `1.2` or `1.3`. Defaults to `1.2`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("1.2", "1.3"),
+				},
+			},
+			"operation_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Seconds after which a create, read, update, or delete of a `contextforge_gateway` or `contextforge_server` is abandoned, including any wait for the gateway to finish the operation. A `timeouts` block on the resource takes precedence. Defaults to `1200` (20 minutes).",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Seconds after which a single HTTP request to the gateway is abandoned, including reading the response. Can also be set with the `CONTEXTFORGE_TIMEOUT` environment variable; the attribute takes precedence. Defaults to `0`, which applies no limit beyond the operation timeouts.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"require_endpoint": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, configuration fails if none of `endpoint`, `endpoints` and `CONTEXTFORGE_ENDPOINT` is set, instead of falling back to `http://localhost:4444`. An empty value counts as unset; one that is not known until apply is not checked. Recommended for production so a missing setting cannot send traffic to a local gateway. Defaults to `false`.",
+				Optional:            true,
+			},
+			"require_healthy": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, the provider checks the gateway's `/health` endpoint during configuration and fails if the gateway does not report `ok` or `healthy`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"retry_status_codes": schema.ListAttribute{
+				MarkdownDescription: "HTTP statuses after which a request is retried with exponential backoff, replacing the default set, e.g. to add `409` for a backend that reports conflicts while it settles. Only reads, updates, deletes, and creates sent with idempotency keys are retried. Defaults to `[429, 502, 503, 504]`.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+				},
+			},
+			"server_side_validation": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, new and changed `contextforge_tool` definitions are sent to the gateway's validation endpoint (`POST /tools/validate`) and definitions it rejects fail the plan instead of the apply. If the gateway has no validation endpoint, a warning is shown and the plan continues. Defaults to `false`.",
+				Optional:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, every resource create, update and delete, and every invocation of the `contextforge_invoke_tool`, `contextforge_manage_tags` and `contextforge_refresh_gateway` actions, fails with an error instead of calling the gateway, while plans, refreshes and data sources work as usual. Use it to run plans against a production gateway without any risk of writes. Defaults to `false`.",
+				Optional:            true,
+			},
+			"trace_header": schema.StringAttribute{
+				MarkdownDescription: "Header every request to the gateway carries a trace ID in, so gateway logs can be correlated with the provider's debug logs, where the ID is recorded as `trace_id`. Each request gets a new random ID, kept across its retries. Set the `CONTEXTFORGE_TRACE_ID` environment variable to send that ID on every request instead, e.g. one propagated from the pipeline running Terraform. Defaults to `X-Request-ID`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"verify_gateway": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, the provider checks during configuration that `endpoint` serves a ContextForge gateway, i.e. that `health_path` answers with a JSON health response carrying a `status`, and fails otherwise, so a mistyped endpoint is caught before any resource is read. `require_healthy` implies this check. Defaults to `false`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -69,34 +355,451 @@ func (p *ContextForgeProvider) Configure(ctx context.Context, req provider.Confi
 		return
 	}
 
-	endpoint := "http://localhost:4444"
-	if !data.Endpoint.IsNull() && !data.Endpoint.IsUnknown() {
+	var endpoints []string
+	if !data.Endpoints.IsNull() && !data.Endpoints.IsUnknown() {
+		resp.Diagnostics.Append(data.Endpoints.ElementsAs(ctx, &endpoints, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// An empty endpoint counts as unset, like an empty CONTEXTFORGE_ENDPOINT.
+	// One that is not known yet, e.g. because it comes from another resource,
+	// is not reported as missing.
+	endpoint := defaultEndpoint
+	if len(endpoints) > 0 {
+		endpoint = endpoints[0]
+	} else if data.Endpoint.ValueString() != "" {
 		endpoint = data.Endpoint.ValueString()
 	} else if v := os.Getenv("CONTEXTFORGE_ENDPOINT"); v != "" {
 		endpoint = v
+	} else if data.RequireEndpoint.ValueBool() && !data.Endpoint.IsUnknown() && !data.Endpoints.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Missing Endpoint",
+			"require_endpoint is set but no gateway endpoint was configured. "+
+				"Set the endpoint or endpoints attribute, or the CONTEXTFORGE_ENDPOINT environment variable.",
+		)
+		return
+	}
+
+	for i, e := range endpoints {
+		if err := validateEndpoint(e); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("endpoints").AtListIndex(i),
+				"Invalid Endpoint",
+				fmt.Sprintf("The endpoint %q is not a valid gateway URL: %s. "+
+					"Every entry of endpoints must be an absolute http or https URL, e.g. \"http://localhost:4444\".", e, err),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateEndpoint(endpoint); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Invalid Endpoint",
+			fmt.Sprintf("The endpoint %q is not a valid gateway URL: %s. "+
+				"Set endpoint or CONTEXTFORGE_ENDPOINT to an absolute http or https URL, e.g. \"http://localhost:4444\".", endpoint, err),
+		)
+		return
+	}
+
+	apiKey := ""
+	if !data.APIKey.IsNull() && !data.APIKey.IsUnknown() {
+		apiKey = strings.TrimSpace(data.APIKey.ValueString())
+		if apiKey == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key"),
+				"Empty API Key",
+				"The api_key attribute is empty or contains only whitespace. Set it to a valid key, or unset it to authenticate with a bearer token.",
+			)
+			return
+		}
+	}
+
+	apiKeyHeader := client.DefaultAPIKeyHeader
+	if !data.APIKeyHeader.IsNull() && !data.APIKeyHeader.IsUnknown() {
+		apiKeyHeader = data.APIKeyHeader.ValueString()
+		if client.IsReservedHeader(apiKeyHeader) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key_header"),
+				"Reserved API Key Header",
+				fmt.Sprintf("The header %q is managed by the provider and cannot carry the API key.", apiKeyHeader),
+			)
+			return
+		}
+	}
+
+	traceHeader := client.DefaultTraceHeader
+	if !data.TraceHeader.IsNull() && !data.TraceHeader.IsUnknown() {
+		traceHeader = data.TraceHeader.ValueString()
+		if client.IsReservedHeader(traceHeader) || (apiKey != "" && strings.EqualFold(traceHeader, apiKeyHeader)) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("trace_header"),
+				"Reserved Trace Header",
+				fmt.Sprintf("The header %q is managed by the provider and cannot carry the trace ID.", traceHeader),
+			)
+			return
+		}
 	}
 
 	bearerToken := ""
-	if !data.BearerToken.IsNull() && !data.BearerToken.IsUnknown() {
+	tokenSource := ""
+	switch {
+	case apiKey != "":
+		// The API key replaces the bearer token, so a token exported in the
+		// environment is not sent alongside it.
+	case !data.BearerToken.IsNull() && !data.BearerToken.IsUnknown():
 		bearerToken = data.BearerToken.ValueString()
-	} else if v := os.Getenv("MCPGATEWAY_BEARER_TOKEN"); v != "" {
-		bearerToken = v
+		tokenSource = "the bearer_token attribute"
+	default:
+		if v, ok := os.LookupEnv("MCPGATEWAY_BEARER_TOKEN"); ok {
+			bearerToken = v
+			tokenSource = "the MCPGATEWAY_BEARER_TOKEN environment variable"
+		}
+	}
+
+	// A token that is set but blank would otherwise be dropped silently and
+	// surface later as unexplained 401 responses.
+	bearerToken = strings.TrimSpace(bearerToken)
+	if tokenSource != "" && bearerToken == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("bearer_token"),
+			"Empty Bearer Token",
+			fmt.Sprintf("The bearer token from %s is empty or contains only whitespace. "+
+				"Set bearer_token or MCPGATEWAY_BEARER_TOKEN to a valid token, or unset both if the gateway does not require authentication.", tokenSource),
+		)
+		return
+	}
+
+	var defaultHeaders map[string]string
+	if !data.DefaultHeaders.IsNull() && !data.DefaultHeaders.IsUnknown() {
+		resp.Diagnostics.Append(data.DefaultHeaders.ElementsAs(ctx, &defaultHeaders, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	for name := range defaultHeaders {
+		if client.IsReservedHeader(name) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("default_headers"),
+				"Reserved Default Header",
+				fmt.Sprintf("The header %q is managed by the provider and cannot be set in default_headers.", name),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	insecureSkipVerify := false
+	if !data.InsecureSkipVerify.IsNull() && !data.InsecureSkipVerify.IsUnknown() {
+		insecureSkipVerify = data.InsecureSkipVerify.ValueBool()
+	} else if v := os.Getenv("CONTEXTFORGE_INSECURE"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("insecure_skip_verify"),
+				"Invalid CONTEXTFORGE_INSECURE Value",
+				fmt.Sprintf("The CONTEXTFORGE_INSECURE environment variable must be a boolean such as \"true\" or \"false\", got %q.", v),
+			)
+			return
+		}
+		insecureSkipVerify = parsed
+	}
+
+	var requestTimeout time.Duration
+	if !data.RequestTimeout.IsNull() && !data.RequestTimeout.IsUnknown() {
+		requestTimeout = time.Duration(data.RequestTimeout.ValueInt64()) * time.Second
+	} else if v := os.Getenv("CONTEXTFORGE_TIMEOUT"); v != "" {
+		seconds, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || seconds < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid CONTEXTFORGE_TIMEOUT Value",
+				fmt.Sprintf("The CONTEXTFORGE_TIMEOUT environment variable must be a non-negative number of seconds, got %q.", v),
+			)
+			return
+		}
+		requestTimeout = time.Duration(seconds) * time.Second
+	}
+
+	caCertificateFile := ""
+	if !data.CACertificateFile.IsNull() && !data.CACertificateFile.IsUnknown() {
+		caCertificateFile = data.CACertificateFile.ValueString()
+	} else if v := os.Getenv("CONTEXTFORGE_CA_CERT"); v != "" {
+		caCertificateFile = v
 	}
 
 	apiClient := client.NewClient(endpoint, bearerToken)
-	resp.DataSourceData = apiClient
-	resp.ResourceData = apiClient
+	apiClient.DefaultHeaders = defaultHeaders
+	apiClient.APIKey = apiKey
+	apiClient.APIKeyHeader = apiKeyHeader
+	apiClient.TraceHeader = traceHeader
+	apiClient.TraceID = strings.TrimSpace(os.Getenv("CONTEXTFORGE_TRACE_ID"))
+	if !data.AuthScheme.IsNull() && !data.AuthScheme.IsUnknown() {
+		apiClient.AuthScheme = data.AuthScheme.ValueString()
+	}
+	if len(endpoints) == 0 && hasExtraSlashes(endpoint) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("endpoint"),
+			"Endpoint Normalized",
+			fmt.Sprintf("The endpoint %q contains trailing or repeated slashes and will be used as %q.", endpoint, apiClient.BaseURL),
+		)
+	}
+	for i, e := range endpoints {
+		normalized := client.NormalizeBaseURL(e)
+		if hasExtraSlashes(e) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("endpoints").AtListIndex(i),
+				"Endpoint Normalized",
+				fmt.Sprintf("The endpoint %q contains trailing or repeated slashes and will be used as %q.", e, normalized),
+			)
+		}
+		if i > 0 {
+			apiClient.FallbackURLs = append(apiClient.FallbackURLs, normalized)
+		}
+	}
+	apiClient.IdempotencyKeys = data.EnableIdempotencyKeys.ValueBool()
+	apiClient.MaxRetries = defaultMaxRetries
+	if !data.MaxRetries.IsNull() && !data.MaxRetries.IsUnknown() {
+		apiClient.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+	if !data.HealthPath.IsNull() && !data.HealthPath.IsUnknown() {
+		apiClient.HealthPath = data.HealthPath.ValueString()
+	}
+	apiClient.DefaultVisibility = data.DefaultVisibility.ValueString()
+	if !data.MaxResponseBytes.IsNull() && !data.MaxResponseBytes.IsUnknown() {
+		apiClient.MaxResponseBytes = data.MaxResponseBytes.ValueInt64()
+	}
+	if !data.CacheTTL.IsNull() && !data.CacheTTL.IsUnknown() {
+		apiClient.CacheTTL = time.Duration(data.CacheTTL.ValueInt64()) * time.Second
+	}
+	if !data.RetryStatusCodes.IsNull() && !data.RetryStatusCodes.IsUnknown() {
+		var codes []int
+		resp.Diagnostics.Append(data.RetryStatusCodes.ElementsAs(ctx, &codes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		apiClient.RetryPolicy = client.RetryOnStatus(codes...)
+	}
+	minTLSVersion := defaultMinTLSVersion
+	if !data.MinTLSVersion.IsNull() && !data.MinTLSVersion.IsUnknown() {
+		minTLSVersion = data.MinTLSVersion.ValueString()
+	}
+	apiClient.SetMinTLSVersion(tlsVersions[minTLSVersion])
+	apiClient.HTTPClient.Timeout = requestTimeout
+	if insecureSkipVerify {
+		apiClient.SetInsecureSkipVerify(true)
+	}
+	if caCertificateFile != "" {
+		caPEM, err := os.ReadFile(caCertificateFile)
+		if err == nil {
+			err = apiClient.SetRootCAs(caPEM)
+		}
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_certificate_file"),
+				"Invalid CA Certificate File",
+				fmt.Sprintf("Unable to load the CA bundle %q: %s", caCertificateFile, err),
+			)
+			return
+		}
+	}
+	if data.DisableCompression.ValueBool() {
+		apiClient.DisableCompression()
+	}
+
+	if data.RequireHealthy.ValueBool() || data.VerifyGateway.ValueBool() {
+		setting := "verify_gateway"
+		if data.RequireHealthy.ValueBool() {
+			setting = "require_healthy"
+		}
+
+		health, err := apiClient.GetHealth(ctx)
+		if err == nil {
+			err = verifyGatewayHealth(health)
+		}
+		if isNotGatewayError(err) {
+			resp.Diagnostics.AddError(
+				"Endpoint Is Not a ContextForge Gateway",
+				fmt.Sprintf("%s is set but %s does not look like a ContextForge gateway: %s. "+
+					"Check that endpoint points at the gateway and that health_path is its health endpoint.", setting, endpoint, err),
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Gateway Health Check Failed",
+				fmt.Sprintf("%s is set but the health check against %s failed: %s", setting, endpoint, err),
+			)
+			return
+		}
+		if data.RequireHealthy.ValueBool() && !isHealthyStatus(health.Status) {
+			resp.Diagnostics.AddError(
+				"Gateway Unhealthy",
+				fmt.Sprintf("require_healthy is set but the gateway at %s reported status %q.", endpoint, health.Status),
+			)
+			return
+		}
+		warnIfUnsupportedVersion(&resp.Diagnostics, health.Version)
+	} else if data.Endpoint.IsUnknown() || data.Endpoints.IsUnknown() {
+		tflog.Debug(ctx, "skipping gateway version check until the endpoint is known")
+	} else {
+		// The version check is best effort: it sends a single request so an
+		// unreachable gateway does not slow every plan down with retries, and
+		// leaves reporting it to the first real request.
+		checkCtx, cancel := context.WithTimeout(ctx, versionCheckTimeout)
+		health, err := apiClient.ProbeHealth(checkCtx)
+		cancel()
+		if err != nil {
+			tflog.Debug(ctx, "skipping gateway version check", map[string]interface{}{"error": err.Error()})
+		} else {
+			warnIfUnsupportedVersion(&resp.Diagnostics, health.Version)
+		}
+	}
+
+	configured := &providerData{
+		client:       apiClient,
+		adoptedRoots: &rootAdoptions{},
+		settings: providerSettings{
+			allowToolRename:      data.AllowToolRename.ValueBool(),
+			serverSideValidation: data.ServerSideValidation.ValueBool(),
+			readOnly:             data.ReadOnly.ValueBool(),
+			lowercaseTags:        data.LowercaseTags.ValueBool(),
+			deletionMode:         data.DeletionMode.ValueString(),
+			operationTimeout:     time.Duration(data.OperationTimeout.ValueInt64()) * time.Second,
+		},
+	}
+	resp.DataSourceData = configured
+	resp.ResourceData = configured
+	resp.ActionData = configured
+}
+
+// hasExtraSlashes reports whether the path of endpoint has trailing or
+// repeated slashes, the part of it that client.NormalizeBaseURL changes.
+// Other differences between endpoint and its normalized form, e.g. the case of
+// the scheme, are only canonical spellings of the same URL.
+func hasExtraSlashes(endpoint string) bool {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Opaque != "" {
+		return strings.HasSuffix(endpoint, "/")
+	}
+	return strings.HasSuffix(u.Path, "/") || strings.Contains(u.Path, "//")
+}
+
+// validateEndpoint checks that endpoint is an absolute http or https URL with a host.
+func validateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("scheme must be http or https")
+	}
+	if u.Host == "" {
+		return fmt.Errorf("missing host")
+	}
+	return nil
+}
+
+// warnIfUnsupportedVersion adds a warning when the gateway reports a version
+// older than minimumGatewayVersion. Missing or unparseable versions are ignored.
+func warnIfUnsupportedVersion(diagnostics *diag.Diagnostics, version string) {
+	if !isVersionBelow(version, minimumGatewayVersion) {
+		return
+	}
+	diagnostics.AddWarning(
+		"Unsupported Gateway Version",
+		fmt.Sprintf("The gateway reports version %s, but this provider requires at least %s. "+
+			"Some attributes may be missing or ignored until the gateway is upgraded.", version, minimumGatewayVersion),
+	)
+}
+
+// isVersionBelow reports whether version is a parseable release older than
+// minimum. Pre-release and build suffixes are ignored.
+func isVersionBelow(version, minimum string) bool {
+	v, ok := parseVersion(version)
+	if !ok {
+		return false
+	}
+	m, ok := parseVersion(minimum)
+	if !ok {
+		return false
+	}
+	for i := range v {
+		if v[i] != m[i] {
+			return v[i] < m[i]
+		}
+	}
+	return false
+}
+
+// parseVersion parses a "[v]MAJOR[.MINOR[.PATCH]]" version string.
+func parseVersion(version string) ([3]int, bool) {
+	var parts [3]int
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+	if version == "" {
+		return parts, false
+	}
+	fields := strings.Split(version, ".")
+	if len(fields) > len(parts) {
+		return parts, false
+	}
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 {
+			return parts, false
+		}
+		parts[i] = n
+	}
+	return parts, true
+}
+
+// verifyGatewayHealth checks that a health response decoded without error has
+// the shape of a gateway's, since the body of an unrelated JSON service, e.g.
+// {"message": "hello"}, decodes into an empty HealthResponse.
+func verifyGatewayHealth(health *client.HealthResponse) error {
+	if health.Status == "" {
+		return fmt.Errorf("the health response has no status: %w", client.ErrNotGateway)
+	}
+	return nil
+}
+
+// isNotGatewayError reports whether err from the health check suggests that
+// the endpoint is not a ContextForge gateway: the response was not a gateway
+// health response, or the service has no health endpoint at all.
+func isNotGatewayError(err error) bool {
+	var apiErr *client.APIError
+	return errors.Is(err, client.ErrNotGateway) || (errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound)
+}
+
+// isHealthyStatus reports whether a /health status means the gateway is ready.
+func isHealthyStatus(status string) bool {
+	switch strings.ToLower(status) {
+	case "ok", "healthy":
+		return true
+	}
+	return false
 }
 
 func (p *ContextForgeProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewExampleResource,
 		NewGatewayResource,
+		NewPassthroughConfigResource,
 		NewServerResource,
 		NewToolResource,
 		NewMCPResourceResource,
 		NewPromptResource,
 		NewRootResource,
+		NewRootsResource,
+		NewUserResource,
 	}
 }
 
@@ -109,30 +812,44 @@ func (p *ContextForgeProvider) EphemeralResources(ctx context.Context) []func()
 func (p *ContextForgeProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewExampleDataSource,
+		NewExportDataSource,
+		NewFederationDataSource,
 		NewHealthDataSource,
 		NewServerDataSource,
 		NewServersDataSource,
 		NewGatewayDataSource,
+		NewGatewayCapabilitiesDataSource,
+		NewGatewaySchemaDataSource,
 		NewGatewaysDataSource,
 		NewToolDataSource,
 		NewToolsDataSource,
 		NewMCPResourceDataSource,
+		NewMCPResourceContentDataSource,
 		NewMCPResourcesDataSource,
+		NewMetricsDataSource,
 		NewPromptDataSource,
+		NewPromptRenderDataSource,
 		NewPromptsDataSource,
 		NewRootsDataSource,
+		NewTagsDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
 	}
 }
 
 func (p *ContextForgeProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
 		NewExampleFunction,
+		NewMergeTagsFunction,
 	}
 }
 
 func (p *ContextForgeProvider) Actions(ctx context.Context) []func() action.Action {
 	return []func() action.Action{
 		NewExampleAction,
+		NewInvokeToolAction,
+		NewManageTagsAction,
+		NewRefreshGatewayAction,
 	}
 }
 
@@ -4,11 +4,34 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
 )
 
 // testAccProtoV6ProviderFactories is used to instantiate a provider during acceptance testing.
@@ -32,3 +55,1186 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+func TestIsHealthyStatus(t *testing.T) {
+	for status, want := range map[string]bool{
+		"ok":        true,
+		"healthy":   true,
+		"Healthy":   true,
+		"degraded":  false,
+		"unhealthy": false,
+		"":          false,
+	} {
+		if got := isHealthyStatus(status); got != want {
+			t.Errorf("isHealthyStatus(%q) = %t, want %t", status, got, want)
+		}
+	}
+}
+
+func TestAccProvider_RequireHealthy(t *testing.T) {
+	status := "healthy"
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(client.HealthResponse{Status: status}); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer mockServer.Close()
+
+	config := `
+provider "contextforge" {
+  endpoint        = "` + mockServer.URL + `"
+  require_healthy = true
+}
+
+data "contextforge_health" "test" {}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				PreConfig:   func() { status = "unhealthy" },
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Gateway Unhealthy`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_EmptyBearerToken(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "http://localhost:4444"
+  bearer_token = "   "
+}
+
+data "contextforge_health" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Empty Bearer Token`),
+			},
+		},
+	})
+}
+
+func TestValidateEndpoint(t *testing.T) {
+	for endpoint, wantErr := range map[string]bool{
+		"http://localhost:4444":          false,
+		"https://gateway.example.com":    false,
+		"https://gateway.example.com/v1": false,
+		"localhost:4444":                 true,
+		"gateway.example.com":            true,
+		"ftp://gateway.example.com":      true,
+		"http://":                        true,
+		"http//localhost:4444":           true,
+		"":                               true,
+		"http://local host":              true,
+	} {
+		err := validateEndpoint(endpoint)
+		if gotErr := err != nil; gotErr != wantErr {
+			t.Errorf("validateEndpoint(%q) error = %v, want error: %t", endpoint, err, wantErr)
+		}
+	}
+}
+
+func TestIsVersionBelow(t *testing.T) {
+	for _, tc := range []struct {
+		version string
+		want    bool
+	}{
+		{"0.6.0", true},
+		{"v0.6.9", true},
+		{"0.6", true},
+		{"0.7.0", false},
+		{"0.7.0-rc1", false},
+		{"0.7.1", false},
+		{"1.0.0", false},
+		{"", false},
+		{"unknown", false},
+		{"1.2.3.4", false},
+	} {
+		if got := isVersionBelow(tc.version, "0.7.0"); got != tc.want {
+			t.Errorf("isVersionBelow(%q, \"0.7.0\") = %t, want %t", tc.version, got, tc.want)
+		}
+	}
+}
+
+func TestWarnIfUnsupportedVersion(t *testing.T) {
+	var supported diag.Diagnostics
+	warnIfUnsupportedVersion(&supported, minimumGatewayVersion)
+	if supported.WarningsCount() != 0 {
+		t.Errorf("expected no warning for %s, got %v", minimumGatewayVersion, supported)
+	}
+
+	var unsupported diag.Diagnostics
+	warnIfUnsupportedVersion(&unsupported, "0.1.0")
+	if unsupported.WarningsCount() != 1 {
+		t.Fatalf("expected one warning for 0.1.0, got %v", unsupported)
+	}
+	if got := unsupported.Warnings()[0].Summary(); got != "Unsupported Gateway Version" {
+		t.Errorf("unexpected warning summary %q", got)
+	}
+}
+
+func TestProviderConfigure_VersionCheck(t *testing.T) {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	t.Run("unreachable", func(t *testing.T) {
+		requests.Store(0)
+		resp := configureProvider(t, map[string]tftypes.Value{
+			"endpoint":     tftypes.NewValue(tftypes.String, server.URL),
+			"bearer_token": tftypes.NewValue(tftypes.String, "token"),
+		})
+		if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 0 {
+			t.Fatalf("expected the failed check to be skipped silently, got %v", resp.Diagnostics)
+		}
+		if n := requests.Load(); n != 1 {
+			t.Errorf("expected a single health request without retries, got %d", n)
+		}
+	})
+
+	t.Run("unknown endpoint", func(t *testing.T) {
+		t.Setenv("CONTEXTFORGE_ENDPOINT", server.URL)
+		requests.Store(0)
+		resp := configureProvider(t, map[string]tftypes.Value{
+			"endpoint":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"bearer_token": tftypes.NewValue(tftypes.String, "token"),
+		})
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		if n := requests.Load(); n != 0 {
+			t.Errorf("expected no health request while the endpoint is unknown, got %d", n)
+		}
+	})
+}
+
+func TestAccProvider_UnsupportedGatewayVersion(t *testing.T) {
+	version := minimumGatewayVersion
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(client.HealthResponse{Status: "healthy", Version: version}); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer mockServer.Close()
+
+	config := `
+provider "contextforge" {
+  endpoint = "` + mockServer.URL + `"
+}
+
+data "contextforge_health" "test" {}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("data.contextforge_health.test", "version", minimumGatewayVersion),
+			},
+			{
+				// Warnings do not fail the run; the provider still configures
+				// against an older gateway.
+				PreConfig: func() { version = "0.1.0" },
+				Config:    config,
+				Check:     resource.TestCheckResourceAttr("data.contextforge_health.test", "version", "0.1.0"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_DefaultHeaders(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			if got := r.Header.Get("X-Tenant-ID"); got != "tenant-a" {
+				http.Error(w, "missing X-Tenant-ID", http.StatusForbidden)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(client.HealthResponse{Status: "ok"}); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint = "` + mockServer.URL + `"
+  default_headers = {
+    "X-Tenant-ID" = "tenant-a"
+  }
+}
+
+data "contextforge_health" "test" {}
+`,
+				Check: resource.TestCheckResourceAttr("data.contextforge_health.test", "status", "ok"),
+			},
+			{
+				Config: `
+provider "contextforge" {
+  endpoint = "` + mockServer.URL + `"
+  default_headers = {
+    "Authorization" = "Bearer other"
+  }
+}
+
+data "contextforge_health" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Reserved Default Header`),
+			},
+		},
+	})
+}
+
+func TestAuthSchemePattern(t *testing.T) {
+	for scheme, want := range map[string]bool{
+		"Bearer":    true,
+		"Token":     true,
+		"X-Api-Key": true,
+		"":          false,
+		"Bearer x":  false,
+		" Token":    false,
+		"Token:":    false,
+	} {
+		if got := authSchemePattern.MatchString(scheme); got != want {
+			t.Errorf("authSchemePattern.MatchString(%q) = %t, want %t", scheme, got, want)
+		}
+	}
+}
+
+func TestAccProvider_APIKey(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			if got := r.Header.Get("X-Gateway-Key"); got != "key-123" {
+				http.Error(w, "missing API key", http.StatusUnauthorized)
+				return
+			}
+			if got := r.Header.Get("Authorization"); got != "" {
+				http.Error(w, "unexpected Authorization header", http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(client.HealthResponse{Status: "ok"}); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer mockServer.Close()
+
+	t.Setenv("MCPGATEWAY_BEARER_TOKEN", "ignored-token")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint        = "` + mockServer.URL + `"
+  api_key         = "key-123"
+  api_key_header  = "X-Gateway-Key"
+  require_healthy = true
+}
+
+data "contextforge_health" "test" {}
+`,
+				Check: resource.TestCheckResourceAttr("data.contextforge_health.test", "status", "ok"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_APIKeyConflicts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "http://localhost:4444"
+  api_key      = "key-123"
+  bearer_token = "token"
+}
+
+data "contextforge_health" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `
+provider "contextforge" {
+  endpoint       = "http://localhost:4444"
+  api_key_header = "X-Gateway-Key"
+}
+
+data "contextforge_health" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_MinTLSVersionValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint        = "https://localhost:4444"
+  bearer_token    = "token"
+  min_tls_version = "1.1"
+}
+
+data "contextforge_health" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+// newTLSHealthServer starts an HTTPS gateway with a self-signed certificate
+// that only answers the health endpoint.
+func newTLSHealthServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(client.HealthResponse{Status: "ok"}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestAccProvider_CACertificateFromEnv(t *testing.T) {
+	server := newTLSHealthServer(t)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatalf("failed to write CA bundle: %v", err)
+	}
+	t.Setenv("CONTEXTFORGE_CA_CERT", caFile)
+	t.Setenv("CONTEXTFORGE_INSECURE", "")
+	t.Setenv("CONTEXTFORGE_TIMEOUT", "30")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint        = "` + server.URL + `"
+  bearer_token    = "token"
+  require_healthy = true
+}
+
+data "contextforge_health" "test" {}
+`,
+				Check: resource.TestCheckResourceAttr("data.contextforge_health.test", "status", "ok"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_ConfigOverridesInsecureEnv(t *testing.T) {
+	server := newTLSHealthServer(t)
+
+	t.Setenv("CONTEXTFORGE_CA_CERT", "")
+	t.Setenv("CONTEXTFORGE_INSECURE", "true")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The environment alone skips verification of the
+				// self-signed certificate.
+				Config: `
+provider "contextforge" {
+  endpoint        = "` + server.URL + `"
+  bearer_token    = "token"
+  require_healthy = true
+}
+
+data "contextforge_health" "test" {}
+`,
+				Check: resource.TestCheckResourceAttr("data.contextforge_health.test", "status", "ok"),
+			},
+			{
+				Config: `
+provider "contextforge" {
+  endpoint             = "` + server.URL + `"
+  bearer_token         = "token"
+  require_healthy      = true
+  insecure_skip_verify = false
+}
+
+data "contextforge_health" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Gateway Health Check Failed`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_InvalidEnvValues(t *testing.T) {
+	config := `
+provider "contextforge" {
+  endpoint     = "http://localhost:4444"
+  bearer_token = "token"
+}
+
+data "contextforge_health" "test" {}
+`
+
+	t.Run("insecure", func(t *testing.T) {
+		t.Setenv("CONTEXTFORGE_INSECURE", "sometimes")
+		t.Setenv("CONTEXTFORGE_TIMEOUT", "")
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      config,
+					ExpectError: regexp.MustCompile(`Invalid CONTEXTFORGE_INSECURE Value`),
+				},
+			},
+		})
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Setenv("CONTEXTFORGE_INSECURE", "")
+		t.Setenv("CONTEXTFORGE_TIMEOUT", "30s")
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      config,
+					ExpectError: regexp.MustCompile(`Invalid CONTEXTFORGE_TIMEOUT Value`),
+				},
+			},
+		})
+	})
+
+	t.Run("ca_certificate_file", func(t *testing.T) {
+		t.Setenv("CONTEXTFORGE_CA_CERT", filepath.Join(t.TempDir(), "missing.pem"))
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      config,
+					ExpectError: regexp.MustCompile(`Invalid CA Certificate File`),
+				},
+			},
+		})
+	})
+}
+
+// configureProvider runs the provider's Configure with the given attribute
+// values; attributes not listed are null.
+func configureProvider(t *testing.T, attrs map[string]tftypes.Value) *provider.ConfigureResponse {
+	t.Helper()
+	ctx := context.Background()
+	p := New("test")()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		if v, ok := attrs[name]; ok {
+			values[name] = v
+		} else {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+	return resp
+}
+
+func TestProviderConfigure_RequireEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	strict := map[string]tftypes.Value{
+		"bearer_token":     tftypes.NewValue(tftypes.String, "token"),
+		"require_endpoint": tftypes.NewValue(tftypes.Bool, true),
+	}
+
+	t.Run("strict without endpoint", func(t *testing.T) {
+		t.Setenv("CONTEXTFORGE_ENDPOINT", "")
+		resp := configureProvider(t, strict)
+		if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Missing Endpoint" {
+			t.Fatalf("expected a Missing Endpoint error, got %v", resp.Diagnostics)
+		}
+		if resp.ResourceData != nil {
+			t.Errorf("expected no client to be configured")
+		}
+	})
+
+	t.Run("strict with env endpoint", func(t *testing.T) {
+		t.Setenv("CONTEXTFORGE_ENDPOINT", server.URL)
+		resp := configureProvider(t, strict)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		if data, ok := resp.ResourceData.(*providerData); !ok || data.client.BaseURL != server.URL {
+			t.Errorf("expected a client for %s, got %#v", server.URL, resp.ResourceData)
+		}
+	})
+
+	t.Run("strict with empty endpoint", func(t *testing.T) {
+		t.Setenv("CONTEXTFORGE_ENDPOINT", "")
+		resp := configureProvider(t, map[string]tftypes.Value{
+			"endpoint":         tftypes.NewValue(tftypes.String, ""),
+			"bearer_token":     tftypes.NewValue(tftypes.String, "token"),
+			"require_endpoint": tftypes.NewValue(tftypes.Bool, true),
+		})
+		if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Missing Endpoint" {
+			t.Fatalf("expected a Missing Endpoint error, got %v", resp.Diagnostics)
+		}
+	})
+
+	t.Run("strict with unknown endpoint", func(t *testing.T) {
+		t.Setenv("CONTEXTFORGE_ENDPOINT", "")
+		resp := configureProvider(t, map[string]tftypes.Value{
+			"endpoint":         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"bearer_token":     tftypes.NewValue(tftypes.String, "token"),
+			"require_endpoint": tftypes.NewValue(tftypes.Bool, true),
+		})
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("expected an unknown endpoint not to be reported as missing, got %v", resp.Diagnostics)
+		}
+	})
+
+	t.Run("lenient without endpoint", func(t *testing.T) {
+		t.Setenv("CONTEXTFORGE_ENDPOINT", "")
+		resp := configureProvider(t, map[string]tftypes.Value{
+			"bearer_token": tftypes.NewValue(tftypes.String, "token"),
+		})
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		if data, ok := resp.ResourceData.(*providerData); !ok || data.client.BaseURL != defaultEndpoint {
+			t.Errorf("expected a client for %s, got %#v", defaultEndpoint, resp.ResourceData)
+		}
+	})
+}
+
+func TestAccProvider_RequireEndpoint(t *testing.T) {
+	t.Setenv("CONTEXTFORGE_ENDPOINT", "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  bearer_token     = "token"
+  require_endpoint = true
+}
+
+data "contextforge_health" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Missing Endpoint`),
+			},
+		},
+	})
+}
+
+func TestProviderConfigure_ReadOnlyBlocksWrites(t *testing.T) {
+	ctx := context.Background()
+	var writes atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writes.Add(1)
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	providerResp := configureProvider(t, map[string]tftypes.Value{
+		"endpoint":     tftypes.NewValue(tftypes.String, server.URL),
+		"bearer_token": tftypes.NewValue(tftypes.String, "token"),
+		"read_only":    tftypes.NewValue(tftypes.Bool, true),
+	})
+	if providerResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", providerResp.Diagnostics)
+	}
+
+	for _, newResource := range New("test")().Resources(ctx) {
+		r := newResource()
+		metadataResp := &fwresource.MetadataResponse{}
+		r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "contextforge"}, metadataResp)
+		if metadataResp.TypeName == "contextforge_example" {
+			// The scaffolding example never calls the gateway.
+			continue
+		}
+
+		t.Run(metadataResp.TypeName, func(t *testing.T) {
+			configureResp := &fwresource.ConfigureResponse{}
+			r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{ProviderData: providerResp.ResourceData}, configureResp)
+			if configureResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", configureResp.Diagnostics)
+			}
+
+			operations := map[string]func() diag.Diagnostics{
+				"create": func() diag.Diagnostics {
+					resp := &fwresource.CreateResponse{}
+					r.Create(ctx, fwresource.CreateRequest{}, resp)
+					return resp.Diagnostics
+				},
+				"update": func() diag.Diagnostics {
+					resp := &fwresource.UpdateResponse{}
+					r.Update(ctx, fwresource.UpdateRequest{}, resp)
+					return resp.Diagnostics
+				},
+				"delete": func() diag.Diagnostics {
+					resp := &fwresource.DeleteResponse{}
+					r.Delete(ctx, fwresource.DeleteRequest{}, resp)
+					return resp.Diagnostics
+				},
+			}
+			for name, operation := range operations {
+				diags := operation()
+				if !diags.HasError() || diags.Errors()[0].Summary() != "Provider Is Read-Only" {
+					t.Errorf("%s: expected a Provider Is Read-Only error, got %v", name, diags)
+				}
+			}
+		})
+	}
+
+	if n := writes.Load(); n != 0 {
+		t.Errorf("expected no writes to reach the gateway, got %d", n)
+	}
+}
+
+func TestProviderConfigure_ReadOnlyBlocksActions(t *testing.T) {
+	ctx := context.Background()
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			requests.Add(1)
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	providerResp := configureProvider(t, map[string]tftypes.Value{
+		"endpoint":     tftypes.NewValue(tftypes.String, server.URL),
+		"bearer_token": tftypes.NewValue(tftypes.String, "token"),
+		"read_only":    tftypes.NewValue(tftypes.Bool, true),
+	})
+	if providerResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", providerResp.Diagnostics)
+	}
+
+	for _, newAction := range New("test")().(provider.ProviderWithActions).Actions(ctx) {
+		a := newAction()
+		metadataResp := &action.MetadataResponse{}
+		a.Metadata(ctx, action.MetadataRequest{ProviderTypeName: "contextforge"}, metadataResp)
+		if metadataResp.TypeName == "contextforge_example" {
+			// The scaffolding example never calls the gateway.
+			continue
+		}
+
+		t.Run(metadataResp.TypeName, func(t *testing.T) {
+			configureResp := &action.ConfigureResponse{}
+			a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: providerResp.ActionData}, configureResp)
+			if configureResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", configureResp.Diagnostics)
+			}
+
+			resp := &action.InvokeResponse{}
+			a.Invoke(ctx, action.InvokeRequest{}, resp)
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Provider Is Read-Only" {
+				t.Errorf("expected a Provider Is Read-Only error, got %v", resp.Diagnostics)
+			}
+		})
+	}
+
+	if n := requests.Load(); n != 0 {
+		t.Errorf("expected no action requests to reach the gateway, got %d", n)
+	}
+}
+
+func TestAccProvider_ReadOnly(t *testing.T) {
+	var writes atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writes.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if r.URL.Path != "/health" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		testAccMockWrite(w, http.StatusOK, client.HealthResponse{Status: "ok"})
+	}))
+	defer server.Close()
+
+	config := `
+provider "contextforge" {
+  endpoint     = "` + server.URL + `"
+  bearer_token = "token"
+  read_only    = true
+}
+
+data "contextforge_health" "test" {}
+
+resource "contextforge_root" "test" {
+  uri  = "file:///workspace"
+  name = data.contextforge_health.test.status
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Unable to create root: the provider is configured with\s+read_only = true`),
+			},
+		},
+		CheckDestroy: func(*terraform.State) error {
+			if n := writes.Load(); n != 0 {
+				return fmt.Errorf("expected no writes to reach the gateway, got %d", n)
+			}
+			return nil
+		},
+	})
+}
+
+func TestProviderConfigure_EndpointNormalized(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	cases := map[string]struct {
+		endpoint string
+		warn     bool
+	}{
+		"canonical":         {endpoint: server.URL},
+		"trailing slash":    {endpoint: server.URL + "/", warn: true},
+		"repeated slashes":  {endpoint: server.URL + "//api//", warn: true},
+		"upper-case scheme": {endpoint: "HTTP://" + host},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := configureProvider(t, map[string]tftypes.Value{
+				"endpoint":     tftypes.NewValue(tftypes.String, tc.endpoint),
+				"bearer_token": tftypes.NewValue(tftypes.String, "token"),
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			warned := resp.Diagnostics.WarningsCount() == 1 && resp.Diagnostics.Warnings()[0].Summary() == "Endpoint Normalized"
+			if warned != tc.warn || resp.Diagnostics.WarningsCount() > 1 {
+				t.Errorf("expected warning=%t, got %v", tc.warn, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestProviderConfigure_Endpoints(t *testing.T) {
+	t.Setenv("CONTEXTFORGE_ENDPOINT", "")
+	primary := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(primary.Close)
+	standby := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(standby.Close)
+
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"bearer_token": tftypes.NewValue(tftypes.String, "token"),
+		"endpoints": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, primary.URL),
+			tftypes.NewValue(tftypes.String, standby.URL+"//"),
+		}),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	data, ok := resp.ResourceData.(*providerData)
+	if !ok {
+		t.Fatalf("expected a client, got %#v", resp.ResourceData)
+	}
+	c := data.client
+	if c.BaseURL != primary.URL || len(c.FallbackURLs) != 1 || c.FallbackURLs[0] != standby.URL {
+		t.Errorf("expected base URL %s with fallback %s, got %s and %v", primary.URL, standby.URL, c.BaseURL, c.FallbackURLs)
+	}
+	if len(resp.Diagnostics.Warnings()) == 0 || resp.Diagnostics.Warnings()[0].Summary() != "Endpoint Normalized" {
+		t.Errorf("expected an Endpoint Normalized warning, got %v", resp.Diagnostics)
+	}
+
+	// Canonical spellings of the same URL are used as is without a warning.
+	resp = configureProvider(t, map[string]tftypes.Value{
+		"bearer_token": tftypes.NewValue(tftypes.String, "token"),
+		"endpoints": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, primary.URL),
+			tftypes.NewValue(tftypes.String, strings.Replace(standby.URL, "http://", "HTTP://", 1)),
+		}),
+	})
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 0 {
+		t.Errorf("expected no diagnostics for an upper-case scheme, got %v", resp.Diagnostics)
+	}
+
+	resp = configureProvider(t, map[string]tftypes.Value{
+		"bearer_token": tftypes.NewValue(tftypes.String, "token"),
+		"endpoints": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, primary.URL),
+			tftypes.NewValue(tftypes.String, "standby:4444"),
+		}),
+	})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Invalid Endpoint" {
+		t.Errorf("expected an Invalid Endpoint error, got %v", resp.Diagnostics)
+	}
+}
+
+func TestAccProvider_EndpointsFailover(t *testing.T) {
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	standby := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		testAccMockWrite(w, http.StatusOK, client.HealthResponse{Status: "ok", Version: "standby"})
+	}))
+	defer standby.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + standby.URL + `"
+  endpoints    = ["` + down.URL + `", "` + standby.URL + `"]
+  bearer_token = "token"
+}
+
+data "contextforge_health" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `
+provider "contextforge" {
+  endpoints    = ["` + down.URL + `", "` + standby.URL + `"]
+  bearer_token = "token"
+}
+
+data "contextforge_health" "test" {}
+`,
+				Check: resource.TestCheckResourceAttr("data.contextforge_health.test", "version", "standby"),
+			},
+		},
+	})
+}
+
+func TestProviderConfigure_TraceHeader(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	t.Run("default", func(t *testing.T) {
+		t.Setenv("CONTEXTFORGE_TRACE_ID", "")
+		resp := configureProvider(t, map[string]tftypes.Value{
+			"endpoint":     tftypes.NewValue(tftypes.String, server.URL),
+			"bearer_token": tftypes.NewValue(tftypes.String, "token"),
+		})
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		c := resp.ResourceData.(*providerData).client
+		if c.TraceHeader != "X-Request-ID" || c.TraceID != "" {
+			t.Errorf("expected generated IDs in X-Request-ID, got header %q and ID %q", c.TraceHeader, c.TraceID)
+		}
+	})
+
+	t.Run("configured with ID from environment", func(t *testing.T) {
+		t.Setenv("CONTEXTFORGE_TRACE_ID", " run-1234 ")
+		resp := configureProvider(t, map[string]tftypes.Value{
+			"endpoint":     tftypes.NewValue(tftypes.String, server.URL),
+			"bearer_token": tftypes.NewValue(tftypes.String, "token"),
+			"trace_header": tftypes.NewValue(tftypes.String, "X-Correlation-ID"),
+		})
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		c := resp.ResourceData.(*providerData).client
+		if c.TraceHeader != "X-Correlation-ID" || c.TraceID != "run-1234" {
+			t.Errorf("expected ID run-1234 in X-Correlation-ID, got header %q and ID %q", c.TraceHeader, c.TraceID)
+		}
+	})
+
+	for name, attrs := range map[string]map[string]tftypes.Value{
+		"reserved header": {
+			"bearer_token": tftypes.NewValue(tftypes.String, "token"),
+			"trace_header": tftypes.NewValue(tftypes.String, "authorization"),
+		},
+		"API key header": {
+			"api_key":      tftypes.NewValue(tftypes.String, "key"),
+			"trace_header": tftypes.NewValue(tftypes.String, "x-api-key"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			attrs["endpoint"] = tftypes.NewValue(tftypes.String, server.URL)
+			resp := configureProvider(t, attrs)
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Reserved Trace Header" {
+				t.Errorf("expected a Reserved Trace Header error, got %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestProviderConfigure_RetryStatusCodes(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	codes := func(values ...int64) tftypes.Value {
+		elems := make([]tftypes.Value, len(values))
+		for i, v := range values {
+			elems[i] = tftypes.NewValue(tftypes.Number, v)
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, elems)
+	}
+
+	cases := map[string]struct {
+		codes     tftypes.Value
+		retried   []int
+		unretried []int
+	}{
+		"default": {
+			codes:     tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+			retried:   []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+			unretried: []int{http.StatusConflict},
+		},
+		"configured": {
+			codes:     codes(http.StatusConflict, http.StatusUnprocessableEntity),
+			retried:   []int{http.StatusConflict, http.StatusUnprocessableEntity},
+			unretried: []int{http.StatusServiceUnavailable},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := configureProvider(t, map[string]tftypes.Value{
+				"endpoint":           tftypes.NewValue(tftypes.String, server.URL),
+				"bearer_token":       tftypes.NewValue(tftypes.String, "token"),
+				"retry_status_codes": tc.codes,
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			c := resp.ResourceData.(*providerData).client
+			for _, status := range tc.retried {
+				if retry, _ := c.RetryPolicy(status, 0); !retry {
+					t.Errorf("expected status %d to be retried", status)
+				}
+			}
+			for _, status := range tc.unretried {
+				if retry, _ := c.RetryPolicy(status, 0); retry {
+					t.Errorf("expected status %d not to be retried", status)
+				}
+			}
+		})
+	}
+}
+
+func TestProviderConfigure_MaxRetries(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	cases := map[string]struct {
+		maxRetries tftypes.Value
+		want       int
+	}{
+		"default":  {maxRetries: tftypes.NewValue(tftypes.Number, nil), want: defaultMaxRetries},
+		"disabled": {maxRetries: tftypes.NewValue(tftypes.Number, 0), want: 0},
+		"set":      {maxRetries: tftypes.NewValue(tftypes.Number, 5), want: 5},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := configureProvider(t, map[string]tftypes.Value{
+				"endpoint":     tftypes.NewValue(tftypes.String, server.URL),
+				"bearer_token": tftypes.NewValue(tftypes.String, "token"),
+				"max_retries":  tc.maxRetries,
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if got := resp.ResourceData.(*providerData).client.MaxRetries; got != tc.want {
+				t.Errorf("expected MaxRetries %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestProviderConfigure_VerifyGateway(t *testing.T) {
+	respond := func(status int, body string) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			if _, err := w.Write([]byte(body)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+	const notGateway = "Endpoint Is Not a ContextForge Gateway"
+
+	cases := map[string]struct {
+		server  *httptest.Server
+		setting string
+		wantErr string
+	}{
+		"html decoy":                     {server: respond(http.StatusOK, `<html><body>Welcome</body></html>`), setting: "verify_gateway", wantErr: notGateway},
+		"json decoy":                     {server: respond(http.StatusOK, `{"message": "hello"}`), setting: "verify_gateway", wantErr: notGateway},
+		"mismatched json decoy":          {server: respond(http.StatusOK, `{"status": 200}`), setting: "verify_gateway", wantErr: notGateway},
+		"no health endpoint":             {server: respond(http.StatusNotFound, `Not Found`), setting: "verify_gateway", wantErr: notGateway},
+		"html decoy with require":        {server: respond(http.StatusOK, `<html></html>`), setting: "require_healthy", wantErr: notGateway},
+		"json decoy with require":        {server: respond(http.StatusOK, `{"message": "hello"}`), setting: "require_healthy", wantErr: notGateway},
+		"failing gateway":                {server: respond(http.StatusInternalServerError, `{"detail": "boom"}`), setting: "verify_gateway", wantErr: "Gateway Health Check Failed"},
+		"healthy gateway":                {server: respond(http.StatusOK, `{"status": "healthy"}`), setting: "verify_gateway"},
+		"unhealthy gateway":              {server: respond(http.StatusOK, `{"status": "degraded"}`), setting: "verify_gateway"},
+		"unhealthy gateway with require": {server: respond(http.StatusOK, `{"status": "degraded"}`), setting: "require_healthy", wantErr: "Gateway Unhealthy"},
+		"decoy without checks":           {server: respond(http.StatusOK, `{"message": "hello"}`)},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attrs := map[string]tftypes.Value{
+				"endpoint":     tftypes.NewValue(tftypes.String, tc.server.URL),
+				"bearer_token": tftypes.NewValue(tftypes.String, "token"),
+			}
+			if tc.setting != "" {
+				attrs[tc.setting] = tftypes.NewValue(tftypes.Bool, true)
+			}
+
+			resp := configureProvider(t, attrs)
+			if tc.wantErr == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tc.wantErr {
+				t.Fatalf("expected %q error, got %v", tc.wantErr, resp.Diagnostics)
+			}
+			if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, tc.setting+" is set") {
+				t.Errorf("expected the detail to name %s, got %q", tc.setting, detail)
+			}
+		})
+	}
+}
+
+func TestProviderConfigure_DeletionMode(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	for _, mode := range []string{"", deletionModeDelete, deletionModeDeactivate} {
+		t.Run(mode, func(t *testing.T) {
+			attrs := map[string]tftypes.Value{
+				"endpoint":     tftypes.NewValue(tftypes.String, server.URL),
+				"bearer_token": tftypes.NewValue(tftypes.String, "token"),
+			}
+			if mode != "" {
+				attrs["deletion_mode"] = tftypes.NewValue(tftypes.String, mode)
+			}
+
+			resp := configureProvider(t, attrs)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if got := resp.ResourceData.(*providerData).settings.deletionMode; got != mode {
+				t.Errorf("expected deletion mode %q, got %q", mode, got)
+			}
+		})
+	}
+}
+
+func TestProviderConfigure_OperationTimeout(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	cases := map[string]struct {
+		operationTimeout tftypes.Value
+		want             time.Duration
+	}{
+		"default": {operationTimeout: tftypes.NewValue(tftypes.Number, nil), want: defaultOperationTimeout},
+		"set":     {operationTimeout: tftypes.NewValue(tftypes.Number, 90), want: 90 * time.Second},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := configureProvider(t, map[string]tftypes.Value{
+				"endpoint":          tftypes.NewValue(tftypes.String, server.URL),
+				"bearer_token":      tftypes.NewValue(tftypes.String, "token"),
+				"operation_timeout": tc.operationTimeout,
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if got := resp.ResourceData.(*providerData).settings.defaultTimeout(); got != tc.want {
+				t.Errorf("expected default timeout %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+var _ action.Action = &RefreshGatewayAction{}
+var _ action.ActionWithConfigure = &RefreshGatewayAction{}
+
+func NewRefreshGatewayAction() action.Action {
+	return &RefreshGatewayAction{}
+}
+
+// RefreshGatewayAction re-runs tool discovery on a registered gateway.
+type RefreshGatewayAction struct {
+	client   *client.Client
+	settings providerSettings
+}
+
+// RefreshGatewayActionModel describes the action data model.
+type RefreshGatewayActionModel struct {
+	GatewayID types.String `tfsdk:"gateway_id"`
+}
+
+func (a *RefreshGatewayAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_refresh_gateway"
+}
+
+func (a *RefreshGatewayAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reconnects a registered gateway to its backend and rediscovers its tools, so tools added to the backend after registration become available. The number of tools discovered is reported as a warning.",
+		Attributes: map[string]schema.Attribute{
+			"gateway_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the gateway to refresh.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (a *RefreshGatewayAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = data.client
+	a.settings = data.settings
+}
+
+func (a *RefreshGatewayAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if readOnlyBlocked(a.settings, "refresh gateway", &resp.Diagnostics) {
+		return
+	}
+
+	var data RefreshGatewayActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gatewayID := data.GatewayID.ValueString()
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("refreshing tools of gateway %s", gatewayID),
+	})
+
+	result, err := a.client.RefreshGatewayTools(ctx, gatewayID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "refresh gateway", err)
+		return
+	}
+	if result == nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Gateway with ID %s not found", gatewayID))
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Gateway Tools Refreshed",
+		fmt.Sprintf("Gateway %s discovered %d tool(s).", gatewayID, result.ToolsDiscovered),
+	)
+
+	tflog.Trace(ctx, "invoked refresh_gateway action")
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccRefreshGatewayAction(t *testing.T) {
+	var mu sync.Mutex
+	refreshes := 0
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/gateways/gw-1/refresh" || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		mu.Lock()
+		refreshes++
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"tools_discovered": 4}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRefreshGatewayActionConfig(mockServer.URL, "gw-1"),
+				PostApplyFunc: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					if refreshes != 1 {
+						t.Errorf("expected 1 refresh request, got %d", refreshes)
+					}
+				},
+			},
+		},
+	})
+}
+
+func TestAccRefreshGatewayAction_NotFound(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRefreshGatewayActionConfig(mockServer.URL, "missing"),
+				ExpectError: regexp.MustCompile(`Gateway with ID missing not found`),
+			},
+		},
+	})
+}
+
+func testAccRefreshGatewayActionConfig(endpoint, gatewayID string) string {
+	return `
+provider "contextforge" {
+  endpoint     = "` + endpoint + `"
+  bearer_token = "test"
+}
+
+resource "terraform_data" "test" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.contextforge_refresh_gateway.test]
+    }
+  }
+}
+
+action "contextforge_refresh_gateway" "test" {
+  config {
+    gateway_id = "` + gatewayID + `"
+  }
+}
+`
+}
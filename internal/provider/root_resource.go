@@ -5,7 +5,12 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,7 +31,9 @@ func NewRootResource() resource.Resource {
 
 // RootResource manages a root on the MCP Gateway.
 type RootResource struct {
-	client *client.Client
+	client   *client.Client
+	settings providerSettings
+	adopted  *rootAdoptions
 }
 
 // RootResourceModel describes the resource data model.
@@ -41,7 +48,9 @@ func (r *RootResource) Metadata(ctx context.Context, req resource.MetadataReques
 
 func (r *RootResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a root on the ContextForge MCP Gateway.",
+		MarkdownDescription: "Manages a root on the ContextForge MCP Gateway. The URI identifies the root, so a replacement created before the root it replaces, " +
+			"as with `create_before_destroy`, takes over the existing root instead of failing, and the root is kept when the replaced instance is destroyed. " +
+			"Any other root that already exists with the URI is taken over the same way, with a warning; use `terraform import` to bring it under management explicitly.",
 		Attributes: map[string]schema.Attribute{
 			"uri": schema.StringAttribute{
 				MarkdownDescription: "URI of the root. Serves as the unique identifier.",
@@ -66,19 +75,25 @@ func (r *RootResource) Configure(ctx context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = apiClient
+	r.client = data.client
+	r.settings = data.settings
+	r.adopted = data.adoptedRoots
 }
 
 func (r *RootResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if readOnlyBlocked(r.settings, "create root", &resp.Diagnostics) {
+		return
+	}
+
 	var data RootResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -88,21 +103,27 @@ func (r *RootResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	createReq := client.Root{
 		URI:  data.URI.ValueString(),
-		Name: data.Name.ValueString(),
+		Name: data.Name.ValueStringPointer(),
 	}
 
 	root, err := r.client.CreateRoot(ctx, createReq)
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) && isDuplicateError(apiErr) {
+		root, err = r.adoptRoot(ctx, createReq, err)
+		if err == nil {
+			resp.Diagnostics.AddWarning(
+				"Existing Root Adopted",
+				fmt.Sprintf("A root with URI %q already existed on the gateway and is now managed by this resource. This is expected when the root is replaced with create_before_destroy.", createReq.URI),
+			)
+		}
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create root, got error: %s", err))
+		addCreateError(&resp.Diagnostics, "create root", fmt.Sprintf("a root with URI %q", data.URI.ValueString()), err)
 		return
 	}
 
 	data.URI = types.StringValue(root.URI)
-	if root.Name != "" {
-		data.Name = types.StringValue(root.Name)
-	} else if data.Name.IsNull() || data.Name.IsUnknown() {
-		data.Name = types.StringNull()
-	}
+	data.Name = stringOrPrior(root.Name, data.Name)
 
 	tflog.Trace(ctx, "created a root resource")
 
@@ -119,7 +140,7 @@ func (r *RootResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	roots, err := r.client.ListRoots(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list roots, got error: %s", err))
+		addClientError(&resp.Diagnostics, "list roots", err)
 		return
 	}
 
@@ -136,17 +157,18 @@ func (r *RootResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	// A name the gateway does not report, e.g. one set on import, is kept.
 	data.URI = types.StringValue(found.URI)
-	if found.Name != "" {
-		data.Name = types.StringValue(found.Name)
-	} else {
-		data.Name = types.StringNull()
-	}
+	data.Name = stringOrPrior(found.Name, data.Name)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *RootResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if readOnlyBlocked(r.settings, "update root", &resp.Diagnostics) {
+		return
+	}
+
 	// All attributes use RequiresReplace, so Update should never be called.
 	resp.Diagnostics.AddError(
 		"Unexpected Update",
@@ -155,6 +177,10 @@ func (r *RootResource) Update(ctx context.Context, req resource.UpdateRequest, r
 }
 
 func (r *RootResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if readOnlyBlocked(r.settings, "delete root", &resp.Diagnostics) {
+		return
+	}
+
 	var data RootResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -162,13 +188,136 @@ func (r *RootResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	if r.adopted.take(data.URI.ValueString()) {
+		tflog.Debug(ctx, "kept root adopted by its replacement", map[string]interface{}{"uri": data.URI.ValueString()})
+		return
+	}
+
 	err := r.client.DeleteRoot(ctx, data.URI.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete root, got error: %s", err))
+		addClientError(&resp.Diagnostics, "delete root", err)
+		return
+	}
+}
+
+// rootAdoptions records the URIs of the roots that Create found already on
+// the gateway and took over. A root's URI is its identity, so when a root is
+// replaced with create_before_destroy its replacement is created while the
+// root still exists; the record lets the delete of the replaced instance that
+// follows in the same apply leave the root to its replacement. Each provider
+// configuration has its own record, so configurations for different gateways
+// do not interfere.
+type rootAdoptions struct {
+	mu   sync.Mutex
+	uris map[string]bool
+}
+
+// add records that the root with uri was adopted. It does nothing on a nil
+// record.
+func (a *rootAdoptions) add(uri string) {
+	if a == nil {
 		return
 	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.uris == nil {
+		a.uris = make(map[string]bool)
+	}
+	a.uris[uri] = true
+}
+
+// take reports whether the root with uri was adopted and forgets it, so only
+// the first delete after the adoption keeps the root.
+func (a *rootAdoptions) take(uri string) bool {
+	if a == nil {
+		return false
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	adopted := a.uris[uri]
+	delete(a.uris, uri)
+	return adopted
+}
+
+// adoptRoot takes over the existing root with the URI of req after a create
+// was rejected with createErr because the URI is taken. Roots cannot be
+// updated in place, so a root whose name differs is recreated with the
+// planned name. createErr is returned if the root is not listed after all.
+func (r *RootResource) adoptRoot(ctx context.Context, req client.Root, createErr error) (*client.Root, error) {
+	roots, err := r.client.ListRoots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(roots, func(root client.Root) bool { return root.URI == req.URI })
+	if i < 0 {
+		return nil, createErr
+	}
+
+	root := &roots[i]
+	if req.Name != nil && derefString(root.Name) != *req.Name {
+		if err := r.client.DeleteRoot(ctx, req.URI); err != nil {
+			return nil, err
+		}
+		if root, err = r.client.CreateRoot(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	r.adopted.add(req.URI)
+	tflog.Debug(ctx, "adopted existing root", map[string]interface{}{"uri": req.URI})
+	return root, nil
 }
 
+// ImportState takes the root URI as the import ID, or "<uri>,<name>" to set
+// the name as well, which Read cannot recover when the gateway does not report
+// one. The URI is checked against ListRoots up front so a mistyped ID fails the
+// import instead of producing an empty state on the following Read.
 func (r *RootResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("uri"), req, resp)
+	roots, err := r.client.ListRoots(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list roots", err)
+		return
+	}
+
+	uri, name := parseRootImportID(req.ID, roots)
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Scheme == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected the import ID to be a root URI such as file:///workspace, optionally followed by a comma and the root name, got %q.", req.ID),
+		)
+		return
+	}
+
+	i := slices.IndexFunc(roots, func(root client.Root) bool { return root.URI == uri })
+	if i < 0 {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Root with URI %s not found", uri))
+		return
+	}
+	if !name.IsNull() && roots[i].Name != nil && *roots[i].Name != name.ValueString() {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The import ID names the root %q, but the gateway reports the root with URI %s as %q.", name.ValueString(), uri, *roots[i].Name),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uri"), uri)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), stringOrPrior(roots[i].Name, name))...)
+}
+
+// parseRootImportID splits a root import ID into the URI and the name, which
+// is null when the ID has none. An ID that is the URI of one of roots is taken
+// whole, since URIs may contain commas; otherwise the name follows the last
+// comma.
+func parseRootImportID(id string, roots []client.Root) (string, types.String) {
+	if slices.ContainsFunc(roots, func(root client.Root) bool { return root.URI == id }) {
+		return id, types.StringNull()
+	}
+	i := strings.LastIndex(id, ",")
+	if i < 0 {
+		return id, types.StringNull()
+	}
+	name := id[i+1:]
+	return id[:i], stringOrPrior(&name, types.StringNull())
 }
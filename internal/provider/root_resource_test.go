@@ -4,16 +4,28 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/nkbud/terraform-provider-contextforge/internal/client"
 )
@@ -41,7 +53,7 @@ func TestAccRootResource(t *testing.T) {
 			if err := json.NewEncoder(w).Encode([]client.Root{
 				{
 					URI:  "file:///workspace",
-					Name: "test-root",
+					Name: stringPointer("test-root"),
 				},
 			}); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -79,6 +91,104 @@ func TestAccRootResource(t *testing.T) {
 	})
 }
 
+func TestAccRootResource_Import(t *testing.T) {
+	const rootURI = "file:///srv/my%20project?branch=a&b"
+
+	deleted := false
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/roots" && r.Method == http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode([]client.Root{
+				{
+					URI:  rootURI,
+					Name: stringPointer("project"),
+				},
+			}); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.Method == http.MethodDelete:
+			if r.URL.EscapedPath() != "/roots/"+url.PathEscape(rootURI) {
+				t.Errorf("unexpected delete path %s", r.URL.EscapedPath())
+			}
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockServer.Close()
+
+	config := `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+resource "contextforge_root" "test" {
+  uri  = "` + rootURI + `"
+  name = "project"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:             config,
+				ResourceName:       "contextforge_root.test",
+				ImportState:        true,
+				ImportStateId:      rootURI,
+				ImportStatePersist: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].Attributes["uri"] != rootURI {
+						return fmt.Errorf("expected root %s to be imported, got %v", rootURI, states)
+					}
+					if states[0].Attributes["name"] != "project" {
+						return fmt.Errorf("expected name project, got %q", states[0].Attributes["name"])
+					}
+					return nil
+				},
+			},
+			{
+				Config:        config,
+				ResourceName:  "contextforge_root.test",
+				ImportState:   true,
+				ImportStateId: rootURI + ",project",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].Attributes["uri"] != rootURI || states[0].Attributes["name"] != "project" {
+						return fmt.Errorf("expected root %s named project to be imported, got %v", rootURI, states)
+					}
+					return nil
+				},
+			},
+			{
+				Config:        config,
+				ResourceName:  "contextforge_root.test",
+				ImportState:   true,
+				ImportStateId: "file:///does-not-exist",
+				ExpectError:   regexp.MustCompile(`Root with URI file:///does-not-exist not found`),
+			},
+			{
+				Config:        config,
+				ResourceName:  "contextforge_root.test",
+				ImportState:   true,
+				ImportStateId: "not a uri",
+				ExpectError:   regexp.MustCompile(`Invalid Import ID`),
+			},
+		},
+		CheckDestroy: func(*terraform.State) error {
+			if !deleted {
+				return fmt.Errorf("expected imported root to be deleted")
+			}
+			return nil
+		},
+	})
+}
+
 func testAccRootResourceConfig(endpoint string) string {
 	return `
 provider "contextforge" {
@@ -92,3 +202,200 @@ resource "contextforge_root" "test" {
 }
 `
 }
+
+// testRootApply runs Create and Delete of RootResource directly, in the
+// order Terraform uses for a replacement.
+type testRootApply struct {
+	t      *testing.T
+	r      *RootResource
+	schema schema.Schema
+}
+
+func newTestRootApply(t *testing.T, endpoint string) *testRootApply {
+	r := &RootResource{client: client.NewClient(endpoint, "test"), adopted: &rootAdoptions{}}
+	r.client.MaxRetries = 0
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, schemaResp)
+	return &testRootApply{t: t, r: r, schema: schemaResp.Schema}
+}
+
+func (a *testRootApply) empty() tftypes.Value {
+	return tftypes.NewValue(a.schema.Type().TerraformType(context.Background()), nil)
+}
+
+func (a *testRootApply) create(uri, name string) RootResourceModel {
+	a.t.Helper()
+	data, diags := a.tryCreate(uri, name)
+	if diags.HasError() {
+		a.t.Fatalf("unexpected error creating %s: %v", uri, diags)
+	}
+	return data
+}
+
+// tryCreate runs Create and returns the resulting state and diagnostics.
+func (a *testRootApply) tryCreate(uri, name string) (RootResourceModel, diag.Diagnostics) {
+	a.t.Helper()
+	ctx := context.Background()
+	plan := tfsdk.Plan{Schema: a.schema, Raw: a.empty()}
+	for attr, value := range map[string]string{"uri": uri, "name": name} {
+		if diags := plan.SetAttribute(ctx, path.Root(attr), value); diags.HasError() {
+			a.t.Fatalf("unexpected diagnostics building plan: %v", diags)
+		}
+	}
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: a.schema, Raw: a.empty()}}
+	a.r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+	var data RootResourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	}
+	return data, resp.Diagnostics
+}
+
+func (a *testRootApply) delete(uri, name string) {
+	a.t.Helper()
+	ctx := context.Background()
+	state := tfsdk.State{Schema: a.schema, Raw: a.empty()}
+	for attr, value := range map[string]string{"uri": uri, "name": name} {
+		if diags := state.SetAttribute(ctx, path.Root(attr), value); diags.HasError() {
+			a.t.Fatalf("unexpected diagnostics building state: %v", diags)
+		}
+	}
+
+	resp := &fwresource.DeleteResponse{}
+	a.r.Delete(ctx, fwresource.DeleteRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		a.t.Fatalf("unexpected error deleting %s: %v", uri, resp.Diagnostics)
+	}
+}
+
+func TestRootResource_CreateBeforeDestroy(t *testing.T) {
+	const uri = "file:///workspace"
+
+	for name, newName := range map[string]string{
+		"name change":    "renamed",
+		"forced replace": "workspace",
+	} {
+		t.Run(name, func(t *testing.T) {
+			api, server := newTestRootsAPI(t, map[string]string{uri: "workspace"})
+			roots := api.roots
+			apply := newTestRootApply(t, server.URL)
+
+			// The replacement is created while the root it replaces exists.
+			data, diags := apply.tryCreate(uri, newName)
+			if diags.HasError() {
+				t.Fatalf("unexpected error creating %s: %v", uri, diags)
+			}
+			if diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Existing Root Adopted" {
+				t.Errorf("expected an Existing Root Adopted warning, got %v", diags)
+			}
+			if data.URI.ValueString() != uri || data.Name.ValueString() != newName {
+				t.Errorf("expected state %s/%s, got %s/%s", uri, newName, data.URI, data.Name)
+			}
+			if got, ok := roots[uri]; !ok || got != newName {
+				t.Fatalf("expected the gateway to hold %s named %q after create, got %v", uri, newName, roots)
+			}
+
+			// Deleting the replaced instance must leave the root to its replacement.
+			apply.delete(uri, "workspace")
+			if got, ok := roots[uri]; !ok || got != newName {
+				t.Fatalf("expected the root to survive the delete of the replaced instance, got %v", roots)
+			}
+
+			// A later destroy of the replacement deletes the root.
+			apply.delete(uri, newName)
+			if _, ok := roots[uri]; ok {
+				t.Errorf("expected the root to be deleted on destroy, got %v", roots)
+			}
+		})
+	}
+}
+
+func TestRootResource_DestroyBeforeCreate(t *testing.T) {
+	const uri = "file:///workspace"
+
+	api, server := newTestRootsAPI(t, map[string]string{uri: "workspace"})
+	roots := api.roots
+	apply := newTestRootApply(t, server.URL)
+
+	apply.delete(uri, "workspace")
+	if _, ok := roots[uri]; ok {
+		t.Fatalf("expected the root to be deleted, got %v", roots)
+	}
+	apply.create(uri, "renamed")
+	if got := roots[uri]; got != "renamed" {
+		t.Fatalf("expected the root to be recreated as renamed, got %v", roots)
+	}
+
+	apply.delete(uri, "renamed")
+	if _, ok := roots[uri]; ok {
+		t.Errorf("expected the root to be deleted on destroy, got %v", roots)
+	}
+}
+
+// importAndRead imports id and refreshes the result, as terraform import does.
+func (a *testRootApply) importAndRead(id string) (RootResourceModel, diag.Diagnostics) {
+	a.t.Helper()
+	ctx := context.Background()
+
+	importResp := &fwresource.ImportStateResponse{State: tfsdk.State{Schema: a.schema, Raw: a.empty()}}
+	a.r.ImportState(ctx, fwresource.ImportStateRequest{ID: id}, importResp)
+	if importResp.Diagnostics.HasError() {
+		return RootResourceModel{}, importResp.Diagnostics
+	}
+
+	readResp := &fwresource.ReadResponse{State: importResp.State}
+	a.r.Read(ctx, fwresource.ReadRequest{State: importResp.State}, readResp)
+	var data RootResourceModel
+	if !readResp.Diagnostics.HasError() {
+		readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+	}
+	return data, readResp.Diagnostics
+}
+
+func TestRootResourceImportState(t *testing.T) {
+	_, server := newTestRootsAPI(t, map[string]string{
+		"file:///unnamed":       "",
+		"file:///named":         "project",
+		"file:///a,b?tags=x,y":  "",
+		"file:///a,b?tags=x,y,": "",
+	})
+
+	cases := map[string]struct {
+		id       string
+		wantURI  string
+		wantName types.String
+		wantErr  string
+	}{
+		"uri":                      {id: "file:///unnamed", wantURI: "file:///unnamed", wantName: types.StringNull()},
+		"uri and name":             {id: "file:///unnamed,docs", wantURI: "file:///unnamed", wantName: types.StringValue("docs")},
+		"uri and empty name":       {id: "file:///unnamed,", wantURI: "file:///unnamed", wantName: types.StringNull()},
+		"reported name":            {id: "file:///named", wantURI: "file:///named", wantName: types.StringValue("project")},
+		"matching name":            {id: "file:///named,project", wantURI: "file:///named", wantName: types.StringValue("project")},
+		"uri with commas":          {id: "file:///a,b?tags=x,y", wantURI: "file:///a,b?tags=x,y", wantName: types.StringNull()},
+		"uri with commas and name": {id: "file:///a,b?tags=x,y,,docs", wantURI: "file:///a,b?tags=x,y,", wantName: types.StringValue("docs")},
+		"uri with trailing comma":  {id: "file:///a,b?tags=x,y,", wantURI: "file:///a,b?tags=x,y,", wantName: types.StringNull()},
+		"conflicting name":         {id: "file:///named,other", wantErr: "Invalid Import ID"},
+		"missing root":             {id: "file:///missing,docs", wantErr: "Not Found"},
+		"invalid uri":              {id: "not a uri,docs", wantErr: "Invalid Import ID"},
+		"name without uri":         {id: ",docs", wantErr: "Invalid Import ID"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			data, diags := newTestRootApply(t, server.URL).importAndRead(tc.id)
+			if tc.wantErr != "" {
+				if !diags.HasError() || diags.Errors()[0].Summary() != tc.wantErr {
+					t.Fatalf("expected %q error, got %v", tc.wantErr, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if data.URI.ValueString() != tc.wantURI || !data.Name.Equal(tc.wantName) {
+				t.Errorf("expected uri %q and name %s, got %s and %s", tc.wantURI, tc.wantName, data.URI, data.Name)
+			}
+		})
+	}
+}
@@ -74,16 +74,16 @@ func (d *RootsDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = apiClient
+	d.client = data.client
 }
 
 func (d *RootsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -96,7 +96,7 @@ func (d *RootsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	roots, err := d.client.ListRoots(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list roots, got error: %s", err))
+		addClientError(&resp.Diagnostics, "list roots", err)
 		return
 	}
 
@@ -104,7 +104,7 @@ func (d *RootsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	for i, r := range roots {
 		data.Roots[i] = RootItemModel{
 			URI:  types.StringValue(r.URI),
-			Name: types.StringValue(r.Name),
+			Name: types.StringValue(derefString(r.Name)),
 		}
 	}
 
@@ -0,0 +1,335 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+var _ resource.Resource = &RootsResource{}
+var _ resource.ResourceWithImportState = &RootsResource{}
+var _ resource.ResourceWithValidateConfig = &RootsResource{}
+
+func NewRootsResource() resource.Resource {
+	return &RootsResource{}
+}
+
+// RootsResource manages a set of roots on the MCP Gateway as one resource.
+type RootsResource struct {
+	client   *client.Client
+	settings providerSettings
+}
+
+// RootsResourceModel describes the resource data model.
+type RootsResourceModel struct {
+	Roots []RootItemModel `tfsdk:"roots"`
+	ID    types.String    `tfsdk:"id"`
+}
+
+func (r *RootsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roots"
+}
+
+func (r *RootsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a set of roots on the ContextForge MCP Gateway as a single resource. " +
+			"Roots are identified by URI: roots added to `roots` are created, roots removed from it are deleted, and " +
+			"a root whose name changes is deleted and created again, since the gateway cannot update roots in place. " +
+			"Roots on the gateway that were never part of `roots` are left alone. Do not manage the same URI with " +
+			"both this resource and `contextforge_root`.",
+		Attributes: map[string]schema.Attribute{
+			"roots": schema.SetNestedAttribute{
+				MarkdownDescription: "Roots to keep on the gateway. Each URI may appear only once.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uri": schema.StringAttribute{
+							MarkdownDescription: "URI of the root. Serves as the unique identifier.",
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the root.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Placeholder identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects configurations that list the same URI more than
+// once, e.g. with two different names, since a URI identifies a root.
+func (r *RootsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var roots types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("roots"), &roots)...)
+	if resp.Diagnostics.HasError() || roots.IsNull() || roots.IsUnknown() {
+		return
+	}
+
+	var items []RootItemModel
+	resp.Diagnostics.Append(roots.ElementsAs(ctx, &items, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		if item.URI.IsNull() || item.URI.IsUnknown() {
+			continue
+		}
+		uri := item.URI.ValueString()
+		if seen[uri] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("roots"),
+				"Duplicate Root URI",
+				fmt.Sprintf("The root URI %q is listed more than once. Each URI identifies one root and may appear only once.", uri),
+			)
+		}
+		seen[uri] = true
+	}
+}
+
+func (r *RootsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.client
+	r.settings = data.settings
+}
+
+func (r *RootsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if readOnlyBlocked(r.settings, "create roots", &resp.Diagnostics) {
+		return
+	}
+
+	var data RootsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Roots = r.reconcile(ctx, nil, data.Roots, &resp.Diagnostics)
+	data.ID = types.StringValue("roots")
+
+	tflog.Trace(ctx, "created a roots resource")
+
+	// The state is saved even when reconciling failed part way, so the roots
+	// that were created are tracked and the next apply retries the rest.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RootsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RootsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roots, err := r.client.ListRoots(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list roots", err)
+		return
+	}
+
+	current := make(map[string]client.Root, len(roots))
+	for _, root := range roots {
+		current[root.URI] = root
+	}
+
+	// Only the roots this resource manages are refreshed; one that was
+	// deleted outside Terraform drops out of state and is planned again.
+	managed := make([]RootItemModel, 0, len(data.Roots))
+	for _, item := range data.Roots {
+		root, ok := current[item.URI.ValueString()]
+		if !ok {
+			continue
+		}
+		managed = append(managed, RootItemModel{
+			URI:  types.StringValue(root.URI),
+			Name: stringOrPrior(root.Name, item.Name),
+		})
+	}
+	data.Roots = managed
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RootsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if readOnlyBlocked(r.settings, "update roots", &resp.Diagnostics) {
+		return
+	}
+
+	var plan, state RootsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Roots = r.reconcile(ctx, state.Roots, plan.Roots, &resp.Diagnostics)
+	plan.ID = state.ID
+
+	tflog.Trace(ctx, "updated a roots resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RootsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if readOnlyBlocked(r.settings, "delete roots", &resp.Diagnostics) {
+		return
+	}
+
+	var data RootsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, item := range data.Roots {
+		if err := r.client.DeleteRoot(ctx, item.URI.ValueString()); err != nil {
+			addClientError(&resp.Diagnostics, "delete root", err)
+			return
+		}
+	}
+}
+
+// ImportState adopts every root currently on the gateway. The import ID is
+// not used; by convention it is "roots".
+func (r *RootsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	roots, err := r.client.ListRoots(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list roots", err)
+		return
+	}
+
+	data := RootsResourceModel{
+		Roots: make([]RootItemModel, 0, len(roots)),
+		ID:    types.StringValue("roots"),
+	}
+	for _, root := range roots {
+		data.Roots = append(data.Roots, RootItemModel{
+			URI:  types.StringValue(root.URI),
+			Name: stringOrPrior(root.Name, types.StringNull()),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// reconcile makes the gateway's roots match desired, given the roots the
+// resource managed before (prior), and returns the roots it manages
+// afterwards. Roots in prior that are no longer desired, or whose name
+// changed, are deleted first; every desired root the gateway does not already
+// have under the same name is then created, replacing a root with the same URI
+// and a different name. Errors are added to diagnostics and stop the run, and
+// the returned roots then reflect what was done so far.
+func (r *RootsResource) reconcile(ctx context.Context, prior, desired []RootItemModel, diagnostics *diag.Diagnostics) []RootItemModel {
+	roots, err := r.client.ListRoots(ctx)
+	if err != nil {
+		addClientError(diagnostics, "list roots", err)
+		return prior
+	}
+
+	current := make(map[string]client.Root, len(roots))
+	for _, root := range roots {
+		current[root.URI] = root
+	}
+	wanted := make(map[string]RootItemModel, len(desired))
+	for _, item := range desired {
+		wanted[item.URI.ValueString()] = item
+	}
+	managed := make(map[string]RootItemModel, len(prior)+len(desired))
+	for _, item := range prior {
+		if _, ok := current[item.URI.ValueString()]; ok {
+			managed[item.URI.ValueString()] = item
+		}
+	}
+
+	result := func() []RootItemModel {
+		items := slices.Collect(maps.Values(managed))
+		slices.SortFunc(items, func(a, b RootItemModel) int {
+			return strings.Compare(a.URI.ValueString(), b.URI.ValueString())
+		})
+		return items
+	}
+
+	deleteRoot := func(uri string) bool {
+		if err := r.client.DeleteRoot(ctx, uri); err != nil {
+			addClientError(diagnostics, "delete root", err)
+			return false
+		}
+		delete(current, uri)
+		delete(managed, uri)
+		return true
+	}
+
+	for _, uri := range slices.Sorted(maps.Keys(managed)) {
+		item, keep := wanted[uri]
+		if keep && item.Name.ValueString() == managed[uri].Name.ValueString() {
+			continue
+		}
+		if !deleteRoot(uri) {
+			return result()
+		}
+	}
+
+	for _, item := range desired {
+		uri := item.URI.ValueString()
+		if root, ok := current[uri]; ok {
+			if derefString(root.Name) == item.Name.ValueString() {
+				managed[uri] = RootItemModel{URI: item.URI, Name: stringOrPrior(root.Name, item.Name)}
+				continue
+			}
+			if !deleteRoot(uri) {
+				return result()
+			}
+		}
+
+		root, err := r.client.CreateRoot(ctx, client.Root{URI: uri, Name: item.Name.ValueStringPointer()})
+		if err != nil {
+			addCreateError(diagnostics, "create root", fmt.Sprintf("a root with URI %q", uri), err)
+			return result()
+		}
+		managed[uri] = RootItemModel{URI: item.URI, Name: stringOrPrior(root.Name, item.Name)}
+	}
+
+	return result()
+}
@@ -0,0 +1,331 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+// testRootsAPI serves the roots endpoints from an in-memory map of URI to
+// name and records the creates and deletes it receives.
+type testRootsAPI struct {
+	mu      sync.Mutex
+	roots   map[string]string
+	created []string
+	deleted []string
+}
+
+func newTestRootsAPI(t *testing.T, roots map[string]string) (*testRootsAPI, *httptest.Server) {
+	t.Helper()
+	api := &testRootsAPI{roots: roots}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	return api, server
+}
+
+func (a *testRootsAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	switch {
+	case r.URL.Path == "/roots" && r.Method == http.MethodGet:
+		roots := make([]client.Root, 0, len(a.roots))
+		for _, uri := range slices.Sorted(maps.Keys(a.roots)) {
+			// Like the gateway, leave out the name of a root that has none.
+			root := client.Root{URI: uri}
+			if name := a.roots[uri]; name != "" {
+				root.Name = &name
+			}
+			roots = append(roots, root)
+		}
+		testAccMockWrite(w, http.StatusOK, roots)
+	case r.URL.Path == "/roots" && r.Method == http.MethodPost:
+		var root client.Root
+		if err := json.NewDecoder(r.Body).Decode(&root); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if _, ok := a.roots[root.URI]; ok {
+			testAccMockWrite(w, http.StatusConflict, map[string]string{"detail": "root already exists"})
+			return
+		}
+		a.roots[root.URI] = derefString(root.Name)
+		a.created = append(a.created, root.URI)
+		testAccMockWrite(w, http.StatusCreated, root)
+	case strings.HasPrefix(r.URL.EscapedPath(), "/roots/") && r.Method == http.MethodDelete:
+		uri, err := url.PathUnescape(strings.TrimPrefix(r.URL.EscapedPath(), "/roots/"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if _, ok := a.roots[uri]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(a.roots, uri)
+		a.deleted = append(a.deleted, uri)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func testRootItems(roots ...string) []RootItemModel {
+	items := make([]RootItemModel, 0, len(roots))
+	for _, root := range roots {
+		uri, name, _ := strings.Cut(root, "=")
+		item := RootItemModel{URI: types.StringValue(uri), Name: types.StringNull()}
+		if name != "" {
+			item.Name = types.StringValue(name)
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func TestRootsResourceReconcile(t *testing.T) {
+	api, server := newTestRootsAPI(t, map[string]string{
+		"file:///keep":      "keep",
+		"file:///remove":    "remove",
+		"file:///rename":    "old",
+		"file:///unmanaged": "other",
+	})
+	r := &RootsResource{client: client.NewClient(server.URL, "test")}
+
+	var diags diag.Diagnostics
+	got := r.reconcile(context.Background(),
+		testRootItems("file:///keep=keep", "file:///remove=remove", "file:///rename=old"),
+		testRootItems("file:///keep=keep", "file:///rename=new", "file:///add"),
+		&diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	want := testRootItems("file:///add", "file:///keep=keep", "file:///rename=new")
+	if !slices.Equal(got, want) {
+		t.Errorf("expected managed roots %v, got %v", want, got)
+	}
+	if wantDeleted := []string{"file:///remove", "file:///rename"}; !slices.Equal(api.deleted, wantDeleted) {
+		t.Errorf("expected deletes %v, got %v", wantDeleted, api.deleted)
+	}
+	if wantCreated := []string{"file:///rename", "file:///add"}; !slices.Equal(api.created, wantCreated) {
+		t.Errorf("expected creates %v, got %v", wantCreated, api.created)
+	}
+	if api.roots["file:///unmanaged"] != "other" {
+		t.Errorf("expected the unmanaged root to be left alone, got %v", api.roots)
+	}
+}
+
+func TestRootsResourceReconcile_AdoptsExisting(t *testing.T) {
+	api, server := newTestRootsAPI(t, map[string]string{"file:///existing": "same"})
+	r := &RootsResource{client: client.NewClient(server.URL, "test")}
+
+	var diags diag.Diagnostics
+	got := r.reconcile(context.Background(), nil, testRootItems("file:///existing=same"), &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if want := testRootItems("file:///existing=same"); !slices.Equal(got, want) {
+		t.Errorf("expected managed roots %v, got %v", want, got)
+	}
+	if len(api.created) != 0 || len(api.deleted) != 0 {
+		t.Errorf("expected no changes, got creates %v and deletes %v", api.created, api.deleted)
+	}
+}
+
+func TestRootsResourceReconcile_PartialFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet:
+			testAccMockWrite(w, http.StatusOK, []client.Root{})
+		case r.Method == http.MethodPost:
+			var root client.Root
+			if err := json.NewDecoder(r.Body).Decode(&root); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if root.URI == "file:///b" {
+				testAccMockWrite(w, http.StatusUnprocessableEntity, map[string]string{"detail": "invalid root"})
+				return
+			}
+			testAccMockWrite(w, http.StatusCreated, root)
+		}
+	}))
+	defer server.Close()
+	r := &RootsResource{client: client.NewClient(server.URL, "test")}
+
+	var diags diag.Diagnostics
+	got := r.reconcile(context.Background(), nil, testRootItems("file:///a", "file:///b", "file:///c"), &diags)
+	if !diags.HasError() {
+		t.Fatal("expected an error")
+	}
+	if want := testRootItems("file:///a"); !slices.Equal(got, want) {
+		t.Errorf("expected only the created root to be managed, got %v", got)
+	}
+}
+
+func TestRootsResourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &RootsResource{}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	for name, tc := range map[string]struct {
+		roots   []RootItemModel
+		wantErr bool
+	}{
+		"unique URIs":   {roots: testRootItems("file:///a=a", "file:///b=b")},
+		"duplicate URI": {roots: testRootItems("file:///a=one", "file:///a=two"), wantErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"id":    tftypes.NewValue(tftypes.String, nil),
+					"roots": testRootsSetValue(ctx, t, schemaResp, tc.roots),
+				}),
+			}
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: config}, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Errorf("expected error %t, got %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func testRootsSetValue(ctx context.Context, t *testing.T, schemaResp *fwresource.SchemaResponse, roots []RootItemModel) tftypes.Value {
+	t.Helper()
+	setType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes["roots"].(tftypes.Set)
+	objectType := setType.ElementType.(tftypes.Object)
+
+	elements := make([]tftypes.Value, 0, len(roots))
+	for _, root := range roots {
+		var name interface{}
+		if !root.Name.IsNull() {
+			name = root.Name.ValueString()
+		}
+		elements = append(elements, tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"uri":  tftypes.NewValue(tftypes.String, root.URI.ValueString()),
+			"name": tftypes.NewValue(tftypes.String, name),
+		}))
+	}
+	return tftypes.NewValue(setType, elements)
+}
+
+func TestAccRootsResource(t *testing.T) {
+	api, server := newTestRootsAPI(t, map[string]string{"file:///unmanaged": "other"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRootsResourceConfig(server.URL, `
+    { uri = "file:///a", name = "a" },
+    { uri = "file:///b" },
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_roots.test",
+						tfjsonpath.New("roots"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"uri":  knownvalue.StringExact("file:///a"),
+								"name": knownvalue.StringExact("a"),
+							}),
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"uri":  knownvalue.StringExact("file:///b"),
+								"name": knownvalue.Null(),
+							}),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccRootsResourceConfig(server.URL, `
+    { uri = "file:///a", name = "renamed" },
+    { uri = "file:///c", name = "c" },
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_roots.test",
+						tfjsonpath.New("roots"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"uri":  knownvalue.StringExact("file:///a"),
+								"name": knownvalue.StringExact("renamed"),
+							}),
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"uri":  knownvalue.StringExact("file:///c"),
+								"name": knownvalue.StringExact("c"),
+							}),
+						}),
+					),
+				},
+				Check: func(*terraform.State) error {
+					api.mu.Lock()
+					defer api.mu.Unlock()
+					want := map[string]string{"file:///a": "renamed", "file:///c": "c", "file:///unmanaged": "other"}
+					if !maps.Equal(api.roots, want) {
+						return fmt.Errorf("expected roots %v on the gateway, got %v", want, api.roots)
+					}
+					return nil
+				},
+			},
+			{
+				Config: testAccRootsResourceConfig(server.URL, `
+    { uri = "file:///a", name = "a" },
+    { uri = "file:///a", name = "b" },
+`),
+				ExpectError: regexp.MustCompile(`Duplicate Root URI`),
+			},
+		},
+		CheckDestroy: func(*terraform.State) error {
+			api.mu.Lock()
+			defer api.mu.Unlock()
+			if want := map[string]string{"file:///unmanaged": "other"}; !maps.Equal(api.roots, want) {
+				return fmt.Errorf("expected only the unmanaged root to remain, got %v", api.roots)
+			}
+			return nil
+		},
+	})
+}
+
+func testAccRootsResourceConfig(endpoint, roots string) string {
+	return fmt.Sprintf(`
+provider "contextforge" {
+  endpoint     = %[1]q
+  bearer_token = "test"
+}
+
+resource "contextforge_roots" "test" {
+  roots = [%[2]s  ]
+}
+`, endpoint, roots)
+}
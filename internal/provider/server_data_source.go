@@ -27,15 +27,19 @@ type ServerDataSource struct {
 
 // ServerDataSourceModel describes the data source data model.
 type ServerDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Tags        types.List   `tfsdk:"tags"`
-	ToolIDs     types.List   `tfsdk:"tool_ids"`
-	Visibility  types.String `tfsdk:"visibility"`
-	IsActive    types.Bool   `tfsdk:"is_active"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Tags          types.List   `tfsdk:"tags"`
+	ToolIDs       types.List   `tfsdk:"tool_ids"`
+	ToolCount     types.Int64  `tfsdk:"tool_count"`
+	ResourceCount types.Int64  `tfsdk:"resource_count"`
+	PromptCount   types.Int64  `tfsdk:"prompt_count"`
+	Visibility    types.String `tfsdk:"visibility"`
+	IsActive      types.Bool   `tfsdk:"is_active"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+	CreatedBy     types.String `tfsdk:"created_by"`
 }
 
 func (d *ServerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -68,6 +72,18 @@ func (d *ServerDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"tool_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of tools associated with the server.",
+				Computed:            true,
+			},
+			"resource_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of resources associated with the server.",
+				Computed:            true,
+			},
+			"prompt_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of prompts associated with the server.",
+				Computed:            true,
+			},
 			"visibility": schema.StringAttribute{
 				MarkdownDescription: "Visibility of the server (e.g. `public`, `private`).",
 				Computed:            true,
@@ -84,6 +100,10 @@ func (d *ServerDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				MarkdownDescription: "Timestamp when the server was last updated.",
 				Computed:            true,
 			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "Principal that created the server, if reported by the gateway.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -93,16 +113,16 @@ func (d *ServerDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = apiClient
+	d.client = data.client
 }
 
 func (d *ServerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -115,7 +135,7 @@ func (d *ServerDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	server, err := d.client.GetServer(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read server", err)
 		return
 	}
 	if server == nil {
@@ -131,27 +151,28 @@ func (d *ServerDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	data.CreatedAt = types.StringValue(server.CreatedAt)
 	data.UpdatedAt = types.StringValue(server.UpdatedAt)
 
-	if server.Tags != nil {
-		tags, diags := types.ListValueFrom(ctx, types.StringType, server.Tags)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.Tags = tags
+	if server.CreatedBy != "" {
+		data.CreatedBy = types.StringValue(server.CreatedBy)
 	} else {
-		data.Tags = types.ListNull(types.StringType)
+		data.CreatedBy = types.StringNull()
 	}
+	data.ToolCount = types.Int64Value(int64(len(server.ToolIDs)))
+	data.ResourceCount = types.Int64Value(int64(len(server.ResourceIDs)))
+	data.PromptCount = types.Int64Value(int64(len(server.PromptIDs)))
 
-	if server.ToolIDs != nil {
-		toolIDs, diags := types.ListValueFrom(ctx, types.StringType, server.ToolIDs)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.ToolIDs = toolIDs
-	} else {
-		data.ToolIDs = types.ListNull(types.StringType)
+	tags, diags := stringListValue(ctx, server.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Tags = tags
+
+	toolIDs, diags := stringListValue(ctx, server.ToolIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.ToolIDs = toolIDs
 
 	tflog.Trace(ctx, "read server data source")
 
@@ -27,8 +27,11 @@ func TestAccServerDataSource(t *testing.T) {
 				Name:        "test-server",
 				Description: "A test server",
 				Tags:        []string{"demo"},
+				ToolIDs:     []string{"tool-1", "tool-2"},
+				ResourceIDs: []string{"res-1"},
 				Visibility:  "private",
 				IsActive:    true,
+				CreatedBy:   "admin@example.com",
 			}); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 				return
@@ -57,6 +60,26 @@ func TestAccServerDataSource(t *testing.T) {
 						tfjsonpath.New("visibility"),
 						knownvalue.StringExact("private"),
 					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_server.test",
+						tfjsonpath.New("tool_count"),
+						knownvalue.Int64Exact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_server.test",
+						tfjsonpath.New("resource_count"),
+						knownvalue.Int64Exact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_server.test",
+						tfjsonpath.New("prompt_count"),
+						knownvalue.Int64Exact(0),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_server.test",
+						tfjsonpath.New("created_by"),
+						knownvalue.StringExact("admin@example.com"),
+					),
 				},
 			},
 		},
@@ -7,11 +7,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -22,6 +24,8 @@ import (
 
 var _ resource.Resource = &ServerResource{}
 var _ resource.ResourceWithImportState = &ServerResource{}
+var _ resource.ResourceWithModifyPlan = &ServerResource{}
+var _ resource.ResourceWithValidateConfig = &ServerResource{}
 
 func NewServerResource() resource.Resource {
 	return &ServerResource{}
@@ -29,20 +33,30 @@ func NewServerResource() resource.Resource {
 
 // ServerResource manages a server on the MCP Gateway.
 type ServerResource struct {
-	client *client.Client
+	client   *client.Client
+	settings providerSettings
 }
 
 // ServerResourceModel describes the resource data model.
 type ServerResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Tags        types.List   `tfsdk:"tags"`
-	ToolIDs     types.List   `tfsdk:"tool_ids"`
-	Visibility  types.String `tfsdk:"visibility"`
-	IsActive    types.Bool   `tfsdk:"is_active"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	Description   types.String   `tfsdk:"description"`
+	Tags          types.List     `tfsdk:"tags"`
+	ToolIDs       types.List     `tfsdk:"tool_ids"`
+	ResourceIDs   types.List     `tfsdk:"resource_ids"`
+	PromptIDs     types.List     `tfsdk:"prompt_ids"`
+	Visibility    types.String   `tfsdk:"visibility"`
+	TeamID        types.String   `tfsdk:"team_id"`
+	IsActive      types.Bool     `tfsdk:"is_active"`
+	Status        types.String   `tfsdk:"status"`
+	WaitForActive types.Bool     `tfsdk:"wait_for_active"`
+	Cascade       types.Bool     `tfsdk:"cascade"`
+	DeletionMode  types.String   `tfsdk:"deletion_mode"`
+	CreatedAt     types.String   `tfsdk:"created_at"`
+	UpdatedAt     types.String   `tfsdk:"updated_at"`
+	CreatedBy     types.String   `tfsdk:"created_by"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ServerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -70,7 +84,7 @@ func (r *ServerResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 			},
 			"tags": schema.ListAttribute{
-				MarkdownDescription: "Tags associated with the server.",
+				MarkdownDescription: "Tags associated with the server. Leaving this unset and setting it to `[]` are equivalent. Tags are trimmed and de-duplicated before they are sent, and lowercased when the provider sets `lowercase_tags`.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
@@ -80,13 +94,42 @@ func (r *ServerResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_ids": schema.ListAttribute{
+				MarkdownDescription: "List of resource IDs associated with the server.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prompt_ids": schema.ListAttribute{
+				MarkdownDescription: "List of prompt IDs associated with the server.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"visibility": schema.StringAttribute{
-				MarkdownDescription: "Visibility of the server (e.g. `public`, `private`).",
+				MarkdownDescription: "Visibility of the server (e.g. `public`, `private`). Defaults to the provider's `default_visibility` when that is set.",
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("public", "private", "team"),
+					stringvalidator.OneOf(visibilityValues...),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the team the server is shared with. Only valid when `visibility` is `team`, either set on the server or through the provider's `default_visibility`. Removing it from the configuration removes the server from the team.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
 				},
 			},
 			"is_active": schema.BoolAttribute{
@@ -94,6 +137,19 @@ func (r *ServerResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 				Computed:            true,
 			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status reported by the gateway, e.g. `active` or `degraded`. Null when the gateway does not report one.",
+				Computed:            true,
+			},
+			"cascade": schema.BoolAttribute{
+				MarkdownDescription: "Whether destroying the server also detaches or removes its dependents, such as associated tools. Defaults to `false`, in which case the gateway may refuse to delete a server that still has dependents.",
+				Optional:            true,
+			},
+			"deletion_mode": deletionModeAttribute("server"),
+			"wait_for_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait after creation until the server reports an `active` status. Waiting is bounded by the create timeout.",
+				Optional:            true,
+			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "Timestamp when the server was created.",
 				Computed:            true,
@@ -102,28 +158,117 @@ func (r *ServerResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "Timestamp when the server was last updated.",
 				Computed:            true,
 			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "Principal that created the server, if reported by the gateway.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
+// ValidateConfig rejects a team_id on a server whose visibility is set to
+// something other than "team", since the gateway only scopes team servers to
+// a team. An unset visibility is checked in Create, once the provider's
+// default_visibility is known.
+func (r *ServerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var visibility, teamID types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("visibility"), &visibility)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("team_id"), &teamID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if teamID.IsNull() || visibility.IsNull() || visibility.IsUnknown() {
+		return
+	}
+	checkServerTeamVisibility(visibility.ValueString(), &resp.Diagnostics)
+}
+
+// checkServerTeamVisibility reports an error when a team_id is combined with a
+// visibility other than "team".
+func checkServerTeamVisibility(visibility string, diagnostics *diag.Diagnostics) {
+	if visibility == "team" {
+		return
+	}
+	diagnostics.AddAttributeError(
+		path.Root("team_id"),
+		"Invalid Attribute Combination",
+		fmt.Sprintf("team_id can only be set when visibility is \"team\", got visibility %q.", visibility),
+	)
+}
+
 func (r *ServerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = apiClient
+	r.client = data.client
+	r.settings = data.settings
+}
+
+// serverTeamIDKey is the private state key recording that team_id was set in
+// the configuration. team_id is also computed, since the gateway may assign a
+// team itself, so only a team the configuration set is cleared when team_id
+// is removed from it.
+const serverTeamIDKey = "team_id_configured"
+
+// serverTeamIDConfigured returns the private state value for serverTeamIDKey
+// given the configured team_id; nil removes the key.
+func serverTeamIDConfigured(teamID types.String) []byte {
+	if teamID.IsNull() {
+		return nil
+	}
+	return []byte("true")
+}
+
+// ModifyPlan warns when the configured tags will be normalized before they
+// are sent to the gateway, and plans team_id as unknown when a configured
+// team is removed, so that Update clears it and reads back the team the
+// gateway reports instead.
+func (r *ServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnTagNormalization(ctx, req.Config, r.settings.lowercaseTags, &resp.Diagnostics)
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configTeamID, stateTeamID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("team_id"), &configTeamID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("team_id"), &stateTeamID)...)
+	configured, diags := req.Private.GetKey(ctx, serverTeamIDKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if configTeamID.IsNull() && !stateTeamID.IsNull() && configured != nil {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("team_id"), types.StringUnknown())...)
+	}
 }
 
 func (r *ServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if readOnlyBlocked(r.settings, "create server", &resp.Diagnostics) {
+		return
+	}
+
 	var data ServerResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -131,34 +276,103 @@ func (r *ServerResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	var tags []string
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	createTimeout, diags := data.Timeouts.Create(ctx, r.settings.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tags := tagsFromModel(ctx, data.Tags, r.settings.lowercaseTags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toolIDs []string
+	if !data.ToolIDs.IsNull() && !data.ToolIDs.IsUnknown() {
+		resp.Diagnostics.Append(data.ToolIDs.ElementsAs(ctx, &toolIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var resourceIDs []string
+	if !data.ResourceIDs.IsNull() && !data.ResourceIDs.IsUnknown() {
+		resp.Diagnostics.Append(data.ResourceIDs.ElementsAs(ctx, &resourceIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var promptIDs []string
+	if !data.PromptIDs.IsNull() && !data.PromptIDs.IsUnknown() {
+		resp.Diagnostics.Append(data.PromptIDs.ElementsAs(ctx, &promptIDs, false)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
 
+	var isActive *bool
+	if !data.IsActive.IsNull() && !data.IsActive.IsUnknown() {
+		v := data.IsActive.ValueBool()
+		isActive = &v
+	}
+
+	if !data.TeamID.IsNull() && !data.TeamID.IsUnknown() {
+		visibility := data.Visibility.ValueString()
+		if visibility == "" {
+			visibility = r.client.DefaultVisibility
+		}
+		if visibility != "" {
+			checkServerTeamVisibility(visibility, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
 	createReq := client.CreateServerRequest{
 		Server: client.ServerConfig{
 			Name:        data.Name.ValueString(),
 			Description: data.Description.ValueString(),
 			Tags:        tags,
+			ToolIDs:     toolIDs,
+			ResourceIDs: resourceIDs,
+			PromptIDs:   promptIDs,
+			IsActive:    isActive,
 		},
 		Visibility: data.Visibility.ValueString(),
+		TeamID:     data.TeamID.ValueString(),
 	}
 
 	server, err := r.client.CreateServer(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create server, got error: %s", err))
+		addCreateError(&resp.Diagnostics, "create server", fmt.Sprintf("a server named %q", data.Name.ValueString()), err)
 		return
 	}
 
+	if data.WaitForActive.ValueBool() {
+		server, err = r.client.WaitForServerActive(ctx, server.ID)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "wait for server to become active", err)
+			return
+		}
+	}
+
 	r.serverToModel(ctx, server, &data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	var configTeamID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("team_id"), &configTeamID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, serverTeamIDKey, serverTeamIDConfigured(configTeamID))...)
+
 	tflog.Trace(ctx, "created a server resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -172,9 +386,18 @@ func (r *ServerResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, r.settings.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	server, err := r.client.GetServer(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read server", err)
 		return
 	}
 	if server == nil {
@@ -191,6 +414,10 @@ func (r *ServerResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *ServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if readOnlyBlocked(r.settings, "update server", &resp.Diagnostics) {
+		return
+	}
+
 	var data ServerResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -198,32 +425,35 @@ func (r *ServerResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	var tags []string
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.settings.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	var toolIDs []string
-	if !data.ToolIDs.IsNull() && !data.ToolIDs.IsUnknown() {
-		resp.Diagnostics.Append(data.ToolIDs.ElementsAs(ctx, &toolIDs, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	updateReq := serverUpdateFromModel(ctx, data, r.settings.lowercaseTags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	updateReq := client.ServerUpdate{
-		Name:        data.Name.ValueString(),
-		Description: data.Description.ValueString(),
-		Tags:        tags,
-		ToolIDs:     toolIDs,
+	var configTeamID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("team_id"), &configTeamID)...)
+	configured, diags := req.Private.GetKey(ctx, serverTeamIDKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if configTeamID.IsNull() && configured != nil {
+		// The configured team was removed; see ModifyPlan.
+		updateReq.TeamID = new(string)
 	}
 
 	server, err := r.client.UpdateServer(ctx, data.ID.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update server, got error: %s", err))
+		addClientError(&resp.Diagnostics, "update server", err)
 		return
 	}
 
@@ -232,12 +462,64 @@ func (r *ServerResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, serverTeamIDKey, serverTeamIDConfigured(configTeamID))...)
+
 	tflog.Trace(ctx, "updated a server resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// serverUpdateFromModel builds the update request for the server described by
+// data. The association IDs, team_id and is_active are only sent when they are
+// known, so an unset value leaves the server's state unchanged.
+func serverUpdateFromModel(ctx context.Context, data ServerResourceModel, lowercase bool, diagnostics *diag.Diagnostics) client.ServerUpdate {
+	tags := tagsFromModel(ctx, data.Tags, lowercase, diagnostics)
+
+	toolIDs := knownIDs(ctx, data.ToolIDs, diagnostics)
+	resourceIDs := knownIDs(ctx, data.ResourceIDs, diagnostics)
+	promptIDs := knownIDs(ctx, data.PromptIDs, diagnostics)
+
+	var isActive *bool
+	if !data.IsActive.IsNull() && !data.IsActive.IsUnknown() {
+		v := data.IsActive.ValueBool()
+		isActive = &v
+	}
+
+	var teamID *string
+	if !data.TeamID.IsNull() && !data.TeamID.IsUnknown() {
+		v := data.TeamID.ValueString()
+		teamID = &v
+	}
+
+	return client.ServerUpdate{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Tags:        tags,
+		ToolIDs:     toolIDs,
+		ResourceIDs: resourceIDs,
+		PromptIDs:   promptIDs,
+		TeamID:      teamID,
+		IsActive:    isActive,
+	}
+}
+
+// knownIDs returns the IDs in list, or nil if list is null or unknown. A known
+// empty list returns a pointer to an empty slice, so it is sent to clear the
+// associations.
+func knownIDs(ctx context.Context, list types.List, diagnostics *diag.Diagnostics) *[]string {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+	ids := []string{}
+	diagnostics.Append(list.ElementsAs(ctx, &ids, false)...)
+	return &ids
+}
+
 func (r *ServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if readOnlyBlocked(r.settings, "delete server", &resp.Diagnostics) {
+		return
+	}
+
 	var data ServerResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -245,15 +527,67 @@ func (r *ServerResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteServer(ctx, data.ID.ValueString())
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.settings.defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if deactivateOnDelete(r.settings, data.DeletionMode) {
+		deactivateReq := serverUpdateFromModel(ctx, data, r.settings.lowercaseTags, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		deactivateReq.IsActive = new(bool)
+
+		if _, err := r.client.UpdateServer(ctx, data.ID.ValueString(), deactivateReq); err != nil {
+			addDeactivateError(&resp.Diagnostics, "deactivate server", err)
+		}
+		return
+	}
+
+	err := r.client.DeleteServer(ctx, data.ID.ValueString(), data.Cascade.ValueBool())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete server, got error: %s", err))
+		addClientError(&resp.Diagnostics, "delete server", err)
 		return
 	}
 }
 
 func (r *ServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, err := r.client.GetServer(ctx, req.ID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read server", err)
+		return
+	}
+	if server == nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Server with ID %s not found", req.ID))
+		return
+	}
+	if server.ToolIDs != nil {
+		return
+	}
+
+	// Some gateway versions leave the tool associations out of GET
+	// /servers/{id}. Fetch them separately so the imported state does not
+	// drift; Read keeps them as long as the GET response omits them.
+	tools, err := r.client.ListServerTools(ctx, req.ID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list server tools", err)
+		return
+	}
+	toolIDs := make([]string, len(tools))
+	for i, tool := range tools {
+		toolIDs[i] = tool.ID
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tool_ids"), toolIDs)...)
 }
 
 // serverToModel maps a client.Server to the Terraform resource model.
@@ -262,20 +596,29 @@ func (r *ServerResource) serverToModel(ctx context.Context, server *client.Serve
 	data.Name = types.StringValue(server.Name)
 	data.Description = types.StringValue(server.Description)
 	data.Visibility = types.StringValue(server.Visibility)
+	data.TeamID = stringOrPrior(server.TeamID, data.TeamID)
 	data.IsActive = types.BoolValue(server.IsActive)
 	data.CreatedAt = types.StringValue(server.CreatedAt)
 	data.UpdatedAt = types.StringValue(server.UpdatedAt)
 
-	if server.Tags != nil {
-		tagsList, diags := types.ListValueFrom(ctx, types.StringType, server.Tags)
-		diagnostics.Append(diags...)
-		if diagnostics.HasError() {
-			return
-		}
-		data.Tags = tagsList
+	if server.CreatedBy != "" {
+		data.CreatedBy = types.StringValue(server.CreatedBy)
+	} else {
+		data.CreatedBy = types.StringNull()
+	}
+
+	if server.Status != "" {
+		data.Status = types.StringValue(server.Status)
 	} else {
-		data.Tags = types.ListNull(types.StringType)
+		data.Status = types.StringNull()
+	}
+
+	tagsList, diags := normalizedTagsToModel(ctx, server.Tags, data.Tags, r.settings.lowercaseTags)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
 	}
+	data.Tags = tagsList
 
 	if server.ToolIDs != nil {
 		toolIDsList, diags := types.ListValueFrom(ctx, types.StringType, server.ToolIDs)
@@ -284,7 +627,29 @@ func (r *ServerResource) serverToModel(ctx context.Context, server *client.Serve
 			return
 		}
 		data.ToolIDs = toolIDsList
-	} else {
+	} else if data.ToolIDs.IsUnknown() {
 		data.ToolIDs = types.ListNull(types.StringType)
 	}
+
+	if server.ResourceIDs != nil {
+		resourceIDsList, diags := types.ListValueFrom(ctx, types.StringType, server.ResourceIDs)
+		diagnostics.Append(diags...)
+		if diagnostics.HasError() {
+			return
+		}
+		data.ResourceIDs = resourceIDsList
+	} else if data.ResourceIDs.IsUnknown() {
+		data.ResourceIDs = types.ListNull(types.StringType)
+	}
+
+	if server.PromptIDs != nil {
+		promptIDsList, diags := types.ListValueFrom(ctx, types.StringType, server.PromptIDs)
+		diagnostics.Append(diags...)
+		if diagnostics.HasError() {
+			return
+		}
+		data.PromptIDs = promptIDsList
+	} else if data.PromptIDs.IsUnknown() {
+		data.PromptIDs = types.ListNull(types.StringType)
+	}
 }
@@ -4,16 +4,31 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/nkbud/terraform-provider-contextforge/internal/client"
 )
@@ -34,6 +49,9 @@ func TestAccServerResource(t *testing.T) {
 				Name:        req.Server.Name,
 				Description: req.Server.Description,
 				Tags:        req.Server.Tags,
+				ToolIDs:     req.Server.ToolIDs,
+				ResourceIDs: req.Server.ResourceIDs,
+				PromptIDs:   req.Server.PromptIDs,
 				Visibility:  req.Visibility,
 				IsActive:    true,
 			}); err != nil {
@@ -47,6 +65,9 @@ func TestAccServerResource(t *testing.T) {
 				Name:        "my-server",
 				Description: "A managed server",
 				Tags:        []string{"managed"},
+				ToolIDs:     []string{"tool-1"},
+				ResourceIDs: []string{"res-1"},
+				PromptIDs:   []string{"prompt-1"},
 				Visibility:  "private",
 				IsActive:    true,
 			}); err != nil {
@@ -84,6 +105,779 @@ func TestAccServerResource(t *testing.T) {
 						tfjsonpath.New("visibility"),
 						knownvalue.StringExact("private"),
 					),
+					statecheck.ExpectKnownValue(
+						"contextforge_server.test",
+						tfjsonpath.New("tool_ids"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("tool-1")}),
+					),
+					statecheck.ExpectKnownValue(
+						"contextforge_server.test",
+						tfjsonpath.New("resource_ids"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("res-1")}),
+					),
+					statecheck.ExpectKnownValue(
+						"contextforge_server.test",
+						tfjsonpath.New("prompt_ids"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("prompt-1")}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccServerResource_Inactive(t *testing.T) {
+	var created client.Server
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/servers" && r.Method == http.MethodPost:
+			var req client.CreateServerRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if req.Server.IsActive == nil || *req.Server.IsActive {
+				http.Error(w, "expected is_active=false in create request", http.StatusBadRequest)
+				return
+			}
+			created = client.Server{
+				ID:       "srv-inactive",
+				Name:     req.Server.Name,
+				IsActive: *req.Server.IsActive,
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			if err := json.NewEncoder(w).Encode(created); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/servers/srv-inactive" && r.Method == http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(created); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/servers/srv-inactive" && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+resource "contextforge_server" "test" {
+  name      = "inactive-server"
+  is_active = false
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_server.test",
+						tfjsonpath.New("is_active"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccServerResource_WaitForActive(t *testing.T) {
+	gets := 0
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/servers" && r.Method == http.MethodPost:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			if err := json.NewEncoder(w).Encode(client.Server{
+				ID:     "srv-waiting",
+				Name:   "waiting-server",
+				Status: "initializing",
+			}); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/servers/srv-waiting" && r.Method == http.MethodGet:
+			gets++
+			status := "initializing"
+			if gets > 1 {
+				status = client.ServerStatusActive
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(client.Server{
+				ID:       "srv-waiting",
+				Name:     "waiting-server",
+				IsActive: status == client.ServerStatusActive,
+				Status:   status,
+			}); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/servers/srv-waiting" && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+resource "contextforge_server" "test" {
+  name            = "waiting-server"
+  wait_for_active = true
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_server.test",
+						tfjsonpath.New("is_active"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"contextforge_server.test",
+						tfjsonpath.New("status"),
+						knownvalue.StringExact(client.ServerStatusActive),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccServerResource_Status(t *testing.T) {
+	server := client.Server{
+		ID:       "srv-degraded",
+		Name:     "degraded-server",
+		IsActive: true,
+		Status:   "degraded",
+	}
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/servers" && r.Method == http.MethodPost:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			if err := json.NewEncoder(w).Encode(server); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/servers/srv-degraded" && r.Method == http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(server); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/servers/srv-degraded" && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+resource "contextforge_server" "test" {
+  name = "degraded-server"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_server.test",
+						tfjsonpath.New("status"),
+						knownvalue.StringExact("degraded"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccServerResource_CreateNoContent(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/servers" && r.Method == http.MethodPost:
+			w.Header().Set("Location", "/servers/srv-204")
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/servers/srv-204" && r.Method == http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(client.Server{
+				ID:        "srv-204",
+				Name:      "no-content-server",
+				IsActive:  true,
+				CreatedAt: "2025-01-01T00:00:00Z",
+				UpdatedAt: "2025-01-01T00:00:00Z",
+			}); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/servers/srv-204" && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+resource "contextforge_server" "test" {
+  name = "no-content-server"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_server.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("srv-204"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccServerResource_CreateTimeout(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		select {
+		case <-r.Context().Done():
+		case <-time.After(10 * time.Second):
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+resource "contextforge_server" "test" {
+  name = "slow-server"
+
+  timeouts {
+    create = "1s"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`context deadline exceeded`),
+			},
+		},
+	})
+}
+
+func TestAccServerResource_ProviderOperationTimeout(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		select {
+		case <-r.Context().Done():
+		case <-time.After(10 * time.Second):
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint          = "` + mockServer.URL + `"
+  bearer_token      = "test"
+  operation_timeout = 1
+}
+
+resource "contextforge_server" "test" {
+  name = "slow-server"
+}
+`,
+				ExpectError: regexp.MustCompile(`context deadline exceeded`),
+			},
+		},
+	})
+}
+
+func TestAccServerResource_Cascade(t *testing.T) {
+	forced := false
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/servers" && r.Method == http.MethodPost:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			if err := json.NewEncoder(w).Encode(client.Server{ID: "srv-cascade", Name: "cascade-server", IsActive: true}); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/servers/srv-cascade" && r.Method == http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(client.Server{ID: "srv-cascade", Name: "cascade-server", IsActive: true}); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/servers/srv-cascade" && r.Method == http.MethodDelete:
+			forced = r.URL.Query().Get("force") == "true"
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+resource "contextforge_server" "test" {
+  name    = "cascade-server"
+  cascade = true
+}
+`,
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if !forced {
+				return fmt.Errorf("expected delete request with force=true")
+			}
+			return nil
+		},
+	})
+}
+
+func TestAccServerResource_DefaultVisibility(t *testing.T) {
+	var mu sync.Mutex
+	servers := map[string]client.Server{}
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Both servers are created in parallel.
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.URL.Path == "/servers" && r.Method == http.MethodPost:
+			var req client.CreateServerRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			created := client.Server{ID: "srv-" + req.Server.Name, Name: req.Server.Name, Visibility: req.Visibility, IsActive: true}
+			servers[created.ID] = created
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			if err := json.NewEncoder(w).Encode(created); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.Method == http.MethodGet:
+			server, ok := servers[strings.TrimPrefix(r.URL.Path, "/servers/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(server); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint           = "` + mockServer.URL + `"
+  bearer_token       = "test"
+  default_visibility = "private"
+}
+
+resource "contextforge_server" "defaulted" {
+  name = "defaulted"
+}
+
+resource "contextforge_server" "overridden" {
+  name       = "overridden"
+  visibility = "public"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_server.defaulted",
+						tfjsonpath.New("visibility"),
+						knownvalue.StringExact("private"),
+					),
+					statecheck.ExpectKnownValue(
+						"contextforge_server.overridden",
+						tfjsonpath.New("visibility"),
+						knownvalue.StringExact("public"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccServerResource_ImportToolAssociations(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var payload interface{}
+		switch {
+		case r.URL.Path == "/servers/srv-existing" && r.Method == http.MethodGet:
+			// Associations are not part of the server representation.
+			payload = client.Server{
+				ID:          "srv-existing",
+				Name:        "existing-server",
+				Description: "Created outside Terraform",
+				Visibility:  "public",
+				IsActive:    true,
+			}
+		case r.URL.Path == "/servers/srv-existing/tools" && r.Method == http.MethodGet:
+			payload = []client.Tool{{ID: "tool-1", Name: "search"}, {ID: "tool-2", Name: "fetch"}}
+		case r.URL.Path == "/servers/srv-existing" && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+			return
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := json.NewEncoder(w).Encode(payload); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer mockServer.Close()
+
+	config := `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+resource "contextforge_server" "test" {
+  name        = "existing-server"
+  description = "Created outside Terraform"
+  visibility  = "public"
+  tool_ids    = ["tool-1", "tool-2"]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:             config,
+				ResourceName:       "contextforge_server.test",
+				ImportState:        true,
+				ImportStateId:      "srv-existing",
+				ImportStatePersist: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported server, got %d", len(states))
+					}
+					attrs := states[0].Attributes
+					if attrs["tool_ids.#"] != "2" || attrs["tool_ids.0"] != "tool-1" || attrs["tool_ids.1"] != "tool-2" {
+						return fmt.Errorf("expected tool_ids [tool-1 tool-2], got %v", attrs)
+					}
+					return nil
+				},
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestServerResourceValidateConfig_TeamID(t *testing.T) {
+	ctx := context.Background()
+	r := &ServerResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	cases := map[string]struct {
+		visibility types.String
+		teamID     types.String
+		wantError  bool
+	}{
+		"team with team_id":         {visibility: types.StringValue("team"), teamID: types.StringValue("team-platform")},
+		"team without team_id":      {visibility: types.StringValue("team"), teamID: types.StringNull()},
+		"unset visibility":          {visibility: types.StringNull(), teamID: types.StringValue("team-platform")},
+		"unknown visibility":        {visibility: types.StringUnknown(), teamID: types.StringValue("team-platform")},
+		"public without team_id":    {visibility: types.StringValue("public"), teamID: types.StringNull()},
+		"public with team_id":       {visibility: types.StringValue("public"), teamID: types.StringValue("team-platform"), wantError: true},
+		"private with team_id":      {visibility: types.StringValue("private"), teamID: types.StringValue("team-platform"), wantError: true},
+		"private with unknown team": {visibility: types.StringValue("private"), teamID: types.StringUnknown(), wantError: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			plan := tfsdk.Plan{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			diags := plan.SetAttribute(ctx, path.Root("visibility"), tc.visibility)
+			diags.Append(plan.SetAttribute(ctx, path.Root("team_id"), tc.teamID)...)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics building config: %v", diags)
+			}
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw},
+			}, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tc.wantError {
+				t.Errorf("expected error=%t, got diagnostics: %v", tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestServerUpdateFromModel_AssociationIDs(t *testing.T) {
+	ctx := context.Background()
+	data := ServerResourceModel{
+		Name:        types.StringValue("srv"),
+		Tags:        types.ListNull(types.StringType),
+		ToolIDs:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("tool-1")}),
+		ResourceIDs: types.ListNull(types.StringType),
+		PromptIDs:   types.ListUnknown(types.StringType),
+	}
+
+	var diags diag.Diagnostics
+	req := serverUpdateFromModel(ctx, data, false, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if req.ToolIDs == nil || len(*req.ToolIDs) != 1 || (*req.ToolIDs)[0] != "tool-1" {
+		t.Errorf("expected tool_ids [tool-1], got %v", req.ToolIDs)
+	}
+	if req.ResourceIDs != nil || req.PromptIDs != nil {
+		t.Errorf("expected unset resource_ids and prompt_ids to be left out, got %v and %v", req.ResourceIDs, req.PromptIDs)
+	}
+}
+
+func TestServerToModel_KeepsOmittedAssociationIDs(t *testing.T) {
+	ctx := context.Background()
+	prior := func(id string) types.List {
+		return types.ListValueMust(types.StringType, []attr.Value{types.StringValue(id)})
+	}
+	data := ServerResourceModel{
+		Tags:        types.ListNull(types.StringType),
+		TeamID:      types.StringNull(),
+		ToolIDs:     prior("tool-1"),
+		ResourceIDs: prior("res-1"),
+		PromptIDs:   prior("prompt-1"),
+	}
+
+	var diags diag.Diagnostics
+	r := &ServerResource{}
+	r.serverToModel(ctx, &client.Server{ID: "srv-1", Name: "srv"}, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !data.ToolIDs.Equal(prior("tool-1")) || !data.ResourceIDs.Equal(prior("res-1")) || !data.PromptIDs.Equal(prior("prompt-1")) {
+		t.Errorf("expected the prior association IDs to be kept, got %s %s %s", data.ToolIDs, data.ResourceIDs, data.PromptIDs)
+	}
+}
+
+func TestAccServerResource_TeamID(t *testing.T) {
+	var mu sync.Mutex
+	var server client.Server
+	var created bool
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.URL.Path == "/servers" && r.Method == http.MethodPost:
+			var req client.CreateServerRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			server = client.Server{ID: "srv-team", Name: req.Server.Name, Visibility: req.Visibility, TeamID: stringPointer(req.TeamID), IsActive: true}
+			created = true
+			testAccMockWrite(w, http.StatusCreated, server)
+		case r.URL.Path == "/servers/srv-team" && r.Method == http.MethodPut:
+			var req client.ServerUpdate
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			server.Name = req.Name
+			if req.TeamID != nil {
+				server.TeamID = req.TeamID
+			}
+			testAccMockWrite(w, http.StatusOK, server)
+		case r.URL.Path == "/servers/srv-team" && r.Method == http.MethodGet && created:
+			testAccMockWrite(w, http.StatusOK, server)
+		case r.URL.Path == "/servers/srv-team" && r.Method == http.MethodDelete:
+			created = false
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockServer.Close()
+
+	config := func(visibility, teamID string) string {
+		teamIDLine := ""
+		if teamID != "" {
+			teamIDLine = fmt.Sprintf("team_id    = %q", teamID)
+		}
+		return fmt.Sprintf(`
+provider "contextforge" {
+  endpoint     = %q
+  bearer_token = "test"
+}
+
+resource "contextforge_server" "test" {
+  name       = "team-server"
+  visibility = %q
+  %s
+}
+`, mockServer.URL, visibility, teamIDLine)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("public", "team-platform"),
+				ExpectError: regexp.MustCompile(`team_id can only be set when visibility is "team"`),
+			},
+			{
+				Config: config("team", "team-platform"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_server.test",
+						tfjsonpath.New("team_id"),
+						knownvalue.StringExact("team-platform"),
+					),
+					statecheck.ExpectKnownValue(
+						"contextforge_server.test",
+						tfjsonpath.New("visibility"),
+						knownvalue.StringExact("team"),
+					),
+				},
+			},
+			{
+				Config: config("team", "team-data"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("contextforge_server.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_server.test",
+						tfjsonpath.New("team_id"),
+						knownvalue.StringExact("team-data"),
+					),
+				},
+			},
+			{
+				ResourceName:      "contextforge_server.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"cascade",
+					"wait_for_active",
+				},
+			},
+			{
+				// Removing team_id from the configuration clears the team.
+				Config: config("team", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("contextforge_server.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_server.test",
+						tfjsonpath.New("team_id"),
+						knownvalue.Null(),
+					),
 				},
 			},
 		},
@@ -98,10 +892,13 @@ provider "contextforge" {
 }
 
 resource "contextforge_server" "test" {
-  name        = "my-server"
-  description = "A managed server"
-  tags        = ["managed"]
-  visibility  = "private"
+  name         = "my-server"
+  description  = "A managed server"
+  tags         = ["managed"]
+  tool_ids     = ["tool-1"]
+  resource_ids = ["res-1"]
+  prompt_ids   = ["prompt-1"]
+  visibility   = "private"
 }
 `
 }
@@ -6,9 +6,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nkbud/terraform-provider-contextforge/internal/client"
@@ -28,6 +33,9 @@ type ServersDataSource struct {
 // ServersDataSourceModel describes the data source data model.
 type ServersDataSourceModel struct {
 	IncludeInactive types.Bool        `tfsdk:"include_inactive"`
+	OnlyInactive    types.Bool        `tfsdk:"only_inactive"`
+	Active          types.Bool        `tfsdk:"active"`
+	IncludeCounts   types.Bool        `tfsdk:"include_counts"`
 	Servers         []ServerItemModel `tfsdk:"servers"`
 	ID              types.String      `tfsdk:"id"`
 }
@@ -43,6 +51,10 @@ type ServerItemModel struct {
 	IsActive    types.Bool   `tfsdk:"is_active"`
 	CreatedAt   types.String `tfsdk:"created_at"`
 	UpdatedAt   types.String `tfsdk:"updated_at"`
+
+	ToolCount     types.Int64 `tfsdk:"tool_count"`
+	ResourceCount types.Int64 `tfsdk:"resource_count"`
+	PromptCount   types.Int64 `tfsdk:"prompt_count"`
 }
 
 func (d *ServersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -57,6 +69,23 @@ func (d *ServersDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "Whether to include inactive servers in the list. Defaults to `false`.",
 				Optional:            true,
 			},
+			"only_inactive": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, only inactive servers are returned. Shorthand for `active = false`.",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("active")),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "When set, only servers whose `is_active` matches this value are returned. Setting it to `false` implies `include_inactive`.",
+				Optional:            true,
+			},
+			"include_counts": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, the number of tools, resources, and prompts associated with each server is set in `tool_count`, `resource_count`, and `prompt_count`. " +
+					"Counts are taken from the association IDs in the server list; for servers whose list entry leaves them out, they are fetched with up to three extra requests per server, sent a few servers at a time. " +
+					"Defaults to `false`, which leaves the counts null.",
+				Optional: true,
+			},
 			"servers": schema.ListNestedAttribute{
 				MarkdownDescription: "List of servers.",
 				Computed:            true,
@@ -100,6 +129,18 @@ func (d *ServersDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 							MarkdownDescription: "Timestamp when the server was last updated.",
 							Computed:            true,
 						},
+						"tool_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of tools associated with the server. Null unless `include_counts` is `true`, or if the gateway lists neither the IDs nor the associations of the server.",
+							Computed:            true,
+						},
+						"resource_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of resources associated with the server. Null unless `include_counts` is `true`, or if the gateway lists neither the IDs nor the associations of the server.",
+							Computed:            true,
+						},
+						"prompt_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of prompts associated with the server. Null unless `include_counts` is `true`, or if the gateway lists neither the IDs nor the associations of the server.",
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -116,16 +157,16 @@ func (d *ServersDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = apiClient
+	d.client = data.client
 }
 
 func (d *ServersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -136,59 +177,175 @@ func (d *ServersDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	includeInactive := false
-	if !data.IncludeInactive.IsNull() && !data.IncludeInactive.IsUnknown() {
-		includeInactive = data.IncludeInactive.ValueBool()
-	}
+	filter := newListFilter(data.IncludeInactive, data.OnlyInactive, data.Active)
 
-	servers, err := d.client.ListServers(ctx, includeInactive)
+	servers, err := d.client.ListServers(ctx, filter.includeInactive)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list servers, got error: %s", err))
+		addClientError(&resp.Diagnostics, "list servers", err)
 		return
 	}
+	servers = filterByActive(servers, filter, func(s client.Server) bool { return s.IsActive })
 
-	data.Servers = make([]ServerItemModel, len(servers))
-	for i, s := range servers {
-		var tags types.List
-		if s.Tags != nil {
-			t, diags := types.ListValueFrom(ctx, types.StringType, s.Tags)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			tags = t
-		} else {
-			tags = types.ListNull(types.StringType)
+	if data.IncludeCounts.ValueBool() {
+		if err := fillServerAssociations(ctx, d.client, servers); err != nil {
+			addClientError(&resp.Diagnostics, "list server associations", err)
+			return
 		}
+	}
 
-		var toolIDs types.List
-		if s.ToolIDs != nil {
-			t, diags := types.ListValueFrom(ctx, types.StringType, s.ToolIDs)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			toolIDs = t
-		} else {
-			toolIDs = types.ListNull(types.StringType)
+	data.Servers = serverItemsFromAPI(ctx, servers, data.IncludeCounts.ValueBool(), &resp.Diagnostics)
+
+	data.ID = types.StringValue("servers")
+
+	tflog.Trace(ctx, "read servers data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// serverItemsFromAPI maps the listed servers to item models. Elements that fail
+// to map are omitted with a warning so the remaining results are still returned.
+func serverItemsFromAPI(ctx context.Context, servers []client.Server, includeCounts bool, diagnostics *diag.Diagnostics) []ServerItemModel {
+	items := make([]ServerItemModel, 0, len(servers))
+	for _, s := range servers {
+		item, diags := serverItemFromAPI(ctx, s, includeCounts)
+		if diags.HasError() {
+			addSkippedItemWarning(diagnostics, "server", s.ID, diags)
+			continue
 		}
+		items = append(items, item)
+	}
+	return items
+}
 
-		data.Servers[i] = ServerItemModel{
-			ID:          types.StringValue(s.ID),
-			Name:        types.StringValue(s.Name),
-			Description: types.StringValue(s.Description),
-			Tags:        tags,
-			ToolIDs:     toolIDs,
-			Visibility:  types.StringValue(s.Visibility),
-			IsActive:    types.BoolValue(s.IsActive),
-			CreatedAt:   types.StringValue(s.CreatedAt),
-			UpdatedAt:   types.StringValue(s.UpdatedAt),
+// serverItemFromAPI maps a single server to its item model. With includeCounts
+// the association counts are taken from the IDs the server lists, and a count
+// whose IDs are missing is null, as are all counts without includeCounts.
+func serverItemFromAPI(ctx context.Context, s client.Server, includeCounts bool) (ServerItemModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tags, listDiags := stringListValue(ctx, s.Tags)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return ServerItemModel{}, diags
+	}
+
+	toolIDs, listDiags := stringListValue(ctx, s.ToolIDs)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return ServerItemModel{}, diags
+	}
+
+	item := ServerItemModel{
+		ID:          types.StringValue(s.ID),
+		Name:        types.StringValue(s.Name),
+		Description: types.StringValue(s.Description),
+		Tags:        tags,
+		ToolIDs:     toolIDs,
+		Visibility:  types.StringValue(s.Visibility),
+		IsActive:    types.BoolValue(s.IsActive),
+		CreatedAt:   types.StringValue(s.CreatedAt),
+		UpdatedAt:   types.StringValue(s.UpdatedAt),
+
+		ToolCount:     types.Int64Null(),
+		ResourceCount: types.Int64Null(),
+		PromptCount:   types.Int64Null(),
+	}
+	if includeCounts {
+		item.ToolCount = associationCount(s.ToolIDs)
+		item.ResourceCount = associationCount(s.ResourceIDs)
+		item.PromptCount = associationCount(s.PromptIDs)
+	}
+	return item, diags
+}
+
+// associationCount returns the number of ids, or null if the gateway left the
+// IDs out, which a nil slice records while an empty list decodes as non-nil.
+func associationCount(ids []string) types.Int64 {
+	if ids == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(len(ids)))
+}
+
+// serverAssociationsConcurrency is the number of servers whose associations
+// fillServerAssociations fetches at once, bounding the load on the gateway.
+const serverAssociationsConcurrency = 8
+
+// fillServerAssociations sets the tool, resource and prompt IDs that the
+// server list left out, fetching them for the affected servers
+// serverAssociationsConcurrency servers at a time. Servers whose list entry
+// has every ID list are not requested. If any fetch fails, the error of the
+// first failing server in list order is returned.
+func fillServerAssociations(ctx context.Context, c *client.Client, servers []client.Server) error {
+	var missing []int
+	for i, s := range servers {
+		if s.ToolIDs == nil || s.ResourceIDs == nil || s.PromptIDs == nil {
+			missing = append(missing, i)
 		}
 	}
 
-	data.ID = types.StringValue("servers")
+	errs := make([]error, len(servers))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(serverAssociationsConcurrency, len(missing)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				errs[i] = fillAssociations(ctx, c, &servers[i])
+			}
+		}()
+	}
+	for _, i := range missing {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
 
-	tflog.Trace(ctx, "read servers data source")
+	for i, s := range servers {
+		if errs[i] != nil {
+			return fmt.Errorf("server %s: %w", s.ID, errs[i])
+		}
+	}
+	return nil
+}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+// fillAssociations fetches whichever of the tool, resource and prompt IDs s
+// is missing. IDs stay nil if the server no longer exists.
+func fillAssociations(ctx context.Context, c *client.Client, s *client.Server) error {
+	if s.ToolIDs == nil {
+		tools, err := c.ListServerTools(ctx, s.ID)
+		if err != nil {
+			return err
+		}
+		s.ToolIDs = associationIDs(tools, func(t client.Tool) string { return t.ID })
+	}
+	if s.ResourceIDs == nil {
+		resources, err := c.ListServerResources(ctx, s.ID)
+		if err != nil {
+			return err
+		}
+		s.ResourceIDs = associationIDs(resources, func(r client.Resource) string { return r.ID })
+	}
+	if s.PromptIDs == nil {
+		prompts, err := c.ListServerPrompts(ctx, s.ID)
+		if err != nil {
+			return err
+		}
+		s.PromptIDs = associationIDs(prompts, func(p client.Prompt) string { return p.ID })
+	}
+	return nil
+}
+
+// associationIDs returns the IDs of objects, or nil if objects is nil because
+// the server was not found.
+func associationIDs[T any](objects []T, id func(T) string) []string {
+	if objects == nil {
+		return nil
+	}
+	ids := make([]string, 0, len(objects))
+	for _, o := range objects {
+		ids = append(ids, id(o))
+	}
+	return ids
 }
@@ -4,13 +4,23 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -76,3 +86,179 @@ provider "contextforge" {
 data "contextforge_servers" "test" {}
 `
 }
+
+// newTestServerCountsAPI serves n servers, where server i has i%3 tools, i%2
+// resources and one prompt. The server list includes their IDs only with
+// listIDs; the association endpoints always serve them. It counts the
+// requests it receives other than the server list.
+func newTestServerCountsAPI(t *testing.T, n int, listIDs bool) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+	ids := func(kind string, i, count int) []string {
+		ids := make([]string, count)
+		for j := range ids {
+			ids[j] = fmt.Sprintf("%s-%d-%d", kind, i, j)
+		}
+		return ids
+	}
+	counts := map[string]func(int) int{
+		"tools":     func(i int) int { return i % 3 },
+		"resources": func(i int) int { return i % 2 },
+		"prompts":   func(int) int { return 1 },
+	}
+
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/servers" {
+			requests.Add(1)
+			var i int
+			var kind string
+			parts := strings.Split(r.URL.Path, "/")
+			if len(parts) == 4 {
+				kind = parts[3]
+				_, _ = fmt.Sscanf(parts[2], "srv-%d", &i)
+			}
+			count, ok := counts[kind]
+			if !ok || i >= n {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			objects := []map[string]interface{}{}
+			for _, id := range ids(kind, i, count(i)) {
+				objects = append(objects, map[string]interface{}{"id": id})
+			}
+			testAccMockWrite(w, http.StatusOK, objects)
+			return
+		}
+
+		servers := make([]map[string]interface{}, n)
+		for i := range servers {
+			servers[i] = map[string]interface{}{
+				"id":        fmt.Sprintf("srv-%d", i),
+				"name":      fmt.Sprintf("server-%d", i),
+				"is_active": true,
+			}
+			if listIDs {
+				servers[i]["tool_ids"] = ids("tools", i, counts["tools"](i))
+				servers[i]["resource_ids"] = ids("resources", i, counts["resources"](i))
+				servers[i]["prompt_ids"] = ids("prompts", i, counts["prompts"](i))
+			}
+		}
+		testAccMockWrite(w, http.StatusOK, servers)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func readTestServersDataSource(t *testing.T, endpoint string, includeCounts types.Bool) (ServersDataSourceModel, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	d := &ServersDataSource{client: client.NewClient(endpoint, "test")}
+	d.client.MaxRetries = 0
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	empty := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: empty}
+	if diags := plan.SetAttribute(ctx, path.Root("include_counts"), includeCounts); diags.HasError() {
+		t.Fatalf("unexpected diagnostics building config: %v", diags)
+	}
+	config := tfsdk.Config(plan)
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: empty}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+
+	var data ServersDataSourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	}
+	return data, resp.Diagnostics
+}
+
+func TestServersDataSourceRead_IncludeCounts(t *testing.T) {
+	const n = 20
+
+	cases := map[string]struct {
+		listIDs      bool
+		wantRequests int64
+	}{
+		"listed IDs":  {listIDs: true, wantRequests: 0},
+		"missing IDs": {listIDs: false, wantRequests: 3 * n},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server, requests := newTestServerCountsAPI(t, n, tc.listIDs)
+
+			data, diags := readTestServersDataSource(t, server.URL, types.BoolValue(true))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if len(data.Servers) != n {
+				t.Fatalf("expected %d servers, got %d", n, len(data.Servers))
+			}
+			for i, s := range data.Servers {
+				want := [3]int64{int64(i % 3), int64(i % 2), 1}
+				got := [3]int64{s.ToolCount.ValueInt64(), s.ResourceCount.ValueInt64(), s.PromptCount.ValueInt64()}
+				if s.ToolCount.IsNull() || s.ResourceCount.IsNull() || s.PromptCount.IsNull() || got != want {
+					t.Errorf("server %s: expected tool, resource and prompt counts %v, got %s %s %s", s.ID, want, s.ToolCount, s.ResourceCount, s.PromptCount)
+				}
+			}
+			if got := requests.Load(); got != tc.wantRequests {
+				t.Errorf("expected %d requests beyond the server list, got %d", tc.wantRequests, got)
+			}
+		})
+	}
+}
+
+func TestServersDataSourceRead_IncludeCountsUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/servers" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		testAccMockWrite(w, http.StatusOK, []map[string]interface{}{
+			{"id": "srv-1", "name": "server-one", "is_active": true},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	data, diags := readTestServersDataSource(t, server.URL, types.BoolValue(true))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(data.Servers) != 1 {
+		t.Fatalf("expected 1 server, got %d", len(data.Servers))
+	}
+	if s := data.Servers[0]; !s.ToolCount.IsNull() || !s.ResourceCount.IsNull() || !s.PromptCount.IsNull() {
+		t.Errorf("expected null counts when the IDs cannot be listed, got %s %s %s", s.ToolCount, s.ResourceCount, s.PromptCount)
+	}
+}
+
+func TestServersDataSourceRead_CountsNullByDefault(t *testing.T) {
+	for name, includeCounts := range map[string]types.Bool{
+		"unset": types.BoolNull(),
+		"false": types.BoolValue(false),
+	} {
+		t.Run(name, func(t *testing.T) {
+			server, requests := newTestServerCountsAPI(t, 3, false)
+
+			data, diags := readTestServersDataSource(t, server.URL, includeCounts)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if len(data.Servers) != 3 {
+				t.Fatalf("expected 3 servers, got %d", len(data.Servers))
+			}
+			for _, s := range data.Servers {
+				if !s.ToolCount.IsNull() || !s.ResourceCount.IsNull() || !s.PromptCount.IsNull() {
+					t.Errorf("server %s: expected null counts, got %s %s %s", s.ID, s.ToolCount, s.ResourceCount, s.PromptCount)
+				}
+			}
+			if got := requests.Load(); got != 0 {
+				t.Errorf("expected no association requests, got %d", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// tagsToModel maps the tags returned by the API to a list attribute value.
+//
+// The gateway may report "no tags" either as null or as [], so the two are
+// treated as equivalent: when the API returns no tags the result is an empty
+// list if prior (the planned or stored value) is a known empty list, and null
+// otherwise. This way neither an unset tags attribute nor `tags = []` shows a
+// diff, whichever form the gateway uses. Non-empty tags are always taken from
+// the API so real drift is still reported.
+func tagsToModel(ctx context.Context, tags []string, prior types.List) (types.List, diag.Diagnostics) {
+	if len(tags) == 0 {
+		if !prior.IsNull() && !prior.IsUnknown() && len(prior.Elements()) == 0 {
+			return prior, nil
+		}
+		return types.ListNull(types.StringType), nil
+	}
+	return types.ListValueFrom(ctx, types.StringType, tags)
+}
+
+// normalizeTags trims whitespace from tags, lowercases them when lowercase is
+// set, and drops tags that end up empty or repeat an earlier one. The order of
+// the remaining tags is kept, and a non-nil input yields a non-nil result so an
+// explicit empty list is still sent as [].
+func normalizeTags(tags []string, lowercase bool) []string {
+	if tags == nil {
+		return nil
+	}
+
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if lowercase {
+			tag = strings.ToLower(tag)
+		}
+		if tag == "" || slices.Contains(normalized, tag) {
+			continue
+		}
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
+// tagsFromModel returns the normalized tags to send to the gateway for a tags
+// attribute value. Null and unknown values yield nil.
+func tagsFromModel(ctx context.Context, value types.List, lowercase bool, diagnostics *diag.Diagnostics) []string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	var tags []string
+	diagnostics.Append(value.ElementsAs(ctx, &tags, false)...)
+	return normalizeTags(tags, lowercase)
+}
+
+// normalizedTagsToModel is tagsToModel for resources whose tags were sent
+// through normalizeTags. When the gateway reports exactly the normalized form
+// of prior, prior is kept, so configured tags with stray whitespace, different
+// case or duplicates do not show a diff after every apply.
+func normalizedTagsToModel(ctx context.Context, tags []string, prior types.List, lowercase bool) (types.List, diag.Diagnostics) {
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorTags []string
+		if diags := prior.ElementsAs(ctx, &priorTags, false); !diags.HasError() && slices.Equal(normalizeTags(priorTags, lowercase), tags) {
+			return prior, nil
+		}
+	}
+	return tagsToModel(ctx, tags, prior)
+}
+
+// warnTagNormalization adds a warning at plan time when normalizeTags would
+// change the configured tags into different ones before they are sent to the
+// gateway, so the difference between the configuration and the gateway is not
+// a surprise. Dropping duplicates alone changes no tag and is not reported.
+func warnTagNormalization(ctx context.Context, config tfsdk.Config, lowercase bool, diagnostics *diag.Diagnostics) {
+	if config.Raw.IsNull() {
+		return
+	}
+
+	var value types.List
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("tags"), &value)...)
+	if diagnostics.HasError() || value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	// The list is only checked once all of its elements are known.
+	for _, element := range value.Elements() {
+		if element.IsNull() || element.IsUnknown() {
+			return
+		}
+	}
+
+	var tags []string
+	diagnostics.Append(value.ElementsAs(ctx, &tags, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	normalized := normalizeTags(tags, lowercase)
+	if sameTags(normalized, tags) {
+		return
+	}
+	diagnostics.AddAttributeWarning(
+		path.Root("tags"),
+		"Tags Normalized",
+		fmt.Sprintf("The tags %q will be sent to the gateway as %q: surrounding whitespace is trimmed, empty and duplicate tags are dropped, "+
+			"and tags are lowercased when the provider sets lowercase_tags. Update the configuration to match to silence this warning.", tags, normalized),
+	)
+}
+
+// sameTags reports whether a and b hold the same tags, regardless of order and
+// duplicates.
+func sameTags(a, b []string) bool {
+	return slices.Equal(slices.Compact(slices.Sorted(slices.Values(a))), slices.Compact(slices.Sorted(slices.Values(b))))
+}
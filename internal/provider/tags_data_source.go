@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+var _ datasource.DataSource = &TagsDataSource{}
+
+func NewTagsDataSource() datasource.DataSource {
+	return &TagsDataSource{}
+}
+
+// TagsDataSource aggregates the tags in use across the servers, tools,
+// prompts, resources and gateways on the MCP Gateway.
+type TagsDataSource struct {
+	client *client.Client
+}
+
+// TagsDataSourceModel describes the data source data model.
+type TagsDataSourceModel struct {
+	IncludeInactive types.Bool   `tfsdk:"include_inactive"`
+	Tags            types.List   `tfsdk:"tags"`
+	Counts          types.Map    `tfsdk:"counts"`
+	ID              types.String `tfsdk:"id"`
+}
+
+func (d *TagsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tags"
+}
+
+func (d *TagsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the tags in use across the servers, tools, prompts, resources and gateways on the ContextForge MCP Gateway, " +
+			"with the number of objects carrying each one, e.g. to audit tagging conventions.",
+		Attributes: map[string]schema.Attribute{
+			"include_inactive": schema.BoolAttribute{
+				MarkdownDescription: "Whether to count the tags of inactive objects. Defaults to `false`.",
+				Optional:            true,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Every tag in use, sorted and without duplicates. Tags are trimmed and empty tags are ignored.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"counts": schema.MapAttribute{
+				MarkdownDescription: "Number of objects carrying each tag, keyed by tag. An object that lists a tag more than once counts once.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Placeholder identifier.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TagsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *TagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TagsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	includeInactive := false
+	if !data.IncludeInactive.IsNull() && !data.IncludeInactive.IsUnknown() {
+		includeInactive = data.IncludeInactive.ValueBool()
+	}
+
+	counts := map[string]int64{}
+
+	tools, err := d.client.ListTools(ctx, includeInactive)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list tools", err)
+		return
+	}
+	for _, t := range tools {
+		countTags(counts, t.Tags)
+	}
+
+	servers, err := d.client.ListServers(ctx, includeInactive)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list servers", err)
+		return
+	}
+	for _, s := range servers {
+		countTags(counts, s.Tags)
+	}
+
+	prompts, err := d.client.ListPrompts(ctx, includeInactive, "")
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list prompts", err)
+		return
+	}
+	for _, p := range prompts {
+		countTags(counts, p.Tags)
+	}
+
+	resources, err := d.client.ListResources(ctx, includeInactive, "")
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list resources", err)
+		return
+	}
+	for _, r := range resources {
+		countTags(counts, r.Tags)
+	}
+
+	gateways, err := d.client.ListGateways(ctx, includeInactive, nil)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "list gateways", err)
+		return
+	}
+	for _, g := range gateways {
+		countTags(counts, g.Tags)
+	}
+
+	tags, diags := types.ListValueFrom(ctx, types.StringType, slices.Sorted(maps.Keys(counts)))
+	resp.Diagnostics.Append(diags...)
+	countsMap, diags := types.MapValueFrom(ctx, types.Int64Type, counts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Tags = tags
+	data.Counts = countsMap
+	data.ID = types.StringValue("tags")
+
+	tflog.Trace(ctx, "read tags data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// countTags adds one to the count of each distinct tag of a single object.
+func countTags(counts map[string]int64, tags []string) {
+	for _, tag := range normalizeTags(tags, false) {
+		counts[tag]++
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+func TestCountTags(t *testing.T) {
+	counts := map[string]int64{}
+	countTags(counts, []string{"prod", "search"})
+	countTags(counts, []string{"prod", " prod", "", "ops"})
+	countTags(counts, nil)
+
+	want := map[string]int64{"prod": 2, "search": 1, "ops": 1}
+	if !maps.Equal(counts, want) {
+		t.Errorf("expected %v, got %v", want, counts)
+	}
+}
+
+func TestAccTagsDataSource(t *testing.T) {
+	responses := map[string]interface{}{
+		"/tools":     []client.Tool{{ID: "tool-1", Tags: []string{"prod", "search"}}, {ID: "tool-2", Tags: []string{"search"}}},
+		"/servers":   []client.Server{{ID: "srv-1", Tags: []string{"prod", "prod"}}},
+		"/prompts":   []client.Prompt{{ID: "prompt-1", Tags: []string{"docs"}}},
+		"/resources": []client.Resource{{ID: "res-1", URI: "file:///one", Tags: []string{"docs", "prod"}}},
+		"/gateways":  []client.Gateway{{ID: "gw-1"}, {ID: "gw-2", Tags: []string{"federated"}}},
+	}
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok || r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(body); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+data "contextforge_tags" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.contextforge_tags.test",
+						tfjsonpath.New("tags"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("docs"),
+							knownvalue.StringExact("federated"),
+							knownvalue.StringExact("prod"),
+							knownvalue.StringExact("search"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_tags.test",
+						tfjsonpath.New("counts"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"docs":      knownvalue.Int64Exact(2),
+							"federated": knownvalue.Int64Exact(1),
+							"prod":      knownvalue.Int64Exact(3),
+							"search":    knownvalue.Int64Exact(2),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
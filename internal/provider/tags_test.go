@@ -0,0 +1,219 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	cases := map[string]struct {
+		tags      []string
+		lowercase bool
+		want      []string
+	}{
+		"unchanged":               {tags: []string{"prod", "Team-A"}, want: []string{"prod", "Team-A"}},
+		"trims whitespace":        {tags: []string{" prod", "team-a\t", "\nops "}, want: []string{"prod", "team-a", "ops"}},
+		"drops blank tags":        {tags: []string{"prod", "", "   "}, want: []string{"prod"}},
+		"dedupes keeping order":   {tags: []string{"b", "a", "b", "a"}, want: []string{"b", "a"}},
+		"dedupes after trimming":  {tags: []string{"prod", " prod "}, want: []string{"prod"}},
+		"keeps case by default":   {tags: []string{"Prod", "prod"}, want: []string{"Prod", "prod"}},
+		"lowercases":              {tags: []string{"Prod", "OPS"}, lowercase: true, want: []string{"prod", "ops"}},
+		"dedupes after lowercase": {tags: []string{"Prod", " prod", "PROD"}, lowercase: true, want: []string{"prod"}},
+		"empty stays empty":       {tags: []string{}, want: []string{}},
+		"nil stays nil":           {tags: nil, want: nil},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := normalizeTags(tc.tags, tc.lowercase)
+			if !slices.Equal(got, tc.want) || (got == nil) != (tc.want == nil) {
+				t.Errorf("normalizeTags(%q, %t) = %#v, want %#v", tc.tags, tc.lowercase, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNormalizedTagsToModel(t *testing.T) {
+	ctx := context.Background()
+	list := func(tags ...string) types.List {
+		values := make([]attr.Value, len(tags))
+		for i, tag := range tags {
+			values[i] = types.StringValue(tag)
+		}
+		return types.ListValueMust(types.StringType, values)
+	}
+
+	cases := map[string]struct {
+		tags      []string
+		prior     types.List
+		lowercase bool
+		want      types.List
+	}{
+		"keeps configured spelling":     {tags: []string{"prod", "ops"}, prior: list(" prod", "ops", "prod"), want: list(" prod", "ops", "prod")},
+		"keeps configured case":         {tags: []string{"prod"}, prior: list("Prod"), lowercase: true, want: list("Prod")},
+		"case differs without lowering": {tags: []string{"prod"}, prior: list("Prod"), want: list("prod")},
+		"reports drift":                 {tags: []string{"prod", "extra"}, prior: list(" prod"), want: list("prod", "extra")},
+		"blank tags sent as none":       {tags: nil, prior: list(" "), want: list(" ")},
+		"null prior":                    {tags: []string{"prod"}, prior: types.ListNull(types.StringType), want: list("prod")},
+		"unknown prior":                 {tags: nil, prior: types.ListUnknown(types.StringType), want: types.ListNull(types.StringType)},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, diags := normalizedTagsToModel(ctx, tc.tags, tc.prior, tc.lowercase)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestWarnTagNormalization(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &fwresource.SchemaResponse{}
+	(&ServerResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	strings := func(values ...tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, values)
+	}
+	str := func(s string) tftypes.Value { return tftypes.NewValue(tftypes.String, s) }
+
+	cases := map[string]struct {
+		tags      tftypes.Value
+		lowercase bool
+		wantWarn  bool
+	}{
+		"normalized":           {tags: strings(str("prod"), str("Ops"))},
+		"whitespace":           {tags: strings(str(" prod")), wantWarn: true},
+		"duplicate":            {tags: strings(str("prod"), str("Ops"), str("prod"))},
+		"duplicate after trim": {tags: strings(str("prod"), str(" prod")), wantWarn: true},
+		"blank":                {tags: strings(str("")), wantWarn: true},
+		"case without flag":    {tags: strings(str("Prod"))},
+		"case with flag":       {tags: strings(str("Prod")), lowercase: true, wantWarn: true},
+		"lowercase with flag":  {tags: strings(str("prod")), lowercase: true},
+		"null":                 {tags: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil)},
+		"unknown":              {tags: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue)},
+		"unknown element":      {tags: strings(str(" prod"), tftypes.NewValue(tftypes.String, tftypes.UnknownValue))},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			plan := tfsdk.Plan(config)
+			diags := plan.SetAttribute(ctx, path.Root("name"), "test")
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics building config: %v", diags)
+			}
+			raw, err := tftypes.Transform(plan.Raw, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+				if p.Equal(tftypes.NewAttributePath().WithAttributeName("tags")) {
+					return tc.tags, nil
+				}
+				return v, nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error building config: %v", err)
+			}
+			config.Raw = raw
+
+			var got diag.Diagnostics
+			warnTagNormalization(ctx, config, tc.lowercase, &got)
+			if got.HasError() {
+				t.Fatalf("unexpected error: %v", got)
+			}
+			if warned := len(got.Warnings()) > 0; warned != tc.wantWarn {
+				t.Errorf("expected warning=%t, got %v", tc.wantWarn, got)
+			}
+		})
+	}
+
+	var got diag.Diagnostics
+	warnTagNormalization(ctx, tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}, true, &got)
+	if len(got) != 0 {
+		t.Errorf("expected no diagnostics for a null config, got %v", got)
+	}
+}
+
+func TestAccGatewayResource_NormalizedTags(t *testing.T) {
+	api := newTestAccMockAPI()
+	api.Collection("gateways", "gw", "auth_value")
+	mockServer := api.Server(t)
+
+	config := fmt.Sprintf(`
+provider "contextforge" {
+  endpoint       = %q
+  bearer_token   = "test"
+  lowercase_tags = true
+}
+
+resource "contextforge_gateway" "test" {
+  name      = "test-gw"
+  url       = "https://example.com/mcp"
+  transport = "STREAMABLEHTTP"
+  tags      = [" Prod", "prod", "Ops "]
+}
+`, mockServer.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_gateway.test",
+						tfjsonpath.New("tags"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact(" Prod"),
+							knownvalue.StringExact("prod"),
+							knownvalue.StringExact("Ops "),
+						}),
+					),
+				},
+				Check: func(*terraform.State) error {
+					posts := api.Requests(http.MethodPost, "/gateways")
+					if len(posts) != 1 {
+						return fmt.Errorf("expected 1 create request, got %d", len(posts))
+					}
+					if got := fmt.Sprint(posts[0].Body["tags"]); got != "[prod ops]" {
+						return fmt.Errorf("expected normalized tags [prod ops] to be sent, got %s", got)
+					}
+					return nil
+				},
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -33,11 +33,14 @@ type ToolDataSourceModel struct {
 	Description types.String `tfsdk:"description"`
 	InputSchema types.String `tfsdk:"input_schema"`
 	Tags        types.List   `tfsdk:"tags"`
+	Annotations types.Map    `tfsdk:"annotations"`
 	IsActive    types.Bool   `tfsdk:"is_active"`
 	GatewayID   types.String `tfsdk:"gateway_id"`
+	Source      types.String `tfsdk:"source"`
 	Visibility  types.String `tfsdk:"visibility"`
 	CreatedAt   types.String `tfsdk:"created_at"`
 	UpdatedAt   types.String `tfsdk:"updated_at"`
+	CreatedBy   types.String `tfsdk:"created_by"`
 }
 
 func (d *ToolDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -69,6 +72,11 @@ func (d *ToolDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"annotations": schema.MapAttribute{
+				MarkdownDescription: "MCP annotations of the tool. Non-string values are JSON-encoded.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"is_active": schema.BoolAttribute{
 				MarkdownDescription: "Whether the tool is active.",
 				Computed:            true,
@@ -77,6 +85,10 @@ func (d *ToolDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				MarkdownDescription: "Gateway ID the tool belongs to.",
 				Computed:            true,
 			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "`gateway` when the tool was discovered from a federated gateway, `manual` when it was created directly.",
+				Computed:            true,
+			},
 			"visibility": schema.StringAttribute{
 				MarkdownDescription: "Visibility of the tool.",
 				Computed:            true,
@@ -89,6 +101,10 @@ func (d *ToolDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				MarkdownDescription: "Timestamp when the tool was last updated.",
 				Computed:            true,
 			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "Principal that created the tool, if reported by the gateway.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -98,16 +114,16 @@ func (d *ToolDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = apiClient
+	d.client = data.client
 }
 
 func (d *ToolDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -120,7 +136,7 @@ func (d *ToolDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	tool, err := d.client.GetTool(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tool, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read tool", err)
 		return
 	}
 	if tool == nil {
@@ -130,13 +146,20 @@ func (d *ToolDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	data.ID = types.StringValue(tool.ID)
 	data.Name = types.StringValue(tool.Name)
-	data.Description = types.StringValue(tool.Description)
+	data.Description = types.StringValue(derefString(tool.Description))
 	data.IsActive = types.BoolValue(tool.IsActive)
 	data.GatewayID = types.StringValue(tool.GatewayID)
-	data.Visibility = types.StringValue(tool.Visibility)
+	data.Source = types.StringValue(toolSource(tool))
+	data.Visibility = types.StringValue(derefString(tool.Visibility))
 	data.CreatedAt = types.StringValue(tool.CreatedAt)
 	data.UpdatedAt = types.StringValue(tool.UpdatedAt)
 
+	if tool.CreatedBy != "" {
+		data.CreatedBy = types.StringValue(tool.CreatedBy)
+	} else {
+		data.CreatedBy = types.StringNull()
+	}
+
 	if tool.InputSchema != nil {
 		schemaJSON, err := json.Marshal(tool.InputSchema)
 		if err != nil {
@@ -148,16 +171,19 @@ func (d *ToolDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		data.InputSchema = types.StringNull()
 	}
 
-	if tool.Tags != nil {
-		tags, diags := types.ListValueFrom(ctx, types.StringType, tool.Tags)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.Tags = tags
-	} else {
-		data.Tags = types.ListNull(types.StringType)
+	tags, diags := stringListValue(ctx, tool.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Tags = tags
+
+	annotations, diags := toolAnnotationsToModel(tool.Annotations, types.MapNull(types.StringType))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.Annotations = annotations
 
 	tflog.Trace(ctx, "read tool data source")
 
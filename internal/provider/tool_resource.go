@@ -6,9 +6,12 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nkbud/terraform-provider-contextforge/internal/client"
@@ -23,6 +27,7 @@ import (
 
 var _ resource.Resource = &ToolResource{}
 var _ resource.ResourceWithImportState = &ToolResource{}
+var _ resource.ResourceWithModifyPlan = &ToolResource{}
 
 func NewToolResource() resource.Resource {
 	return &ToolResource{}
@@ -30,21 +35,26 @@ func NewToolResource() resource.Resource {
 
 // ToolResource manages a tool on the MCP Gateway.
 type ToolResource struct {
-	client *client.Client
+	client   *client.Client
+	settings providerSettings
 }
 
 // ToolResourceModel describes the resource data model.
 type ToolResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	InputSchema types.String `tfsdk:"input_schema"`
-	Tags        types.List   `tfsdk:"tags"`
-	IsActive    types.Bool   `tfsdk:"is_active"`
-	GatewayID   types.String `tfsdk:"gateway_id"`
-	Visibility  types.String `tfsdk:"visibility"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	InputSchema  types.String `tfsdk:"input_schema"`
+	Tags         types.List   `tfsdk:"tags"`
+	Annotations  types.Map    `tfsdk:"annotations"`
+	IsActive     types.Bool   `tfsdk:"is_active"`
+	DeletionMode types.String `tfsdk:"deletion_mode"`
+	GatewayID    types.String `tfsdk:"gateway_id"`
+	Source       types.String `tfsdk:"source"`
+	Visibility   types.String `tfsdk:"visibility"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+	CreatedBy    types.String `tfsdk:"created_by"`
 }
 
 func (r *ToolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,7 +63,7 @@ func (r *ToolResource) Metadata(ctx context.Context, req resource.MetadataReques
 
 func (r *ToolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a tool on the ContextForge MCP Gateway.",
+		MarkdownDescription: "Manages a tool on the ContextForge MCP Gateway. Tools discovered from a federated gateway belong to that gateway; reading one into this resource produces a warning.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Tool identifier, assigned by the API.",
@@ -63,7 +73,7 @@ func (r *ToolResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the tool.",
+				MarkdownDescription: "Name of the tool. Some gateways treat tool names as immutable, so changing it destroys the tool and creates a new one with a new `id` unless the provider sets `allow_tool_rename`. The replacement loses state the gateway keeps for the old tool, such as invocation metrics and server associations made outside Terraform.",
 				Required:            true,
 			},
 			"description": schema.StringAttribute{
@@ -77,7 +87,13 @@ func (r *ToolResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed:            true,
 			},
 			"tags": schema.ListAttribute{
-				MarkdownDescription: "Tags associated with the tool.",
+				MarkdownDescription: "Tags associated with the tool. Leaving this unset and setting it to `[]` are equivalent. Tags are trimmed and de-duplicated before they are sent, and lowercased when the provider sets `lowercase_tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"annotations": schema.MapAttribute{
+				MarkdownDescription: "Free-form MCP annotations for the tool, e.g. `title` or `readOnlyHint`. The boolean hints `readOnlyHint`, `destructiveHint`, `idempotentHint`, and `openWorldHint` must be `\"true\"` or `\"false\"` and are sent as JSON booleans. Non-string values returned by the gateway are JSON-encoded. Setting `{}` removes all annotations.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
@@ -86,16 +102,21 @@ func (r *ToolResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				MarkdownDescription: "Whether the tool is active.",
 				Computed:            true,
 			},
+			"deletion_mode": deletionModeAttribute("tool"),
 			"gateway_id": schema.StringAttribute{
 				MarkdownDescription: "Gateway ID associated with the tool.",
 				Computed:            true,
 			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "How the tool was registered: `gateway` when it was discovered from a federated gateway, `manual` when it was created directly.",
+				Computed:            true,
+			},
 			"visibility": schema.StringAttribute{
-				MarkdownDescription: "Visibility of the tool (e.g. `public`, `private`).",
+				MarkdownDescription: "Visibility of the tool (e.g. `public`, `private`). Defaults to the provider's `default_visibility` when that is set.",
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("public", "private", "team"),
+					stringvalidator.OneOf(visibilityValues...),
 				},
 			},
 			"created_at": schema.StringAttribute{
@@ -106,6 +127,10 @@ func (r *ToolResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				MarkdownDescription: "Timestamp when the tool was last updated.",
 				Computed:            true,
 			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "Principal that created the tool, if reported by the gateway.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -115,55 +140,159 @@ func (r *ToolResource) Configure(ctx context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = apiClient
+	r.client = data.client
+	r.settings = data.settings
 }
 
-func (r *ToolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data ToolResourceModel
-
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
+// ModifyPlan warns when the configured tags will be normalized, validates a
+// new or changed tool, and replaces the tool when its name changes, unless the
+// provider allows renaming tools in place.
+func (r *ToolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate or replace on destroy.
+	if req.Plan.Raw.IsNull() {
 		return
 	}
 
-	var tags []string
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	warnTagNormalization(ctx, req.Config, r.settings.lowercaseTags, &resp.Diagnostics)
+
+	// A plan that leaves the tool as it is has nothing new to validate.
+	if req.State.Raw.IsNull() || !req.Plan.Raw.Equal(req.State.Raw) {
+		r.validatePlan(ctx, req.Plan, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
 
-	var inputSchema map[string]interface{}
-	if !data.InputSchema.IsNull() && !data.InputSchema.IsUnknown() && data.InputSchema.ValueString() != "" {
-		if err := json.Unmarshal([]byte(data.InputSchema.ValueString()), &inputSchema); err != nil {
-			resp.Diagnostics.AddError("Invalid Input Schema", fmt.Sprintf("Unable to parse input_schema JSON: %s", err))
-			return
-		}
+	// Nothing to replace on create.
+	if req.State.Raw.IsNull() {
+		return
+	}
+	if r.settings.allowToolRename {
+		return
+	}
+
+	var planned, prior types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !planned.IsUnknown() && planned.Equal(prior) {
+		return
+	}
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("name"))
+}
+
+// validatePlan sends the planned tool to the gateway's validation endpoint
+// when the provider sets server_side_validation, so a definition the gateway
+// would reject fails the plan instead of the apply. Plans whose name is not
+// known yet are left to be checked by the create or update itself.
+func (r *ToolResource) validatePlan(ctx context.Context, plan tfsdk.Plan, diagnostics *diag.Diagnostics) {
+	if r.client == nil || !r.settings.serverSideValidation {
+		return
+	}
+
+	var data ToolResourceModel
+	diagnostics.Append(plan.Get(ctx, &data)...)
+	if diagnostics.HasError() || data.Name.IsUnknown() {
+		return
+	}
+
+	createReq, ok := toolCreateRequestFromModel(ctx, data, r.settings.lowercaseTags, diagnostics)
+	if !ok {
+		return
+	}
+
+	result, err := r.client.ValidateTool(ctx, createReq)
+	if errors.Is(err, client.ErrValidationUnsupported) {
+		diagnostics.AddWarning(
+			"Server-Side Validation Unavailable",
+			"server_side_validation is set but the gateway has no tool validation endpoint (POST /tools/validate), so the tool was not validated before apply.",
+		)
+		return
+	}
+	if err != nil {
+		diagnostics.AddWarning(
+			"Server-Side Validation Failed",
+			fmt.Sprintf("Unable to validate tool %q with the gateway, so it was not validated before apply: %s", createReq.Tool.Name, err),
+		)
+		return
+	}
+
+	for _, warning := range result.Warnings {
+		diagnostics.AddWarning("Gateway Validation Warning", fmt.Sprintf("Tool %q: %s", createReq.Tool.Name, warning))
+	}
+	if result.Valid {
+		return
+	}
+	if len(result.Errors) == 0 {
+		diagnostics.AddError("Tool Rejected by Gateway", fmt.Sprintf("The gateway rejected tool %q without giving a reason.", createReq.Tool.Name))
+	}
+	for _, msg := range result.Errors {
+		diagnostics.AddError("Tool Rejected by Gateway", fmt.Sprintf("The gateway rejected tool %q: %s", createReq.Tool.Name, msg))
+	}
+}
+
+// toolCreateRequestFromModel builds the create request for the planned tool.
+// It reports false after adding an error when the plan cannot be encoded.
+func toolCreateRequestFromModel(ctx context.Context, data ToolResourceModel, lowercase bool, diagnostics *diag.Diagnostics) (client.CreateToolRequest, bool) {
+	tags := tagsFromModel(ctx, data.Tags, lowercase, diagnostics)
+	if diagnostics.HasError() {
+		return client.CreateToolRequest{}, false
+	}
+
+	inputSchema := jsonObjectFromModel(data.InputSchema, path.Root("input_schema"), "Invalid Input Schema", diagnostics)
+	if diagnostics.HasError() {
+		return client.CreateToolRequest{}, false
+	}
+
+	annotations := toolAnnotationsFromModel(ctx, data.Annotations, diagnostics)
+	if diagnostics.HasError() {
+		return client.CreateToolRequest{}, false
 	}
 
-	createReq := client.CreateToolRequest{
+	return client.CreateToolRequest{
 		Tool: client.ToolCreate{
 			Name:        data.Name.ValueString(),
 			Description: data.Description.ValueString(),
 			InputSchema: inputSchema,
 			Tags:        tags,
+			Annotations: annotations,
 		},
 		Visibility: data.Visibility.ValueString(),
+	}, true
+}
+
+func (r *ToolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if readOnlyBlocked(r.settings, "create tool", &resp.Diagnostics) {
+		return
+	}
+
+	var data ToolResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq, ok := toolCreateRequestFromModel(ctx, data, r.settings.lowercaseTags, &resp.Diagnostics)
+	if !ok {
+		return
 	}
 
 	tool, err := r.client.CreateTool(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create tool, got error: %s", err))
+		addCreateError(&resp.Diagnostics, "create tool", fmt.Sprintf("a tool named %q", data.Name.ValueString()), err)
 		return
 	}
 
@@ -187,13 +316,21 @@ func (r *ToolResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	tool, err := r.client.GetTool(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tool, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read tool", err)
 		return
 	}
 	if tool == nil {
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	if tool.GatewayID != "" {
+		resp.Diagnostics.AddWarning(
+			"Gateway-Managed Tool",
+			fmt.Sprintf("Tool %q (ID %s) was discovered from gateway %s and is managed by that gateway, not by Terraform. "+
+				"Changes made through contextforge_tool are overwritten when the gateway is refreshed, and destroying it only lasts until the tool is rediscovered. "+
+				"Remove it from state with terraform state rm and manage the contextforge_gateway instead.", tool.Name, tool.ID, tool.GatewayID),
+		)
+	}
 
 	r.toolToModel(ctx, tool, &data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -204,39 +341,35 @@ func (r *ToolResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *ToolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ToolResourceModel
-
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
+	if readOnlyBlocked(r.settings, "update tool", &resp.Diagnostics) {
 		return
 	}
 
-	var tags []string
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
+	var data, state ToolResourceModel
 
-	var inputSchema map[string]interface{}
-	if !data.InputSchema.IsNull() && !data.InputSchema.IsUnknown() && data.InputSchema.ValueString() != "" {
-		if err := json.Unmarshal([]byte(data.InputSchema.ValueString()), &inputSchema); err != nil {
-			resp.Diagnostics.AddError("Invalid Input Schema", fmt.Sprintf("Unable to parse input_schema JSON: %s", err))
-			return
-		}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	updateReq := client.ToolUpdate{
-		Name:        data.Name.ValueString(),
-		Description: data.Description.ValueString(),
-		InputSchema: inputSchema,
-		Tags:        tags,
+	updateReq := toolUpdateFromModel(ctx, data, r.settings.lowercaseTags, &resp.Diagnostics)
+	priorReq := toolUpdateFromModel(ctx, state, r.settings.lowercaseTags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	tool, err := r.client.UpdateTool(ctx, data.ID.ValueString(), updateReq)
+	id := data.ID.ValueString()
+	tool, err := updateWithPatch(priorReq, updateReq,
+		func(changes map[string]json.RawMessage) (*client.Tool, error) {
+			return r.client.PatchTool(ctx, id, changes)
+		},
+		func(full client.ToolUpdate) (*client.Tool, error) {
+			return r.client.UpdateTool(ctx, id, full)
+		},
+	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update tool, got error: %s", err))
+		addClientError(&resp.Diagnostics, "update tool", err)
 		return
 	}
 
@@ -250,7 +383,31 @@ func (r *ToolResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// toolUpdateFromModel builds the update request for the tool described by data.
+func toolUpdateFromModel(ctx context.Context, data ToolResourceModel, lowercase bool, diagnostics *diag.Diagnostics) client.ToolUpdate {
+	tags := tagsFromModel(ctx, data.Tags, lowercase, diagnostics)
+
+	inputSchema := jsonObjectFromModel(data.InputSchema, path.Root("input_schema"), "Invalid Input Schema", diagnostics)
+
+	update := client.ToolUpdate{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		InputSchema: inputSchema,
+		Tags:        tags,
+	}
+	// A known empty map is sent as {} so that removing every annotation
+	// clears them on the gateway; null and unknown leave them unchanged.
+	if annotations := toolAnnotationsFromModel(ctx, data.Annotations, diagnostics); annotations != nil {
+		update.Annotations = &annotations
+	}
+	return update
+}
+
 func (r *ToolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if readOnlyBlocked(r.settings, "delete tool", &resp.Diagnostics) {
+		return
+	}
+
 	var data ToolResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -258,9 +415,32 @@ func (r *ToolResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	if deactivateOnDelete(r.settings, data.DeletionMode) {
+		priorReq := toolUpdateFromModel(ctx, data, r.settings.lowercaseTags, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		deactivateReq := priorReq
+		deactivateReq.IsActive = new(bool)
+
+		id := data.ID.ValueString()
+		_, err := updateWithPatch(priorReq, deactivateReq,
+			func(changes map[string]json.RawMessage) (*client.Tool, error) {
+				return r.client.PatchTool(ctx, id, changes)
+			},
+			func(full client.ToolUpdate) (*client.Tool, error) {
+				return r.client.UpdateTool(ctx, id, full)
+			},
+		)
+		if err != nil {
+			addDeactivateError(&resp.Diagnostics, "deactivate tool", err)
+		}
+		return
+	}
+
 	err := r.client.DeleteTool(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete tool, got error: %s", err))
+		addClientError(&resp.Diagnostics, "delete tool", err)
 		return
 	}
 }
@@ -269,17 +449,39 @@ func (r *ToolResource) ImportState(ctx context.Context, req resource.ImportState
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// Values of the computed source attribute on tools.
+const (
+	toolSourceGateway = "gateway"
+	toolSourceManual  = "manual"
+)
+
+// toolSource reports whether a tool was discovered from a gateway or created
+// directly, based on whether the gateway set its gateway_id.
+func toolSource(tool *client.Tool) string {
+	if tool.GatewayID != "" {
+		return toolSourceGateway
+	}
+	return toolSourceManual
+}
+
 // toolToModel maps a client.Tool to the Terraform resource model.
 func (r *ToolResource) toolToModel(ctx context.Context, tool *client.Tool, data *ToolResourceModel, diagnostics *diag.Diagnostics) {
 	data.ID = types.StringValue(tool.ID)
 	data.Name = types.StringValue(tool.Name)
-	data.Description = types.StringValue(tool.Description)
+	data.Description = stringOrPrior(tool.Description, data.Description)
 	data.IsActive = types.BoolValue(tool.IsActive)
 	data.GatewayID = types.StringValue(tool.GatewayID)
-	data.Visibility = types.StringValue(tool.Visibility)
+	data.Source = types.StringValue(toolSource(tool))
+	data.Visibility = stringOrPrior(tool.Visibility, data.Visibility)
 	data.CreatedAt = types.StringValue(tool.CreatedAt)
 	data.UpdatedAt = types.StringValue(tool.UpdatedAt)
 
+	if tool.CreatedBy != "" {
+		data.CreatedBy = types.StringValue(tool.CreatedBy)
+	} else {
+		data.CreatedBy = types.StringNull()
+	}
+
 	if tool.InputSchema != nil {
 		inputSchemaJSON, err := json.Marshal(tool.InputSchema)
 		if err != nil {
@@ -291,14 +493,90 @@ func (r *ToolResource) toolToModel(ctx context.Context, tool *client.Tool, data
 		data.InputSchema = types.StringNull()
 	}
 
-	if tool.Tags != nil {
-		tagsList, diags := types.ListValueFrom(ctx, types.StringType, tool.Tags)
-		diagnostics.Append(diags...)
-		if diagnostics.HasError() {
-			return
+	tagsList, diags := normalizedTagsToModel(ctx, tool.Tags, data.Tags, r.settings.lowercaseTags)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+	data.Tags = tagsList
+
+	annotations, diags := toolAnnotationsToModel(tool.Annotations, data.Annotations)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+	data.Annotations = annotations
+}
+
+// toolBooleanHints are the MCP tool annotations whose values are booleans.
+// They are sent as JSON booleans so clients reading them get the hint the
+// configuration means, rather than a string that is always truthy.
+var toolBooleanHints = []string{"readOnlyHint", "destructiveHint", "idempotentHint", "openWorldHint"}
+
+// toolAnnotationsFromModel converts the annotations attribute into the request
+// payload, returning nil when it is null or unknown. Known boolean hints are
+// parsed into booleans; any other value is sent as a string.
+func toolAnnotationsFromModel(ctx context.Context, annotations types.Map, diagnostics *diag.Diagnostics) map[string]interface{} {
+	if annotations.IsNull() || annotations.IsUnknown() {
+		return nil
+	}
+
+	var values map[string]string
+	diagnostics.Append(annotations.ElementsAs(ctx, &values, false)...)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	result := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		if !slices.Contains(toolBooleanHints, k) {
+			result[k] = v
+			continue
 		}
-		data.Tags = tagsList
-	} else {
-		data.Tags = types.ListNull(types.StringType)
+		// Only the canonical spellings are accepted, since the value read back
+		// from the gateway is always "true" or "false".
+		if v != "true" && v != "false" {
+			diagnostics.AddAttributeError(
+				path.Root("annotations").AtMapKey(k),
+				"Invalid Tool Annotation",
+				fmt.Sprintf("The MCP annotation %s is a boolean hint and must be \"true\" or \"false\", got %q.", k, v),
+			)
+			continue
+		}
+		result[k] = v == "true"
 	}
+	return result
+}
+
+// toolAnnotationsToModel maps the annotations returned by the API to a map of
+// strings, JSON-encoding non-string values. The gateway does not distinguish
+// between absent and empty annotations, so an empty result keeps prior when it
+// is a known empty map and is null otherwise.
+func toolAnnotationsToModel(annotations map[string]interface{}, prior types.Map) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(annotations) == 0 {
+		if !prior.IsNull() && !prior.IsUnknown() && len(prior.Elements()) == 0 {
+			return prior, diags
+		}
+		return types.MapNull(types.StringType), diags
+	}
+
+	values := make(map[string]attr.Value, len(annotations))
+	for k, v := range annotations {
+		if str, ok := v.(string); ok {
+			values[k] = types.StringValue(str)
+			continue
+		}
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			diags.AddError("Serialization Error", fmt.Sprintf("Unable to serialize annotation %q to JSON: %s", k, err))
+			return types.MapNull(types.StringType), diags
+		}
+		values[k] = types.StringValue(string(encoded))
+	}
+
+	result, mapDiags := types.MapValue(types.StringType, values)
+	diags.Append(mapDiags...)
+	return result, diags
 }
@@ -4,15 +4,29 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/nkbud/terraform-provider-contextforge/internal/client"
@@ -32,10 +46,10 @@ func TestAccToolResource(t *testing.T) {
 			if err := json.NewEncoder(w).Encode(client.Tool{
 				ID:          "tool-created",
 				Name:        req.Tool.Name,
-				Description: req.Tool.Description,
+				Description: stringPointer(req.Tool.Description),
 				Tags:        []string{},
 				IsActive:    true,
-				Visibility:  req.Visibility,
+				Visibility:  stringPointer(req.Visibility),
 				CreatedAt:   "2025-01-01T00:00:00Z",
 				UpdatedAt:   "2025-01-01T00:00:00Z",
 			}); err != nil {
@@ -47,10 +61,10 @@ func TestAccToolResource(t *testing.T) {
 			if err := json.NewEncoder(w).Encode(client.Tool{
 				ID:          "tool-created",
 				Name:        "test-tool",
-				Description: "A test tool",
+				Description: stringPointer("A test tool"),
 				Tags:        []string{},
 				IsActive:    true,
-				Visibility:  "private",
+				Visibility:  stringPointer("private"),
 				CreatedAt:   "2025-01-01T00:00:00Z",
 				UpdatedAt:   "2025-01-01T00:00:00Z",
 			}); err != nil {
@@ -83,12 +97,716 @@ func TestAccToolResource(t *testing.T) {
 						tfjsonpath.New("name"),
 						knownvalue.StringExact("test-tool"),
 					),
+					statecheck.ExpectKnownValue(
+						"contextforge_tool.test",
+						tfjsonpath.New("created_by"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccToolResource_Annotations(t *testing.T) {
+	var created client.Tool
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/tools" && r.Method == http.MethodPost:
+			var req client.CreateToolRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if req.Tool.Annotations["destructiveHint"] != false {
+				t.Errorf("expected destructiveHint annotation in request, got %v", req.Tool.Annotations)
+			}
+			created = client.Tool{
+				ID:          "tool-annotated",
+				Name:        req.Tool.Name,
+				Tags:        []string{},
+				Annotations: req.Tool.Annotations,
+				IsActive:    true,
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			if err := json.NewEncoder(w).Encode(created); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/tools/tool-annotated" && r.Method == http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(created); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/tools/tool-annotated" && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "contextforge" {
+  endpoint     = "` + mockServer.URL + `"
+  bearer_token = "test"
+}
+
+resource "contextforge_tool" "test" {
+  name = "annotated-tool"
+  annotations = {
+    destructiveHint = "false"
+    readOnlyHint    = "true"
+  }
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_tool.test",
+						tfjsonpath.New("annotations"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"destructiveHint": knownvalue.StringExact("false"),
+							"readOnlyHint":    knownvalue.StringExact("true"),
+						}),
+					),
 				},
 			},
 		},
 	})
 }
 
+func TestToolAnnotationsToModel(t *testing.T) {
+	empty := types.MapValueMust(types.StringType, map[string]attr.Value{})
+
+	got, diags := toolAnnotationsToModel(nil, types.MapNull(types.StringType))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !got.IsNull() {
+		t.Errorf("expected null for absent annotations, got %s", got)
+	}
+
+	got, diags = toolAnnotationsToModel(map[string]interface{}{}, empty)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got.IsNull() || len(got.Elements()) != 0 {
+		t.Errorf("expected configured empty map to be kept, got %s", got)
+	}
+
+	got, diags = toolAnnotationsToModel(map[string]interface{}{"readOnlyHint": true, "title": "Lookup"}, types.MapUnknown(types.StringType))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"readOnlyHint": types.StringValue("true"),
+		"title":        types.StringValue("Lookup"),
+	})
+	if !got.Equal(want) {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestToolAnnotationsFromModel_BooleanHints(t *testing.T) {
+	data := ToolResourceModel{
+		Name: types.StringValue("lookup"),
+		Tags: types.ListNull(types.StringType),
+		Annotations: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"readOnlyHint":    types.StringValue("true"),
+			"destructiveHint": types.StringValue("false"),
+			"idempotentHint":  types.StringValue("true"),
+			"openWorldHint":   types.StringValue("false"),
+			"title":           types.StringValue("true"),
+		}),
+	}
+	var diags diag.Diagnostics
+
+	createReq, ok := toolCreateRequestFromModel(context.Background(), data, false, &diags)
+	if !ok || diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	body, err := json.Marshal(createReq)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `"annotations":{"destructiveHint":false,"idempotentHint":true,"openWorldHint":false,"readOnlyHint":true,"title":"true"}`
+	if !strings.Contains(string(body), want) {
+		t.Errorf("expected request body to contain %s, got %s", want, body)
+	}
+
+	data.Annotations = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"readOnlyHint": types.StringValue("True"),
+	})
+	toolAnnotationsFromModel(context.Background(), data.Annotations, &diags)
+	if !diags.HasError() {
+		t.Fatal("expected an error for a boolean hint that is not a boolean")
+	}
+}
+
+func TestToolUpdateFromModel_Annotations(t *testing.T) {
+	cases := map[string]struct {
+		annotations types.Map
+		want        string
+	}{
+		"null":    {annotations: types.MapNull(types.StringType), want: ""},
+		"unknown": {annotations: types.MapUnknown(types.StringType), want: ""},
+		"empty":   {annotations: types.MapValueMust(types.StringType, map[string]attr.Value{}), want: `{}`},
+		"hint": {
+			annotations: types.MapValueMust(types.StringType, map[string]attr.Value{"readOnlyHint": types.StringValue("true")}),
+			want:        `{"readOnlyHint":true}`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			data := ToolResourceModel{
+				Name:        types.StringValue("lookup"),
+				Tags:        types.ListNull(types.StringType),
+				Annotations: tc.annotations,
+			}
+			var diags diag.Diagnostics
+
+			update := toolUpdateFromModel(context.Background(), data, false, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			body, err := json.Marshal(update)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(body, &fields); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := string(fields["annotations"]); got != tc.want {
+				t.Errorf("expected annotations %q in %s, got %q", tc.want, body, got)
+			}
+		})
+	}
+}
+
+func TestToolToModel_TagsNullAndEmpty(t *testing.T) {
+	empty := types.ListValueMust(types.StringType, []attr.Value{})
+
+	cases := map[string]struct {
+		prior    types.List
+		apiTags  []string
+		wantNull bool
+	}{
+		"unset config, API null":  {prior: types.ListNull(types.StringType), apiTags: nil, wantNull: true},
+		"unset config, API empty": {prior: types.ListNull(types.StringType), apiTags: []string{}, wantNull: true},
+		"empty config, API null":  {prior: empty, apiTags: nil, wantNull: false},
+		"empty config, API empty": {prior: empty, apiTags: []string{}, wantNull: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			data := ToolResourceModel{Tags: tc.prior, Annotations: types.MapNull(types.StringType)}
+			var diags diag.Diagnostics
+
+			(&ToolResource{}).toolToModel(context.Background(), &client.Tool{ID: "tool-1", Tags: tc.apiTags}, &data, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if data.Tags.IsNull() != tc.wantNull {
+				t.Errorf("expected null=%t, got %s", tc.wantNull, data.Tags)
+			}
+			if !data.Tags.IsNull() && len(data.Tags.Elements()) != 0 {
+				t.Errorf("expected empty list, got %s", data.Tags)
+			}
+		})
+	}
+
+	t.Run("API tags win over prior", func(t *testing.T) {
+		data := ToolResourceModel{Tags: empty, Annotations: types.MapNull(types.StringType)}
+		var diags diag.Diagnostics
+
+		(&ToolResource{}).toolToModel(context.Background(), &client.Tool{ID: "tool-1", Tags: []string{"drifted"}}, &data, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		want := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("drifted")})
+		if !data.Tags.Equal(want) {
+			t.Errorf("expected %s, got %s", want, data.Tags)
+		}
+	})
+}
+
+func TestToolToModel_EmptyStrings(t *testing.T) {
+	cases := map[string]struct {
+		prior    types.String
+		apiValue *string
+		want     types.String
+	}{
+		"unset config, API empty": {prior: types.StringUnknown(), apiValue: stringPointer(""), want: types.StringNull()},
+		"null prior, API empty":   {prior: types.StringNull(), apiValue: stringPointer(""), want: types.StringNull()},
+		"empty config, API empty": {prior: types.StringValue(""), apiValue: stringPointer(""), want: types.StringValue("")},
+		"configured, API value":   {prior: types.StringValue("old"), apiValue: stringPointer("new"), want: types.StringValue("new")},
+		"configured, API omits":   {prior: types.StringValue("old"), apiValue: nil, want: types.StringValue("old")},
+		"configured, API empty":   {prior: types.StringValue("old"), apiValue: stringPointer(""), want: types.StringNull()},
+		"unset config, API value": {prior: types.StringUnknown(), apiValue: stringPointer("new"), want: types.StringValue("new")},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			data := ToolResourceModel{
+				Description: tc.prior,
+				Visibility:  tc.prior,
+				Tags:        types.ListNull(types.StringType),
+				Annotations: types.MapNull(types.StringType),
+			}
+			var diags diag.Diagnostics
+
+			tool := &client.Tool{ID: "tool-1", Description: tc.apiValue, Visibility: tc.apiValue}
+			(&ToolResource{}).toolToModel(context.Background(), tool, &data, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if !data.Description.Equal(tc.want) {
+				t.Errorf("expected description %s, got %s", tc.want, data.Description)
+			}
+			if !data.Visibility.Equal(tc.want) {
+				t.Errorf("expected visibility %s, got %s", tc.want, data.Visibility)
+			}
+		})
+	}
+}
+
+func TestAccToolResource_Duplicate(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/tools" && r.Method == http.MethodPost {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			if _, err := w.Write([]byte(`{"detail": "Tool already exists with name: test-tool"}`)); err != nil {
+				t.Errorf("failed to write response: %v", err)
+			}
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccToolResourceConfig(mockServer.URL),
+				ExpectError: regexp.MustCompile(`a tool named "test-tool" already exists on the gateway; import it\s+instead`),
+			},
+		},
+	})
+}
+
+func TestAccToolResource_PatchSendsOnlyChanges(t *testing.T) {
+	var mu sync.Mutex
+	var patches []map[string]interface{}
+	stored := client.Tool{
+		ID:          "tool-created",
+		Tags:        []string{},
+		IsActive:    true,
+		Visibility:  stringPointer("private"),
+		Annotations: map[string]interface{}{"discovered": true},
+		CreatedAt:   "2025-01-01T00:00:00Z",
+		UpdatedAt:   "2025-01-01T00:00:00Z",
+	}
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/tools" && r.Method == http.MethodPost:
+			var req client.CreateToolRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			stored.Name = req.Tool.Name
+			stored.Description = stringPointer(req.Tool.Description)
+			w.WriteHeader(http.StatusCreated)
+		case r.URL.Path == "/tools/tool-created" && r.Method == http.MethodPatch:
+			var req map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			patches = append(patches, req)
+			if description, ok := req["description"].(string); ok {
+				stored.Description = stringPointer(description)
+			}
+		case r.URL.Path == "/tools/tool-created" && r.Method == http.MethodGet:
+		case r.URL.Path == "/tools/tool-created" && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+			return
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := json.NewEncoder(w).Encode(stored); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccToolResourceDescriptionConfig(mockServer.URL, "before"),
+			},
+			{
+				Config: testAccToolResourceDescriptionConfig(mockServer.URL, "after"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_tool.test",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact("after"),
+					),
+				},
+				PostApplyFunc: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					if len(patches) != 1 {
+						t.Fatalf("expected 1 PATCH request, got %d", len(patches))
+					}
+					if len(patches[0]) != 1 || patches[0]["description"] != "after" {
+						t.Errorf("expected only the description to be sent, got %v", patches[0])
+					}
+				},
+			},
+		},
+	})
+}
+
+func TestToolResourceModifyPlan_Rename(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &fwresource.SchemaResponse{}
+	(&ToolResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	cases := map[string]struct {
+		planned     types.String
+		allowRename bool
+		wantReplace bool
+	}{
+		"unchanged":           {planned: types.StringValue("old-name")},
+		"renamed":             {planned: types.StringValue("new-name"), wantReplace: true},
+		"unknown":             {planned: types.StringUnknown(), wantReplace: true},
+		"renamed with allow":  {planned: types.StringValue("new-name"), allowRename: true},
+		"unchanged and allow": {planned: types.StringValue("old-name"), allowRename: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			diags := plan.SetAttribute(ctx, path.Root("name"), tc.planned)
+			diags.Append(state.SetAttribute(ctx, path.Root("name"), types.StringValue("old-name"))...)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics building plan: %v", diags)
+			}
+
+			r := &ToolResource{
+				client:   client.NewClient("http://localhost:4444", ""),
+				settings: providerSettings{allowToolRename: tc.allowRename},
+			}
+
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			gotReplace := slices.ContainsFunc(resp.RequiresReplace, func(p path.Path) bool { return p.Equal(path.Root("name")) })
+			if gotReplace != tc.wantReplace {
+				t.Errorf("expected replace=%t, got RequiresReplace %v", tc.wantReplace, resp.RequiresReplace)
+			}
+		})
+	}
+}
+
+func TestAccToolResource_Rename(t *testing.T) {
+	for name, allowRename := range map[string]bool{"replace": false, "in place": true} {
+		t.Run(name, func(t *testing.T) {
+			var mu sync.Mutex
+			tools := map[string]*client.Tool{}
+			created := 0
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				id := strings.TrimPrefix(r.URL.Path, "/tools/")
+				var tool *client.Tool
+				switch {
+				case r.URL.Path == "/tools" && r.Method == http.MethodPost:
+					var req client.CreateToolRequest
+					if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+						http.Error(w, err.Error(), http.StatusBadRequest)
+						return
+					}
+					created++
+					tool = &client.Tool{
+						ID:         fmt.Sprintf("tool-%d", created),
+						Name:       req.Tool.Name,
+						Tags:       []string{},
+						IsActive:   true,
+						Visibility: stringPointer(req.Visibility),
+						CreatedAt:  "2025-01-01T00:00:00Z",
+						UpdatedAt:  "2025-01-01T00:00:00Z",
+					}
+					tools[tool.ID] = tool
+					w.WriteHeader(http.StatusCreated)
+				case tools[id] != nil && r.Method == http.MethodPatch:
+					var req map[string]interface{}
+					if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+						http.Error(w, err.Error(), http.StatusBadRequest)
+						return
+					}
+					tool = tools[id]
+					if name, ok := req["name"].(string); ok {
+						tool.Name = name
+					}
+				case tools[id] != nil && r.Method == http.MethodGet:
+					tool = tools[id]
+				case tools[id] != nil && r.Method == http.MethodDelete:
+					delete(tools, id)
+					w.WriteHeader(http.StatusNoContent)
+					return
+				default:
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if err := json.NewEncoder(w).Encode(tool); err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+			}))
+			defer mockServer.Close()
+
+			wantAction, wantID := plancheck.ResourceActionReplace, "tool-2"
+			if allowRename {
+				wantAction, wantID = plancheck.ResourceActionUpdate, "tool-1"
+			}
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+					"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: testAccToolResourceRenameConfig(mockServer.URL, allowRename, "old-name"),
+					},
+					{
+						Config: testAccToolResourceRenameConfig(mockServer.URL, allowRename, "new-name"),
+						ConfigPlanChecks: resource.ConfigPlanChecks{
+							PreApply: []plancheck.PlanCheck{
+								plancheck.ExpectResourceAction("contextforge_tool.test", wantAction),
+							},
+						},
+						ConfigStateChecks: []statecheck.StateCheck{
+							statecheck.ExpectKnownValue(
+								"contextforge_tool.test",
+								tfjsonpath.New("id"),
+								knownvalue.StringExact(wantID),
+							),
+							statecheck.ExpectKnownValue(
+								"contextforge_tool.test",
+								tfjsonpath.New("name"),
+								knownvalue.StringExact("new-name"),
+							),
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestToolResourceModifyPlan_ServerSideValidation(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &fwresource.SchemaResponse{}
+	(&ToolResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	cases := map[string]struct {
+		enabled      bool
+		status       int
+		body         string
+		prior        string // "" for a create, or the description in state
+		wantErrors   int
+		wantWarnings int
+		wantRequests int
+	}{
+		"disabled":    {status: http.StatusOK, body: `{"valid": false, "errors": ["bad"]}`},
+		"accepted":    {enabled: true, status: http.StatusOK, body: `{"valid": true}`, wantRequests: 1},
+		"warned":      {enabled: true, status: http.StatusOK, body: `{"valid": true, "warnings": ["no description"]}`, wantWarnings: 1, wantRequests: 1},
+		"rejected":    {enabled: true, status: http.StatusUnprocessableEntity, body: `{"detail": "input_schema must be an object"}`, wantErrors: 1, wantRequests: 1},
+		"unsupported": {enabled: true, status: http.StatusNotFound, body: `{"detail": "Not Found"}`, wantWarnings: 1, wantRequests: 1},
+		"changed":     {enabled: true, status: http.StatusOK, body: `{"valid": true}`, prior: "old", wantRequests: 1},
+		"unchanged":   {enabled: true, status: http.StatusOK, body: `{"valid": false, "errors": ["bad"]}`, prior: "planned"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var requests int
+			var validated client.CreateToolRequest
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if err := json.NewDecoder(r.Body).Decode(&validated); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.status)
+				if _, err := w.Write([]byte(tc.body)); err != nil {
+					t.Errorf("failed to write response: %v", err)
+				}
+			}))
+			defer server.Close()
+
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			diags := plan.SetAttribute(ctx, path.Root("name"), types.StringValue("my-tool"))
+			diags.Append(plan.SetAttribute(ctx, path.Root("input_schema"), types.StringValue(`{"type": "object"}`))...)
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			if tc.prior != "" {
+				diags.Append(plan.SetAttribute(ctx, path.Root("description"), types.StringValue("planned"))...)
+				state.Raw = plan.Raw.Copy()
+				diags.Append(state.SetAttribute(ctx, path.Root("description"), types.StringValue(tc.prior))...)
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics building plan: %v", diags)
+			}
+
+			r := &ToolResource{
+				client:   client.NewClient(server.URL, ""),
+				settings: providerSettings{serverSideValidation: tc.enabled},
+			}
+
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tc.wantErrors {
+				t.Errorf("expected %d errors, got %d: %v", tc.wantErrors, got, resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount(); got != tc.wantWarnings {
+				t.Errorf("expected %d warnings, got %d: %v", tc.wantWarnings, got, resp.Diagnostics)
+			}
+			if requests != tc.wantRequests {
+				t.Fatalf("expected %d validation requests, got %d", tc.wantRequests, requests)
+			}
+			if requests > 0 && (validated.Tool.Name != "my-tool" || validated.Tool.InputSchema["type"] != "object") {
+				t.Errorf("expected the planned tool to be validated, got %+v", validated)
+			}
+		})
+	}
+}
+
+func TestAccToolResource_ServerSideValidation(t *testing.T) {
+	api := newTestAccMockAPI()
+	api.Collection("tools", "tool")
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tools/validate" {
+			api.ServeHTTP(w, r)
+			return
+		}
+		var req client.CreateToolRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if strings.Contains(req.Tool.Name, " ") {
+			testAccMockWrite(w, http.StatusUnprocessableEntity, map[string]string{"detail": "tool names may not contain spaces"})
+			return
+		}
+		testAccMockWrite(w, http.StatusOK, map[string]interface{}{"valid": true})
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccToolResourceValidationConfig(mockServer.URL, "bad name"),
+				ExpectError: regexp.MustCompile(`tool names may not contain spaces`),
+			},
+			{
+				Config: testAccToolResourceValidationConfig(mockServer.URL, "good-name"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_tool.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("good-name"),
+					),
+				},
+			},
+		},
+	})
+
+	if posts := api.Requests(http.MethodPost, "/tools"); len(posts) != 1 {
+		t.Errorf("expected only the accepted tool to be created, got %d creates", len(posts))
+	}
+}
+
+func testAccToolResourceValidationConfig(endpoint, name string) string {
+	return fmt.Sprintf(`
+provider "contextforge" {
+  endpoint               = %q
+  bearer_token           = "test"
+  server_side_validation = true
+}
+
+resource "contextforge_tool" "test" {
+  name       = %q
+  visibility = "private"
+}
+`, endpoint, name)
+}
+
+func testAccToolResourceRenameConfig(endpoint string, allowRename bool, name string) string {
+	return fmt.Sprintf(`
+provider "contextforge" {
+  endpoint          = %q
+  bearer_token      = "test"
+  allow_tool_rename = %t
+}
+
+resource "contextforge_tool" "test" {
+  name       = %q
+  visibility = "private"
+}
+`, endpoint, allowRename, name)
+}
+
+func testAccToolResourceDescriptionConfig(endpoint, description string) string {
+	return `
+provider "contextforge" {
+  endpoint     = "` + endpoint + `"
+  bearer_token = "test"
+}
+
+resource "contextforge_tool" "test" {
+  name        = "test-tool"
+  description = "` + description + `"
+  visibility  = "private"
+}
+`
+}
+
 func testAccToolResourceConfig(endpoint string) string {
 	return `
 provider "contextforge" {
@@ -103,3 +821,119 @@ resource "contextforge_tool" "test" {
 }
 `
 }
+
+func TestToolToModel_Source(t *testing.T) {
+	cases := map[string]struct {
+		gatewayID string
+		want      string
+	}{
+		"discovered from gateway": {gatewayID: "gw-1", want: "gateway"},
+		"created directly":        {gatewayID: "", want: "manual"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			data := ToolResourceModel{
+				Tags:        types.ListNull(types.StringType),
+				Annotations: types.MapNull(types.StringType),
+			}
+			var diags diag.Diagnostics
+
+			tool := &client.Tool{ID: "tool-1", GatewayID: tc.gatewayID}
+			(&ToolResource{}).toolToModel(context.Background(), tool, &data, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if got := data.Source.ValueString(); got != tc.want {
+				t.Errorf("expected source %q, got %q", tc.want, got)
+			}
+			if got := data.GatewayID.ValueString(); got != tc.gatewayID {
+				t.Errorf("expected gateway_id %q, got %q", tc.gatewayID, got)
+			}
+		})
+	}
+}
+
+func TestToolResourceRead_GatewayManagedWarning(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tools/tool-discovered":
+			testAccMockWrite(w, http.StatusOK, client.Tool{ID: "tool-discovered", Name: "search", GatewayID: "gw-1"})
+		case "/tools/tool-manual":
+			testAccMockWrite(w, http.StatusOK, client.Tool{ID: "tool-manual", Name: "echo"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &ToolResource{client: client.NewClient(server.URL, "test")}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	cases := map[string]struct {
+		id       string
+		wantWarn bool
+	}{
+		"discovered from gateway": {id: "tool-discovered", wantWarn: true},
+		"created directly":        {id: "tool-manual"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			diags := state.SetAttribute(ctx, path.Root("id"), tc.id)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics building state: %v", diags)
+			}
+
+			resp := &fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var warned bool
+			for _, d := range resp.Diagnostics.Warnings() {
+				if d.Summary() == "Gateway-Managed Tool" && strings.Contains(d.Detail(), "gw-1") {
+					warned = true
+				}
+			}
+			if warned != tc.wantWarn {
+				t.Errorf("expected gateway-managed warning=%t, got %v", tc.wantWarn, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestToolRequestFromModel_InputSchemaArray(t *testing.T) {
+	ctx := context.Background()
+	data := ToolResourceModel{
+		Name:        types.StringValue("search"),
+		InputSchema: types.StringValue(`[{"type": "object"}]`),
+		Tags:        types.ListNull(types.StringType),
+		Annotations: types.MapNull(types.StringType),
+	}
+
+	var createDiags, updateDiags diag.Diagnostics
+	if _, ok := toolCreateRequestFromModel(ctx, data, false, &createDiags); ok {
+		t.Fatal("expected the create request to be rejected")
+	}
+	toolUpdateFromModel(ctx, data, false, &updateDiags)
+
+	for name, diags := range map[string]diag.Diagnostics{"create": createDiags, "update": updateDiags} {
+		if diags.ErrorsCount() != 1 {
+			t.Fatalf("%s: expected one error, got %v", name, diags)
+		}
+		d := diags.Errors()[0]
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if !ok || !withPath.Path().Equal(path.Root("input_schema")) || !strings.Contains(d.Detail(), "input_schema must be a JSON object") {
+			t.Errorf("%s: expected an input_schema error saying it must be an object, got %v", name, d)
+		}
+	}
+}
@@ -8,8 +8,12 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nkbud/terraform-provider-contextforge/internal/client"
@@ -29,6 +33,8 @@ type ToolsDataSource struct {
 // ToolsDataSourceModel describes the data source data model.
 type ToolsDataSourceModel struct {
 	IncludeInactive types.Bool      `tfsdk:"include_inactive"`
+	OnlyInactive    types.Bool      `tfsdk:"only_inactive"`
+	Active          types.Bool      `tfsdk:"active"`
 	Tools           []ToolItemModel `tfsdk:"tools"`
 	ID              types.String    `tfsdk:"id"`
 }
@@ -40,8 +46,10 @@ type ToolItemModel struct {
 	Description types.String `tfsdk:"description"`
 	InputSchema types.String `tfsdk:"input_schema"`
 	Tags        types.List   `tfsdk:"tags"`
+	Annotations types.Map    `tfsdk:"annotations"`
 	IsActive    types.Bool   `tfsdk:"is_active"`
 	GatewayID   types.String `tfsdk:"gateway_id"`
+	Source      types.String `tfsdk:"source"`
 	Visibility  types.String `tfsdk:"visibility"`
 	CreatedAt   types.String `tfsdk:"created_at"`
 	UpdatedAt   types.String `tfsdk:"updated_at"`
@@ -59,6 +67,17 @@ func (d *ToolsDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				MarkdownDescription: "Whether to include inactive tools in the list. Defaults to `false`.",
 				Optional:            true,
 			},
+			"only_inactive": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, only inactive tools are returned. Shorthand for `active = false`.",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("active")),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "When set, only tools whose `is_active` matches this value are returned. Setting it to `false` implies `include_inactive`.",
+				Optional:            true,
+			},
 			"tools": schema.ListNestedAttribute{
 				MarkdownDescription: "List of tools.",
 				Computed:            true,
@@ -85,6 +104,11 @@ func (d *ToolsDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 							Computed:            true,
 							ElementType:         types.StringType,
 						},
+						"annotations": schema.MapAttribute{
+							MarkdownDescription: "MCP annotations of the tool. Non-string values are JSON-encoded.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"is_active": schema.BoolAttribute{
 							MarkdownDescription: "Whether the tool is active.",
 							Computed:            true,
@@ -93,6 +117,10 @@ func (d *ToolsDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 							MarkdownDescription: "Gateway ID the tool belongs to.",
 							Computed:            true,
 						},
+						"source": schema.StringAttribute{
+							MarkdownDescription: "`gateway` when the tool was discovered from a federated gateway, `manual` when it was created directly.",
+							Computed:            true,
+						},
 						"visibility": schema.StringAttribute{
 							MarkdownDescription: "Visibility of the tool.",
 							Computed:            true,
@@ -121,16 +149,16 @@ func (d *ToolsDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = apiClient
+	d.client = data.client
 }
 
 func (d *ToolsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -141,58 +169,70 @@ func (d *ToolsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	includeInactive := false
-	if !data.IncludeInactive.IsNull() && !data.IncludeInactive.IsUnknown() {
-		includeInactive = data.IncludeInactive.ValueBool()
-	}
+	filter := newListFilter(data.IncludeInactive, data.OnlyInactive, data.Active)
 
-	tools, err := d.client.ListTools(ctx, includeInactive)
+	tools, err := d.client.ListTools(ctx, filter.includeInactive)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list tools, got error: %s", err))
+		addClientError(&resp.Diagnostics, "list tools", err)
 		return
 	}
+	tools = filterByActive(tools, filter, func(t client.Tool) bool { return t.IsActive })
 
-	data.Tools = make([]ToolItemModel, len(tools))
-	for i, t := range tools {
-		item := ToolItemModel{
-			ID:          types.StringValue(t.ID),
-			Name:        types.StringValue(t.Name),
-			Description: types.StringValue(t.Description),
-			IsActive:    types.BoolValue(t.IsActive),
-			GatewayID:   types.StringValue(t.GatewayID),
-			Visibility:  types.StringValue(t.Visibility),
-			CreatedAt:   types.StringValue(t.CreatedAt),
-			UpdatedAt:   types.StringValue(t.UpdatedAt),
-		}
+	data.Tools = toolItemsFromAPI(ctx, tools, &resp.Diagnostics)
 
-		if t.InputSchema != nil {
-			schemaJSON, err := json.Marshal(t.InputSchema)
-			if err != nil {
-				resp.Diagnostics.AddError("InputSchema Serialization Error", fmt.Sprintf("Unable to serialize input schema: %s", err))
-				return
-			}
-			item.InputSchema = types.StringValue(string(schemaJSON))
-		} else {
-			item.InputSchema = types.StringNull()
-		}
+	data.ID = types.StringValue("tools")
 
-		if t.Tags != nil {
-			tags, diags := types.ListValueFrom(ctx, types.StringType, t.Tags)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			item.Tags = tags
-		} else {
-			item.Tags = types.ListNull(types.StringType)
-		}
+	tflog.Trace(ctx, "read tools data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
 
-		data.Tools[i] = item
+// toolItemsFromAPI maps the listed tools to item models. Elements that fail
+// to map are omitted with a warning so the remaining results are still returned.
+func toolItemsFromAPI(ctx context.Context, tools []client.Tool, diagnostics *diag.Diagnostics) []ToolItemModel {
+	return mapItems(ctx, tools, "tool", func(t client.Tool) string { return t.ID }, toolItemFromAPI, diagnostics)
+}
+
+// toolItemFromAPI maps a single tool to its item model.
+func toolItemFromAPI(ctx context.Context, t client.Tool) (ToolItemModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	item := ToolItemModel{
+		ID:          types.StringValue(t.ID),
+		Name:        types.StringValue(t.Name),
+		Description: types.StringValue(derefString(t.Description)),
+		IsActive:    types.BoolValue(t.IsActive),
+		GatewayID:   types.StringValue(t.GatewayID),
+		Source:      types.StringValue(toolSource(&t)),
+		Visibility:  types.StringValue(derefString(t.Visibility)),
+		CreatedAt:   types.StringValue(t.CreatedAt),
+		UpdatedAt:   types.StringValue(t.UpdatedAt),
 	}
 
-	data.ID = types.StringValue("tools")
+	if t.InputSchema != nil {
+		schemaJSON, err := json.Marshal(t.InputSchema)
+		if err != nil {
+			diags.AddError("InputSchema Serialization Error", fmt.Sprintf("Unable to serialize input schema: %s", err))
+			return item, diags
+		}
+		item.InputSchema = types.StringValue(string(schemaJSON))
+	} else {
+		item.InputSchema = types.StringNull()
+	}
 
-	tflog.Trace(ctx, "read tools data source")
+	tags, listDiags := stringListValue(ctx, t.Tags)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return item, diags
+	}
+	item.Tags = tags
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	annotations, mapDiags := toolAnnotationsToModel(t.Annotations, types.MapNull(types.StringType))
+	diags.Append(mapDiags...)
+	if diags.HasError() {
+		return item, diags
+	}
+	item.Annotations = annotations
+
+	return item, diags
 }
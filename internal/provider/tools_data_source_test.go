@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+func TestToolItemsFromAPI_SkipsMalformed(t *testing.T) {
+	tools := []client.Tool{
+		{ID: "tool-1", Name: "first", InputSchema: map[string]interface{}{"type": "object"}},
+		{ID: "tool-bad", Name: "broken", InputSchema: map[string]interface{}{"max": math.Inf(1)}},
+		{ID: "tool-3", Name: "third", Tags: []string{"ok"}},
+	}
+
+	var diags diag.Diagnostics
+	items := toolItemsFromAPI(context.Background(), tools, &diags)
+
+	if diags.HasError() {
+		t.Fatalf("expected no errors, got %v", diags)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("expected 1 warning, got %d: %v", diags.WarningsCount(), diags)
+	}
+	if got := diags.Warnings()[0].Summary(); got != "Skipped tool tool-bad" {
+		t.Errorf("unexpected warning summary %q", got)
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(items))
+	}
+	if items[0].ID.ValueString() != "tool-1" || items[1].ID.ValueString() != "tool-3" {
+		t.Errorf("unexpected items %s, %s", items[0].ID, items[1].ID)
+	}
+}
+
+func TestToolItemsFromAPI_Source(t *testing.T) {
+	tools := []client.Tool{
+		{ID: "tool-1", Name: "discovered", GatewayID: "gw-1"},
+		{ID: "tool-2", Name: "manual"},
+	}
+
+	var diags diag.Diagnostics
+	items := toolItemsFromAPI(context.Background(), tools, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(items))
+	}
+	if got := items[0].Source.ValueString(); got != "gateway" {
+		t.Errorf("expected source gateway for a tool with a gateway_id, got %q", got)
+	}
+	if got := items[1].Source.ValueString(); got != "manual" {
+		t.Errorf("expected source manual for a tool without a gateway_id, got %q", got)
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+// UserResource manages a user on the MCP Gateway.
+type UserResource struct {
+	client   *client.Client
+	settings providerSettings
+}
+
+// UserResourceModel describes the resource data model.
+type UserResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Email     types.String `tfsdk:"email"`
+	Role      types.String `tfsdk:"role"`
+	TeamIDs   types.List   `tfsdk:"team_ids"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a user on the ContextForge MCP Gateway.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "User identifier, assigned by the API.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user.",
+				Required:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role of the user (e.g. `admin`, `member`). Defaults to the gateway's default role.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"team_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the teams the user belongs to. Leaving this unset and setting it to `[]` are equivalent.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the user was created.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the user was last updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.client
+	r.settings = data.settings
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if readOnlyBlocked(r.settings, "create user", &resp.Diagnostics) {
+		return
+	}
+
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var teamIDs []string
+	if !data.TeamIDs.IsNull() && !data.TeamIDs.IsUnknown() {
+		resp.Diagnostics.Append(data.TeamIDs.ElementsAs(ctx, &teamIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	createReq := client.UserCreate{
+		Email:   data.Email.ValueString(),
+		Role:    data.Role.ValueString(),
+		TeamIDs: teamIDs,
+	}
+
+	user, err := r.client.CreateUser(ctx, createReq)
+	if err != nil {
+		addCreateError(&resp.Diagnostics, "create user", fmt.Sprintf("a user with email %q", data.Email.ValueString()), err)
+		return
+	}
+
+	userToModel(ctx, user, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created a user resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetUser(ctx, data.ID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read user", err)
+		return
+	}
+	if user == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	userToModel(ctx, user, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if readOnlyBlocked(r.settings, "update user", &resp.Diagnostics) {
+		return
+	}
+
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Always send team_ids so that removing every team from the
+	// configuration clears the memberships on the gateway.
+	teamIDs := []string{}
+	if !data.TeamIDs.IsNull() && !data.TeamIDs.IsUnknown() {
+		resp.Diagnostics.Append(data.TeamIDs.ElementsAs(ctx, &teamIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	updateReq := client.UserUpdate{
+		Email:   data.Email.ValueString(),
+		TeamIDs: teamIDs,
+	}
+	if !data.Role.IsUnknown() {
+		updateReq.Role = data.Role.ValueString()
+	}
+
+	user, err := r.client.UpdateUser(ctx, data.ID.ValueString(), updateReq)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "update user", err)
+		return
+	}
+
+	userToModel(ctx, user, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a user resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if readOnlyBlocked(r.settings, "delete user", &resp.Diagnostics) {
+		return
+	}
+
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteUser(ctx, data.ID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "delete user", err)
+		return
+	}
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// userToModel maps a client.User to the Terraform resource model. Team IDs
+// follow the same null-or-empty rules as tags.
+func userToModel(ctx context.Context, user *client.User, data *UserResourceModel, diagnostics *diag.Diagnostics) {
+	data.ID = types.StringValue(user.ID)
+	data.Email = types.StringValue(user.Email)
+	data.Role = types.StringValue(user.Role)
+	data.CreatedAt = types.StringValue(user.CreatedAt)
+	data.UpdatedAt = types.StringValue(user.UpdatedAt)
+
+	teamIDs, diags := tagsToModel(ctx, user.TeamIDs, data.TeamIDs)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+	data.TeamIDs = teamIDs
+}
@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+func TestAccUserResource(t *testing.T) {
+	var mu sync.Mutex
+	var stored *client.User
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/users" && r.Method == http.MethodPost:
+			var req client.UserCreate
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			role := req.Role
+			if role == "" {
+				role = "member"
+			}
+			stored = &client.User{
+				ID:        "user-created",
+				Email:     req.Email,
+				Role:      role,
+				TeamIDs:   req.TeamIDs,
+				CreatedAt: "2025-01-01T00:00:00Z",
+				UpdatedAt: "2025-01-01T00:00:00Z",
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			if err := json.NewEncoder(w).Encode(stored); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/users/user-created" && r.Method == http.MethodPut:
+			var req client.UserUpdate
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			stored.Email = req.Email
+			if req.Role != "" {
+				stored.Role = req.Role
+			}
+			stored.TeamIDs = req.TeamIDs
+			stored.UpdatedAt = "2025-01-02T00:00:00Z"
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(stored); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/users/user-created" && r.Method == http.MethodGet:
+			if stored == nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(stored); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/users/user-created" && r.Method == http.MethodDelete:
+			stored = nil
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserResourceConfig(mockServer.URL, `
+  team_ids = ["team-1"]
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_user.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("user-created"),
+					),
+					statecheck.ExpectKnownValue(
+						"contextforge_user.test",
+						tfjsonpath.New("role"),
+						knownvalue.StringExact("member"),
+					),
+					statecheck.ExpectKnownValue(
+						"contextforge_user.test",
+						tfjsonpath.New("team_ids"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("team-1")}),
+					),
+				},
+			},
+			{
+				ResourceName:      "contextforge_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserResourceConfig(mockServer.URL, `
+  role = "admin"
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"contextforge_user.test",
+						tfjsonpath.New("role"),
+						knownvalue.StringExact("admin"),
+					),
+					statecheck.ExpectKnownValue(
+						"contextforge_user.test",
+						tfjsonpath.New("team_ids"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccUserResourceConfig(endpoint, extra string) string {
+	return `
+provider "contextforge" {
+  endpoint     = "` + endpoint + `"
+  bearer_token = "test"
+}
+
+resource "contextforge_user" "test" {
+  email = "user@example.com"
+` + extra + `}
+`
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/nkbud/terraform-provider-contextforge/internal/client"
+)
+
+func TestAccUsersDataSource(t *testing.T) {
+	users := []client.User{
+		{ID: "user-1", Email: "admin@example.com", Role: "admin", TeamIDs: []string{"team-1"}},
+		{ID: "user-2", Email: "member@example.com", Role: "member"},
+	}
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/users" && r.Method == http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(users); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case r.URL.Path == "/users/user-1" && r.Method == http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(users[0]); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contextforge": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig(mockServer.URL),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.contextforge_users.test",
+						tfjsonpath.New("users"),
+						knownvalue.ListSizeExact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_users.test",
+						tfjsonpath.New("users").AtSliceIndex(1).AtMapKey("email"),
+						knownvalue.StringExact("member@example.com"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_user.test",
+						tfjsonpath.New("role"),
+						knownvalue.StringExact("admin"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.contextforge_user.test",
+						tfjsonpath.New("team_ids"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("team-1")}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccUsersDataSourceConfig(endpoint string) string {
+	return `
+provider "contextforge" {
+  endpoint     = "` + endpoint + `"
+  bearer_token = "test"
+}
+
+data "contextforge_users" "test" {}
+
+data "contextforge_user" "test" {
+  id = "user-1"
+}
+`
+}